@@ -3,7 +3,9 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -29,7 +31,19 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(GetCmdQueryParams())
 	cmd.AddCommand(GetCmdQueryLockedDelegationsTo())
 	cmd.AddCommand(GetCmdQueryLockedDelegations())
+	cmd.AddCommand(GetCmdQueryLockedDelegationsFrom())
 	cmd.AddCommand(GetCmdQueryDelegatorRewards())
+	cmd.AddCommand(GetCmdQueryDelegatorSummary())
+	cmd.AddCommand(GetCmdQueryEntry())
+	cmd.AddCommand(GetCmdQueryEntrySlashes())
+	cmd.AddCommand(GetCmdQueryEntryEscrow())
+	cmd.AddCommand(GetCmdQueryLockingStats())
+	cmd.AddCommand(GetCmdQueryRewardPool())
+	cmd.AddCommand(GetCmdQueryRewardDebts())
+	cmd.AddCommand(GetCmdQueryBonusBeneficiary())
+	cmd.AddCommand(GetCmdQueryLockingBudget())
+	cmd.AddCommand(GetCmdQueryQuarantinedPairs())
+	cmd.AddCommand(GetCmdQueryUnlocks())
 	return cmd
 }
 
@@ -178,6 +192,61 @@ $ %s query locking locked-delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 	return cmd
 }
 
+// GetCmdQueryLockedDelegationsFrom implements the command to query locked delegations
+// on an individual validator
+func GetCmdQueryLockedDelegationsFrom() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "locked-delegations-from [validator-addr]",
+		Short: "Query locked delegations based on validator address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query locked delegations on an individual validator.
+
+Example:
+$ %s query locking locked-delegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorLockedDelegationsRequest{
+				ValidatorAddr: valAddr.String(),
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.ValidatorLockedDelegations(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locked-delegations-from")
+
+	return cmd
+}
+
 // GetCmdQueryDelegatorRewards implements the query delegator rewards command
 func GetCmdQueryDelegatorRewards() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -189,6 +258,7 @@ func GetCmdQueryDelegatorRewards() *cobra.Command {
 		Short: "Query all locked delegations rewards",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query all locked delegation rewards earned by a delegator, optionally restrict to rewards from a single validator.
+The rewards are estimated up to the current block without changing the chain state.
 
 Example:
 $ %s query locking rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
@@ -248,3 +318,470 @@ $ %s query locking rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3c
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryDelegatorSummary implements the command to query the locking summary of a delegator
+func GetCmdQueryDelegatorSummary() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "summary [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the locking summary of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegated, locked and free amounts of a delegator, with its reward ratios, pending rewards and next unlock time, per validator and in total.
+
+Example:
+$ %s query locking summary %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorLockingSummary(
+				cmd.Context(),
+				&types.QueryDelegatorLockingSummaryRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEntry implements the command to query a locked delegation entry by its id
+func GetCmdQueryEntry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entry [entry-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a locked delegation entry by its id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a locked delegation entry by its id, with its delegator and validator, current token value, time remaining and accrued bonus estimate.
+
+Example:
+$ %s query locking entry 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LockedDelegationEntry(
+				cmd.Context(),
+				&types.QueryLockedDelegationEntryRequest{Id: id},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEntrySlashes implements the command to query a locked delegation entry token value
+// before and after each of its validator slashes
+func GetCmdQueryEntrySlashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entry-slashes [entry-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a locked delegation entry token value before and after slashes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current token value of a locked delegation entry and its value before and after each slash of its validator.
+
+Example:
+$ %s query locking entry-slashes 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LockedDelegationEntrySlashes(
+				cmd.Context(),
+				&types.QueryLockedDelegationEntrySlashesRequest{Id: id},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEntryEscrow implements the command to query the locking rewards held in escrow for a locked delegation entry
+func GetCmdQueryEntryEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entry-escrow [entry-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the locking rewards held in escrow for a locked delegation entry",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the locking rewards held in escrow for a locked delegation entry with the at maturity payout mode.
+They are paid when the entry reaches its unlock time and forfeited if it's unlocked early.
+
+Example:
+$ %s query locking entry-escrow 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EntryEscrow(
+				cmd.Context(),
+				&types.QueryEntryEscrowRequest{Id: id},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryLockingStats implements the command to query the locked totals
+func GetCmdQueryLockingStats() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "stats [validator-addr]",
+		Short: "Query the module wide and per validator locked totals",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the module wide locked totals and the locked totals per validator and rate duration.
+Optionally restrict the validators totals to a single validator.
+
+Example:
+$ %s query locking stats
+$ %s query locking stats %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryLockingStatsRequest{}
+			if len(args) == 1 {
+				valAddr, err := sdk.ValAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				params.ValidatorAddr = valAddr.String()
+			}
+
+			res, err := queryClient.LockingStats(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRewardPool implements the command to query the reward pool
+func GetCmdQueryRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-pool",
+		Short: "Query the reward pool balance and the outstanding debt",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardPool(cmd.Context(), &types.QueryRewardPoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRewardDebts implements the command to query the locking rewards owed to a delegator
+func GetCmdQueryRewardDebts() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "reward-debts [delegator-addr]",
+		Short: "Query the locking rewards owed to a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the locking rewards owed to a delegator per validator, when the reward pool couldn't pay them.
+
+Example:
+$ %s query locking reward-debts %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorRewardDebts(cmd.Context(), &types.QueryDelegatorRewardDebtsRequest{
+				DelegatorAddress: delAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBonusBeneficiary implements the command to query the address receiving the locking rewards of a delegator
+func GetCmdQueryBonusBeneficiary() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "bonus-beneficiary [delegator-addr]",
+		Short: "Query the address receiving the locking rewards of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the bonus beneficiary of a delegator and the address its locking rewards are paid to.
+Without bonus beneficiary the locking rewards are paid to the distribution withdraw address.
+
+Example:
+$ %s query locking bonus-beneficiary %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BonusBeneficiary(cmd.Context(), &types.QueryBonusBeneficiaryRequest{
+				DelegatorAddress: delAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryLockingBudget implements the command to query the locking rewards budget
+func GetCmdQueryLockingBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "budget",
+		Short: "Query the locking rewards budget consumed on the current window",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LockingBudget(cmd.Context(), &types.QueryLockingBudgetRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryQuarantinedPairs implements the command to query the quarantined locked delegation pairs
+func GetCmdQueryQuarantinedPairs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantined-pairs",
+		Short: "Query the locked delegation pairs whose expired entries couldn't be completed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QuarantinedPairs(cmd.Context(), &types.QueryQuarantinedPairsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "quarantined-pairs")
+
+	return cmd
+}
+
+// GetCmdQueryUnlocks implements the command to query the locked delegation entries unlocking on a time range
+func GetCmdQueryUnlocks() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "unlocks [start-time] [end-time]",
+		Short: "Query the locked delegation entries unlocking between two timestamps",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the locked delegation entries unlocking between two RFC3339 timestamps, both inclusive.
+The entries are returned ordered by unlock time with their shares, token value, rate and auto renew.
+Optionally filter them by delegator or validator.
+
+Example:
+$ %s query locking unlocks 2024-01-01T00:00:00Z 2024-02-01T00:00:00Z
+$ %s query locking unlocks 2024-01-01T00:00:00Z 2024-02-01T00:00:00Z --delegator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTime, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				return err
+			}
+			endTime, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryUnlocksRequest{StartTime: startTime, EndTime: endTime}
+			if params.DelegatorAddr, err = cmd.Flags().GetString("delegator"); err != nil {
+				return err
+			}
+			if params.ValidatorAddr, err = cmd.Flags().GetString("validator"); err != nil {
+				return err
+			}
+			if params.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			res, err := queryClient.Unlocks(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String("delegator", "", "Only return the entries of a delegator")
+	cmd.Flags().String("validator", "", "Only return the entries on a validator")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unlocks")
+
+	return cmd
+}
@@ -17,6 +17,19 @@ import (
 	"github.com/aetherevm/locking/locking/types"
 )
 
+// Flags for the locked delegation expiry action
+const (
+	FlagExpiryAction = "expiry-action"
+	FlagRedelegateTo = "redelegate-to"
+)
+
+// Expiry actions accepted on the command line
+const (
+	expiryActionUndelegate    = "undelegate"
+	expiryActionStayDelegated = "stay-delegated"
+	expiryActionRedelegate    = "redelegate"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -29,8 +42,18 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewCreateLockedDelegationCmd(),
+		NewLockExistingDelegationCmd(),
 		NewRedelegateLockedDelegationsCmd(),
 		NewToggleAutoRenewCmd(),
+		NewSetExpiryActionCmd(),
+		NewEarlyUnlockCmd(),
+		NewSplitLockedDelegationEntryCmd(),
+		NewExtendLockCmd(),
+		NewFundRewardPoolCmd(),
+		NewClaimRewardDebtCmd(),
+		NewSetBonusBeneficiaryCmd(),
+		NewSetAutoCompoundCmd(),
+		NewWithdrawLockingRewardsCmd(),
 	)
 
 	return cmd
@@ -49,8 +72,9 @@ func NewCreateLockedDelegationCmd() *cobra.Command {
 
 Example:
 $ %s tx locking create-locked-delegation %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000stake 123123s --auto-renew=true --from mykey
+$ %s tx locking create-locked-delegation %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000stake 123123s --auto-renew=false --expiry-action=stay-delegated --from mykey
 `,
-				version.AppName, bech32PrefixValAddr,
+				version.AppName, bech32PrefixValAddr, version.AppName, bech32PrefixValAddr,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -91,6 +115,12 @@ $ %s tx locking create-locked-delegation %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59
 				}
 			}
 
+			// Parse the expiry action flags
+			expiryAction, redelegateTo, err := getExpiryActionFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			// Create the message
 			msg := types.NewMsgCreateLockedDelegation(
 				delAddr,
@@ -98,6 +128,8 @@ $ %s tx locking create-locked-delegation %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59
 				amount,
 				lockDuration,
 				autoRenew,
+				expiryAction,
+				redelegateTo,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -111,6 +143,101 @@ $ %s tx locking create-locked-delegation %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59
 	flags.AddTxFlagsToCmd(cmd)
 	// Add auto-renew flag, it is optional and by default true
 	cmd.Flags().Bool("auto-renew", true, "Automatically renew the locked delegation when it expires")
+	// Add the expiry action flags, by default the shares are undelegated on expiry
+	cmd.Flags().String(FlagExpiryAction, expiryActionUndelegate, "What happens to the shares when the lock expires without auto renew: undelegate, stay-delegated or redelegate")
+	cmd.Flags().String(FlagRedelegateTo, "", "The validator the shares are redelegated to with the redelegate expiry action")
+
+	return cmd
+}
+
+// NewLockExistingDelegationCmd returns a CLI command handler for creating a MsgLockExistingDelegation transaction.
+func NewLockExistingDelegationCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "lock-existing-delegation [validator-addr] [amount] [duration] [auto-renew]",
+		Args:  cobra.RangeArgs(3, 4),
+		Short: "Creates a Locked Delegation on top of an existing delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a Locked delegation with an amount already delegated to a validator.
+The amount must not be locked already.
+
+Example:
+$ %s tx locking lock-existing-delegation %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000stake 123123s --auto-renew=true --from mykey
+$ %s tx locking lock-existing-delegation %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000stake 123123s --auto-renew=false --expiry-action=stay-delegated --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// Get the addresses
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse the amount
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Parse the lock duration
+			lockDuration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			// Parse the auto-renew flag with default value as true
+			var autoRenew bool
+			if len(args) == 4 {
+				autoRenew, err = strconv.ParseBool(args[3])
+				if err != nil {
+					return err
+				}
+			} else {
+				autoRenew, err = cmd.Flags().GetBool("auto-renew")
+				if err != nil {
+					return err
+				}
+			}
+
+			// Parse the expiry action flags
+			expiryAction, redelegateTo, err := getExpiryActionFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create the message
+			msg := types.NewMsgLockExistingDelegation(
+				delAddr,
+				valAddr,
+				amount,
+				lockDuration,
+				autoRenew,
+				expiryAction,
+				redelegateTo,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Broadcast
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	// Add auto-renew flag, it is optional and by default true
+	cmd.Flags().Bool("auto-renew", true, "Automatically renew the locked delegation when it expires")
+	// Add the expiry action flags, by default the shares are undelegated on expiry
+	cmd.Flags().String(FlagExpiryAction, expiryActionUndelegate, "What happens to the shares when the lock expires without auto renew: undelegate, stay-delegated or redelegate")
+	cmd.Flags().String(FlagRedelegateTo, "", "The validator the shares are redelegated to with the redelegate expiry action")
 
 	return cmd
 }
@@ -232,3 +359,546 @@ $ %s tx locking toggle-auto-renew %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 123
 
 	return cmd
 }
+
+// NewSetExpiryActionCmd returns a CLI command handler for creating a MsgSetExpiryAction transaction
+func NewSetExpiryActionCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-expiry-action [validator-addr] [entry-id] [expiry-action] [redelegate-to]",
+		Short: "Set what happens to a locked delegation entry shares when it expires",
+		Args:  cobra.RangeArgs(3, 4),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set what happens to a locked delegation entry shares when it expires without auto renew.
+The expiry action can be undelegate, stay-delegated or redelegate, the redelegate action requires the validator to redelegate to.
+
+Example:
+$ %s tx locking set-expiry-action %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 123 stay-delegated --from mykey
+$ %s tx locking set-expiry-action %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 123 redelegate %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, version.AppName, bech32PrefixValAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// Parse the address
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse the ID
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Parse the expiry action and the redelegation validator
+			expiryAction, err := parseExpiryAction(args[2])
+			if err != nil {
+				return err
+			}
+			var redelegateTo string
+			if len(args) == 4 {
+				redelegateTo = args[3]
+			}
+
+			// Generate the message
+			msg := types.NewMsgSetExpiryAction(
+				delAddr,
+				valAddr,
+				id,
+				expiryAction,
+				redelegateTo,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Broadcast
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewEarlyUnlockCmd returns a CLI command handler for creating a MsgEarlyUnlock transaction
+func NewEarlyUnlockCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "early-unlock [validator-addr] [ids]",
+		Short: "Unlock and undelegate locked delegation entries before their unlock time",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unlock and undelegate locked delegation entries before their unlock time.
+Entry IDs must be provided as a comma separated list.
+A penalty, defined per rate on the module params, is taken from the unlocked amount.
+
+Example:
+$ %s tx locking early-unlock %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1,2,3 --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// Parse the address
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse the ID list
+			var ids []uint64
+			for _, idStr := range strings.Split(args[1], ",") {
+				id, err := strconv.ParseUint(idStr, 10, 64)
+				if err != nil {
+					return err
+				}
+				ids = append(ids, id)
+			}
+
+			// Generate the message
+			msg := types.NewMsgEarlyUnlock(
+				delAddr,
+				valAddr,
+				ids,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Broadcast
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSplitLockedDelegationEntryCmd returns a CLI command handler for creating a MsgSplitLockedDelegationEntry transaction
+func NewSplitLockedDelegationEntryCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "split-entry [validator-addr] [entry-id] [shares]",
+		Short: "Split a locked delegation entry in two new entries",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Split a locked delegation entry in two new entries.
+The first new entry holds the given shares and the second one the remaining shares.
+Both entries keep the rate, unlock time and auto renew flag of the original entry.
+
+Example:
+$ %s tx locking split-entry %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 123 1000.5 --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// Parse the address
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse the ID
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Parse the shares
+			shares, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			// Generate the message
+			msg := types.NewMsgSplitLockedDelegationEntry(
+				delAddr,
+				valAddr,
+				id,
+				shares,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Broadcast
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewExtendLockCmd returns a CLI command handler for creating a MsgExtendLock transaction
+func NewExtendLockCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "extend-lock [validator-addr] [entry-id] [duration]",
+		Short: "Move a locked delegation entry to a longer duration rate",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Move a locked delegation entry to a longer duration rate.
+The new unlock time is never earlier than the current one.
+
+Example:
+$ %s tx locking extend-lock %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 123 17280h --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// Parse the address
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse the ID
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Parse the lock duration
+			lockDuration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			// Generate the message
+			msg := types.NewMsgExtendLock(
+				delAddr,
+				valAddr,
+				id,
+				lockDuration,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Broadcast
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewFundRewardPoolCmd returns a CLI command handler for creating a MsgFundRewardPool transaction.
+func NewFundRewardPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-reward-pool [amount]",
+		Short: "Fund the locking reward pool",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send coins to the locking reward pool.
+The pool pays the locking rewards on the pool and hybrid funding modes.
+
+Example:
+$ %s tx locking fund-reward-pool 1000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the amount
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Generate the message
+			msg := types.NewMsgFundRewardPool(
+				clientCtx.GetFromAddress(),
+				amount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Broadcast
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewClaimRewardDebtCmd returns a CLI command handler for creating a MsgClaimRewardDebt transaction.
+func NewClaimRewardDebtCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "claim-reward-debt [validator-addr]",
+		Short: "Claim the locking rewards owed by the reward pool",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the locking rewards owed on a validator when the reward pool couldn't pay them.
+What still can't be paid is kept as debt.
+
+Example:
+$ %s tx locking claim-reward-debt %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// Parse the address
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Generate the message
+			msg := types.NewMsgClaimRewardDebt(
+				delAddr,
+				valAddr,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Broadcast
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetBonusBeneficiaryCmd returns a CLI command handler for creating a MsgSetBonusBeneficiary transaction.
+func NewSetBonusBeneficiaryCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-bonus-beneficiary [beneficiary-addr]",
+		Short: "Set the address receiving your locking rewards",
+		Args:  cobra.RangeArgs(0, 1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the address receiving your locking rewards instead of your distribution withdraw address.
+Without beneficiary address the bonus beneficiary is removed and the locking rewards follow the distribution withdraw address again.
+
+Example:
+$ %s tx locking set-bonus-beneficiary %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// Parse the addresses
+			delAddr := clientCtx.GetFromAddress()
+			var beneficiary sdk.AccAddress
+			if len(args) == 1 {
+				beneficiary, err = sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			// Generate the message
+			msg := types.NewMsgSetBonusBeneficiary(
+				delAddr,
+				beneficiary,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Broadcast
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoCompoundCmd returns a CLI command handler for creating a MsgSetAutoCompound transaction.
+func NewSetAutoCompoundCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [validator-addr] [auto-compound]",
+		Short: "Set if the locking rewards of a locked delegation are compounded",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set if the locking rewards of a locked delegation are compounded.
+Compounded rewards are paid to the delegator, delegated at the end of the block
+and added to the locked delegation entry with the latest unlock time.
+
+Example:
+$ %s tx locking set-auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// Parse the address and the preference
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			autoCompound, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Generate the message
+			msg := types.NewMsgSetAutoCompound(
+				delAddr,
+				valAddr,
+				autoCompound,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Broadcast
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWithdrawLockingRewardsCmd returns a CLI command handler for creating a MsgWithdrawLockingRewards transaction.
+func NewWithdrawLockingRewardsCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "withdraw-locking-rewards [validator-addr]",
+		Short: "Withdraw the locking rewards accrued on a validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the locking rewards accrued on a validator.
+Only available when the module runs on the standalone reward mode.
+
+Example:
+$ %s tx locking withdraw-locking-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// Parse the address
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Generate the message
+			msg := types.NewMsgWithdrawLockingRewards(
+				delAddr,
+				valAddr,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Broadcast
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getExpiryActionFlags returns the expiry action and the redelegation validator set on the command flags
+func getExpiryActionFlags(cmd *cobra.Command) (types.ExpiryAction, string, error) {
+	action, err := cmd.Flags().GetString(FlagExpiryAction)
+	if err != nil {
+		return types.ExpiryActionUndelegate, "", err
+	}
+	expiryAction, err := parseExpiryAction(action)
+	if err != nil {
+		return types.ExpiryActionUndelegate, "", err
+	}
+	redelegateTo, err := cmd.Flags().GetString(FlagRedelegateTo)
+	if err != nil {
+		return types.ExpiryActionUndelegate, "", err
+	}
+	return expiryAction, redelegateTo, nil
+}
+
+// parseExpiryAction parses a command line expiry action
+func parseExpiryAction(action string) (types.ExpiryAction, error) {
+	switch action {
+	case expiryActionUndelegate:
+		return types.ExpiryActionUndelegate, nil
+	case expiryActionStayDelegated:
+		return types.ExpiryActionStayDelegated, nil
+	case expiryActionRedelegate:
+		return types.ExpiryActionRedelegate, nil
+	default:
+		return types.ExpiryActionUndelegate, fmt.Errorf(
+			"invalid expiry action %s, expected %s, %s or %s",
+			action, expiryActionUndelegate, expiryActionStayDelegated, expiryActionRedelegate,
+		)
+	}
+}
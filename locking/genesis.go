@@ -20,7 +20,7 @@ func InitGenesis(
 		panic(err)
 	}
 
-	// Set the locked delegations and the initial ID for the id counter
+	// Set the locked delegations, which also queues their entries, and the initial ID for the id counter
 	initialID := uint64(0)
 	for _, lockedDelegation := range data.LockedDelegations {
 		err = k.SetLockedDelegation(ctx, lockedDelegation)
@@ -28,8 +28,7 @@ func InitGenesis(
 			panic(err)
 		}
 		for _, entry := range lockedDelegation.Entries {
-			// Set it to the queue and to the lookup
-			k.InsertLockedDelegationQueue(ctx, lockedDelegation, entry.UnlockOn)
+			// Set it to the lookup
 			err := k.SetLockedDelegationByEntryID(ctx, lockedDelegation, entry.Id)
 			if err != nil {
 				panic(err)
@@ -44,6 +43,89 @@ func InitGenesis(
 		k.SetInitialLockedDelegationEntryID(ctx, initialID)
 	}
 
+	// Set the accrual checkpoints
+	for _, checkpoint := range data.AccrualCheckpoints {
+		err = k.SetAccrualCheckpoint(ctx, checkpoint)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the validator slash events
+	for _, slashEvent := range data.ValidatorSlashEvents {
+		err = k.SetValidatorSlashEvent(ctx, slashEvent)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the reward debts and the mint epoch
+	for _, debt := range data.RewardDebts {
+		err = k.SetRewardDebt(ctx, debt)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if !data.MintEpoch.Start.IsZero() {
+		k.SetMintEpoch(ctx, data.MintEpoch)
+	}
+
+	// Set the budget window
+	if !data.BudgetWindow.Start.IsZero() {
+		k.SetBudgetWindow(ctx, data.BudgetWindow)
+	}
+
+	// Set the quarantined pairs, their entries are queued again with the locked delegations
+	for _, quarantined := range data.QuarantinedPairs {
+		err = k.SetQuarantinedPair(ctx, quarantined)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the reward remainders
+	for _, remainder := range data.RewardRemainders {
+		err = k.SetRewardRemainder(ctx, remainder)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the bonus beneficiaries
+	for _, beneficiary := range data.BonusBeneficiaries {
+		err = k.SetBonusBeneficiary(ctx, beneficiary)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the entry escrows
+	for _, escrow := range data.EntryEscrows {
+		err = k.SetEntryEscrow(ctx, escrow)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the locking rewards waiting to be compounded
+	for _, compound := range data.CompoundQueue {
+		err = k.SetLockingRewardCompound(ctx, compound)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the free redelegation validators and the pending validator queues
+	for _, valAddr := range validatorAddresses(data.FreeRedelegationValidators) {
+		k.SetFreeRedelegationValidator(ctx, valAddr)
+	}
+	for _, valAddr := range validatorAddresses(data.SlashedValidatorQueue) {
+		k.SetSlashedValidatorQueue(ctx, valAddr)
+	}
+	for _, valAddr := range validatorAddresses(data.ExitedValidatorQueue) {
+		k.SetExitedValidatorQueue(ctx, valAddr)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -55,9 +137,47 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	// Get the locked delegations records
 	lockedDelegations := k.GetAllLockedDelegations(ctx)
 
-	// Return the genesis state
-	return types.NewGenesisState(
+	// Return the genesis state with the validator slash events, the reward funding state, the budget window,
+	// the accrual checkpoints, the quarantined pairs, the bonus beneficiaries, the reward remainders,
+	// the entry escrows, the free redelegation validators, the pending validator queues and the compound queue
+	genesisState := types.NewGenesisState(
 		params,
 		lockedDelegations,
 	)
+	genesisState.ValidatorSlashEvents = k.GetAllValidatorSlashEvents(ctx)
+	genesisState.RewardDebts = k.GetAllRewardDebts(ctx)
+	genesisState.MintEpoch = k.GetMintEpoch(ctx)
+	genesisState.BudgetWindow = k.GetBudgetWindow(ctx)
+	genesisState.AccrualCheckpoints = k.GetAllAccrualCheckpoints(ctx)
+	genesisState.QuarantinedPairs = k.GetAllQuarantinedPairs(ctx)
+	genesisState.BonusBeneficiaries = k.GetAllBonusBeneficiaries(ctx)
+	genesisState.RewardRemainders = k.GetAllRewardRemainders(ctx)
+	genesisState.EntryEscrows = k.GetAllEntryEscrows(ctx)
+	genesisState.FreeRedelegationValidators = validatorStrings(k.GetAllFreeRedelegationValidators(ctx))
+	genesisState.SlashedValidatorQueue = validatorStrings(k.GetAllSlashedValidators(ctx))
+	genesisState.ExitedValidatorQueue = validatorStrings(k.GetAllExitedValidators(ctx))
+	genesisState.CompoundQueue = k.GetAllLockingRewardCompounds(ctx)
+	return genesisState
+}
+
+// validatorAddresses converts the validator addresses of the genesis state, they were validated before
+func validatorAddresses(addrs []string) []sdk.ValAddress {
+	valAddrs := make([]sdk.ValAddress, 0, len(addrs))
+	for _, addr := range addrs {
+		valAddr, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			panic(err)
+		}
+		valAddrs = append(valAddrs, valAddr)
+	}
+	return valAddrs
+}
+
+// validatorStrings converts validator addresses to the genesis state format
+func validatorStrings(valAddrs []sdk.ValAddress) []string {
+	var addrs []string
+	for _, valAddr := range valAddrs {
+		addrs = append(addrs, valAddr.String())
+	}
+	return addrs
 }
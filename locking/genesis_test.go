@@ -200,16 +200,86 @@ func (suite *GenesisTestSuite) TestExportGenesis() {
 		},
 	}
 
+	testGenCases[1].genesisState.RewardRemainders = []types.RewardRemainder{
+		types.NewRewardRemainder(addr, valAddr, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1)))),
+	}
+	testGenCases[1].genesisState.BonusBeneficiaries = []types.BonusBeneficiary{
+		types.NewBonusBeneficiary(addr, sdk.AccAddress([]byte("beneficiary"))),
+	}
+	testGenCases[1].genesisState.EntryEscrows = []types.EntryEscrow{
+		types.NewEntryEscrow(65, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(15, 1)))),
+		types.NewEntryEscrow(421, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 10))),
+	}
+
+	testGenCases[1].genesisState.FreeRedelegationValidators = []string{valAddr.String()}
+	testGenCases[1].genesisState.SlashedValidatorQueue = []string{valAddr.String()}
+	testGenCases[1].genesisState.ExitedValidatorQueue = []string{valAddr.String()}
+
 	for _, tc := range testGenCases {
 		locking.InitGenesis(suite.ctx, suite.app.LockingKeeper, *tc.genesisState)
 		suite.Require().NotPanics(func() {
 			genesisExported := locking.ExportGenesis(suite.ctx, suite.app.LockingKeeper)
 			suite.Require().Equal(tc.genesisState.Params, genesisExported.Params)
 			suite.Require().Equal(tc.genesisState.LockedDelegations, genesisExported.LockedDelegations)
+			suite.Require().Equal(tc.genesisState.RewardRemainders, genesisExported.RewardRemainders)
+			suite.Require().Equal(tc.genesisState.BonusBeneficiaries, genesisExported.BonusBeneficiaries)
+			suite.Require().Equal(tc.genesisState.EntryEscrows, genesisExported.EntryEscrows)
+			suite.Require().Equal(tc.genesisState.FreeRedelegationValidators, genesisExported.FreeRedelegationValidators)
+			suite.Require().Equal(tc.genesisState.SlashedValidatorQueue, genesisExported.SlashedValidatorQueue)
+			suite.Require().Equal(tc.genesisState.ExitedValidatorQueue, genesisExported.ExitedValidatorQueue)
 		})
 	}
 }
 
+// TestGenesisValidatorsRoundTrip tests the free redelegation validators and the pending validator queues
+// are kept on an export and import
+func (suite *GenesisTestSuite) TestGenesisValidatorsRoundTrip() {
+	valAddr := sdk.ValAddress([]byte("val1"))
+	valAddr2 := sdk.ValAddress([]byte("val2"))
+
+	k := suite.app.LockingKeeper
+	k.SetFreeRedelegationValidator(suite.ctx, valAddr)
+	k.SetSlashedValidatorQueue(suite.ctx, valAddr2)
+	k.SetExitedValidatorQueue(suite.ctx, valAddr)
+	k.SetExitedValidatorQueue(suite.ctx, valAddr2)
+
+	exported := locking.ExportGenesis(suite.ctx, k)
+	suite.Require().NoError(exported.Validate())
+	suite.Require().Equal([]string{valAddr.String()}, exported.FreeRedelegationValidators)
+	suite.Require().Equal([]string{valAddr2.String()}, exported.SlashedValidatorQueue)
+	suite.Require().ElementsMatch([]string{valAddr.String(), valAddr2.String()}, exported.ExitedValidatorQueue)
+
+	// Import it on a new chain
+	suite.SetupTest()
+	k = suite.app.LockingKeeper
+	locking.InitGenesis(suite.ctx, k, *exported)
+	suite.Require().Equal(exported, locking.ExportGenesis(suite.ctx, k))
+	suite.Require().True(k.HasFreeRedelegationValidator(suite.ctx, valAddr))
+	suite.Require().False(k.HasFreeRedelegationValidator(suite.ctx, valAddr2))
+	suite.Require().Equal([]sdk.ValAddress{valAddr2}, k.DequeueSlashedValidators(suite.ctx))
+	suite.Require().ElementsMatch([]sdk.ValAddress{valAddr, valAddr2}, k.DequeueExitedValidators(suite.ctx))
+}
+
+// TestGenesisCompoundQueueRoundTrip tests the locking rewards waiting to be compounded are kept on an export and import
+func (suite *GenesisTestSuite) TestGenesisCompoundQueueRoundTrip() {
+	delAddr := sdk.AccAddress([]byte("address1"))
+	valAddr := sdk.ValAddress([]byte("val1"))
+	compound := types.NewLockingRewardCompound(delAddr, valAddr, math.NewInt(10))
+
+	k := suite.app.LockingKeeper
+	suite.Require().NoError(k.SetLockingRewardCompound(suite.ctx, compound))
+
+	exported := locking.ExportGenesis(suite.ctx, k)
+	suite.Require().NoError(exported.Validate())
+	suite.Require().Equal([]types.LockingRewardCompound{compound}, exported.CompoundQueue)
+
+	// Import it on a new chain
+	suite.SetupTest()
+	k = suite.app.LockingKeeper
+	locking.InitGenesis(suite.ctx, k, *exported)
+	suite.Require().Equal(exported, locking.ExportGenesis(suite.ctx, k))
+}
+
 // TestInitGenesisAddrBadPath tests a specific path were genesis store fails on bad validatorAddress
 func (suite *GenesisTestSuite) TestInitGenesisAddrBadPath() {
 	suite.SetupTest()
@@ -243,3 +313,26 @@ func (suite *GenesisTestSuite) TestInitGenesisAddrBadPath() {
 		},
 	)
 }
+
+// TestGenesisLockedDelegationsIdempotent tests that importing the exported locked delegations doesn't
+// take accrual checkpoints, so the distribution store is untouched and the export is the same
+func (suite *GenesisTestSuite) TestGenesisLockedDelegationsIdempotent() {
+	k := suite.app.LockingKeeper
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	delAddr := suite.app.StakingKeeper.GetValidatorDelegations(suite.ctx, valAddr)[0].GetDelegatorAddr()
+
+	// Lock with pending rewards, so an accrual checkpoint is taken
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 1000)))
+	_, err := k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(1000), types.DefaultRates[0], false, types.ExpiryActionStayDelegated, "")
+	suite.Require().NoError(err)
+
+	exported := locking.ExportGenesis(suite.ctx, k)
+	suite.Require().NotEmpty(exported.AccrualCheckpoints)
+	period := suite.app.DistrKeeper.GetValidatorCurrentRewards(suite.ctx, valAddr).Period
+
+	locking.InitGenesis(suite.ctx, k, *exported)
+	suite.Require().Equal(period, suite.app.DistrKeeper.GetValidatorCurrentRewards(suite.ctx, valAddr).Period)
+	suite.Require().Equal(exported, locking.ExportGenesis(suite.ctx, k))
+}
@@ -5,21 +5,27 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// EndBlock iterates over the locked delegations, unlocking the ones that has been expired
+// EndBlock applies the double sign and validator exit policies, iterates over
+// the locked delegations, unlocking the ones that has been expired, and compounds the locking rewards
 func (k Keeper) EndBlock(ctx sdk.Context) []abci.ValidatorUpdate {
-	currTime := ctx.BlockTime()
-
-	// Dequeue locked delegation pairs
-	expiredPairs := k.DequeueExpiredLockedDelegations(ctx, currTime)
+	// Apply the double sign policy to the validators slashed on this block
+	// A failure is reported and doesn't halt the chain, the locked delegations are left untouched
+	params := k.GetParams(ctx)
+	for _, valAddr := range k.DequeueSlashedValidators(ctx) {
+		k.completeValidator(ctx, valAddr, params.DoubleSignPolicy.String(), k.CompleteSlashedValidator)
+	}
 
-	// Complete the expired entries
-	for _, pair := range expiredPairs {
-		err := k.CompleteLockedDelegations(ctx, pair)
-		// There's no problem into panicking at this point
-		if err != nil {
-			panic(err)
-		}
+	// Apply the validator exit policy to the validators that left the active set or were removed
+	for _, valAddr := range k.DequeueExitedValidators(ctx) {
+		k.completeValidator(ctx, valAddr, params.ValidatorExitPolicy.String(), k.CompleteExitedValidator)
 	}
+
+	// Complete the expired entries up to the per block limit, quarantining the pairs that fail
+	k.ProcessExpiredLockedDelegations(ctx)
+
+	// Compound the locking rewards paid on this block, after the expired entries were completed
+	k.ProcessLockingRewardCompounds(ctx)
+
 	// Returns a empty validator set to complete the endblock interface
 	return []abci.ValidatorUpdate{}
 }
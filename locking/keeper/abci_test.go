@@ -65,13 +65,17 @@ func (suite *KeeperTestSuite) TestEndBlockWithLDUndelegate() {
 	valAddress := valAddresses[len(valAddresses)-1]
 	totalDelegated, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddress, valAddress)
 	suite.Require().True(found)
+	validator, found := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddress)
+	suite.Require().True(found)
 	_, err := suite.k.CreateLockedDelegationEntry(
 		suite.ctx,
 		delAddress,
 		valAddress,
-		math.Int(totalDelegated.Shares).Quo(math.NewInt(2)),
+		validator.TokensFromShares(totalDelegated.Shares).TruncateInt().Quo(math.NewInt(2)),
 		rate,
 		false,
+		types.ExpiryActionUndelegate,
+		"",
 	)
 	suite.Require().NoError(err)
 
@@ -112,9 +116,11 @@ func (suite *KeeperTestSuite) TestEndBlockWithLDRenew() {
 		suite.ctx,
 		delAddress,
 		valAddress,
-		math.Int(totalDelegated.Shares).Quo(math.NewInt(2)),
+		totalDelegated.Shares.TruncateInt().Quo(math.NewInt(2)),
 		rate,
 		true,
+		types.ExpiryActionUndelegate,
+		"",
 	)
 	suite.Require().NoError(err)
 
@@ -135,6 +141,52 @@ func (suite *KeeperTestSuite) TestEndBlockWithLDRenew() {
 	}
 }
 
+// TestEndBlockWithSplitLDRenew test the halves of a split entry stay apart when they are renewed
+func (suite *KeeperTestSuite) TestEndBlockWithSplitLDRenew() {
+	delAddresses, valAddresses, _ := setupEndblockTest(suite)
+
+	// Create a locked delegation and split its entry
+	delAddress := delAddresses[len(delAddresses)-1]
+	valAddress := valAddresses[len(valAddresses)-1]
+	totalDelegated, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddress, valAddress)
+	suite.Require().True(found)
+	entry, err := suite.k.CreateLockedDelegationEntry(
+		suite.ctx,
+		delAddress,
+		valAddress,
+		totalDelegated.Shares.TruncateInt().Quo(math.NewInt(2)),
+		rate,
+		true,
+		types.ExpiryActionUndelegate,
+		"",
+	)
+	suite.Require().NoError(err)
+	split, remainder, err := suite.k.SplitLockedDelegationEntry(suite.ctx, delAddress, valAddress, entry.Id, entry.Shares.QuoInt64(2))
+	suite.Require().NoError(err)
+
+	suite.Require().NotPanics(func() {
+		// Move the block head in the future by the rate duration
+		newBlockTime := suite.ctx.BlockTime().Add(rate.Duration)
+		suite.ctx = suite.ctx.WithBlockTime(newBlockTime)
+		suite.k.EndBlock(suite.ctx)
+	})
+
+	// Both halves are renewed with their own ID and can still be looked up
+	lockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddress, valAddress)
+	suite.Require().True(found)
+	suite.Require().Len(lockedDelegation.Entries, 2)
+	for _, half := range []types.LockedDelegationEntry{split, remainder} {
+		exists, entries := lockedDelegation.EntriesForIds([]uint64{half.Id})
+		suite.Require().True(exists)
+		suite.Require().Equal(half.Shares, entries[0].Shares)
+		suite.Require().Equal(half.UnlockOn.Add(rate.Duration), entries[0].UnlockOn)
+
+		ld, found := suite.k.GetLockedDelegationByEntryID(suite.ctx, half.Id)
+		suite.Require().True(found)
+		suite.Require().Equal(lockedDelegation, ld)
+	}
+}
+
 // TestEndBlockWithBigSet tests the endblock with a bit set of locked delegations with undelegate and renew
 func (suite *KeeperTestSuite) TestEndBlockWithBigSet() {
 	delAddresses, valAddresses, delegationShares := setupEndblockTest(suite)
@@ -247,6 +299,8 @@ func createTestingLDs(suite *KeeperTestSuite, delAddresses []sdk.AccAddress, val
 						math.NewInt(int64(10*(i+1))),
 						types.DefaultRates[i%len(types.DefaultRates)],
 						i%2 == 0,
+						types.ExpiryActionUndelegate,
+						"",
 					)
 					suite.Require().NoError(err)
 				}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// GetAccrualCheckpoint returns the accrual checkpoint of a delegator on a validator
+// A pair without checkpoint didn't change its locked delegation since the last withdraw
+func (k Keeper) GetAccrualCheckpoint(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (checkpoint types.AccrualCheckpoint, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetAccrualCheckpointKey(delAddr, valAddr))
+	if bz == nil {
+		return types.NewAccrualCheckpoint(delAddr, valAddr, sdk.NewCoins(), sdk.NewDecCoins(), sdk.NewDecCoins()), false
+	}
+
+	k.cdc.MustUnmarshal(bz, &checkpoint)
+	return checkpoint, true
+}
+
+// SetAccrualCheckpoint sets the accrual checkpoint of a delegator on a validator
+// the checkpoint is removed when it's empty
+func (k Keeper) SetAccrualCheckpoint(ctx sdk.Context, checkpoint types.AccrualCheckpoint) error {
+	if err := checkpoint.Validate(); err != nil {
+		return err
+	}
+	delAddr := sdk.MustAccAddressFromBech32(checkpoint.DelegatorAddress)
+	valAddr, err := sdk.ValAddressFromBech32(checkpoint.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if checkpoint.IsEmpty() {
+		k.DeleteAccrualCheckpoint(ctx, delAddr, valAddr)
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAccrualCheckpointKey(delAddr, valAddr), k.cdc.MustMarshal(&checkpoint))
+	return nil
+}
+
+// DeleteAccrualCheckpoint removes the accrual checkpoint of a delegator on a validator
+func (k Keeper) DeleteAccrualCheckpoint(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAccrualCheckpointKey(delAddr, valAddr))
+}
+
+// GetAllAccrualCheckpoints returns all the accrual checkpoints, used for genesis dump
+func (k Keeper) GetAllAccrualCheckpoints(ctx sdk.Context) (checkpoints []types.AccrualCheckpoint) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.AccrualCheckpointKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var checkpoint types.AccrualCheckpoint
+		k.cdc.MustUnmarshal(iterator.Value(), &checkpoint)
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	return checkpoints
+}
+
+// checkpointLockedDelegationRewards accrues the locking rewards of a pair with the locked delegation
+// that is about to change, so the new locked delegation only applies to the rewards earned from now on
+// It's called by the actions changing the locked entries weights, before the locked delegation is stored
+func (k Keeper) checkpointLockedDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	// Without a delegation there are no pending rewards, they were withdrawn when it was removed
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil
+	}
+	validator := k.stakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return nil
+	}
+
+	// Calculate the delegation rewards pending up to now and the validator reward index
+	// Calculating the pending rewards increments the validator period, so it runs on a cache wrapped
+	// context that is never written, leaving the distribution store untouched
+	cacheCtx, _ := ctx.CacheContext()
+	endingPeriod := k.distributionKeeper.IncrementValidatorPeriod(cacheCtx, validator)
+	distributionRewards := k.distributionKeeper.CalculateDelegationRewards(cacheCtx, validator, delegation, endingPeriod)
+	rewards, _ := distributionRewards.TruncateDecimal()
+	index := k.GetValidatorRewardIndex(ctx, validator)
+
+	// The share of the at maturity entries is held in escrow with the weights they earned it with
+	accrued, err := k.accrueLockedDelegationRewards(ctx, delAddr, valAddr, rewards)
+	if err != nil {
+		return err
+	}
+	return k.SetAccrualCheckpoint(ctx, types.NewAccrualCheckpoint(delAddr, valAddr, rewards, accrued, index))
+}
+
+// checkpointAllLockedDelegationRewards accrues the locking rewards of all the locked delegations
+// used before changing the reward mode so each mode only applies to the rewards earned while it was set
+func (k Keeper) checkpointAllLockedDelegationRewards(ctx sdk.Context) error {
+	for _, lockedDelegation := range k.GetAllLockedDelegations(ctx) {
+		delAddr := sdk.MustAccAddressFromBech32(lockedDelegation.DelegatorAddress)
+		valAddr, err := sdk.ValAddressFromBech32(lockedDelegation.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.checkpointLockedDelegationRewards(ctx, delAddr, valAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetValidatorRewardIndex returns the cumulative reward ratio of a validator up to now
+// this is the rewards earned by one token delegated since the validator creation
+// It's calculated the same way the distribution module ends a period, without storing a new one
+func (k Keeper) GetValidatorRewardIndex(ctx sdk.Context, validator stakingtypes.ValidatorI) sdk.DecCoins {
+	current := k.distributionKeeper.GetValidatorCurrentRewards(ctx, validator.GetOperator())
+	if current.Period == 0 {
+		return sdk.NewDecCoins()
+	}
+	historical := k.distributionKeeper.GetValidatorHistoricalRewards(ctx, validator.GetOperator(), current.Period-1).CumulativeRewardRatio
+
+	// The rewards of a validator without tokens go to the community pool, so they aren't part of the ratio
+	if validator.GetTokens().IsZero() {
+		return historical
+	}
+	return historical.Add(current.Rewards.QuoDecTruncate(sdk.NewDecFromInt(validator.GetTokens()))...)
+}
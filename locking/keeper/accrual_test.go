@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// TestLockedDelegationRewardsAccrual tests that the locking rewards are only paid for the rewards earned while locked
+func (suite *KeeperTestSuite) TestLockedDelegationRewardsAccrual() {
+	// Ensure that the hooks are set
+	suite.ensureDistributionHooksSet()
+
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr := sdk.AccAddress([]byte("address"))
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	setupDistributionHooksTest(suite, sdk.NewInt(100), delAddr, validator)
+
+	// Rewards are allocated while the delegation isn't locked
+	tokens := sdk.DecCoins{sdk.NewDecCoin(denom, sdk.TokensFromConsensusPower(1, PowerReduction))}
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, tokens)
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+	// Lock half of the delegation without withdrawing the rewards
+	// This replicates the case 'half shares, same rate' from locked_delegation_test.go with a 0.025 ratio
+	_, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(50), types.NewRate(200, sdk.NewDec(5)), false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+
+	// The rewards earned until the lock are kept on the checkpoint
+	unlockedRes, err := suite.k.LockedDelegationRewards(suite.ctx, &types.QueryLockedDelegationRewardsRequest{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	unlockedRewards, _ := unlockedRes.DistributionReward.TruncateDecimal()
+	suite.Require().False(unlockedRewards.IsZero())
+	suite.Require().True(unlockedRes.LockingReward.IsZero())
+
+	checkpoint, found := suite.k.GetAccrualCheckpoint(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(unlockedRewards, checkpoint.Rewards)
+	suite.Require().True(checkpoint.Accrued.IsZero())
+
+	// More rewards are allocated while locked
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, tokens)
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+	// The ratio is only applied to the rewards earned since the lock
+	ratio := sdk.NewDecWithPrec(25, 3)
+	res, err := suite.k.LockedDelegationRewards(suite.ctx, &types.QueryLockedDelegationRewardsRequest{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	rewards, _ := res.DistributionReward.TruncateDecimal()
+	expected := sdk.NewDecCoinsFromCoins(rewards.Sub(unlockedRewards...)...).MulDecTruncate(ratio)
+	suite.Require().Equal(expected, res.LockingReward)
+
+	// The withdraw pays the same amount and clears the checkpoint
+	initialBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+	delegationRewards, err := suite.app.DistrKeeper.WithdrawDelegationRewards(suite.ctx, delAddr, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, delegationRewards)
+
+	lockingRewards, _ := expected.TruncateDecimal()
+	suite.Require().Equal(initialBalance.Add(delegationRewards...).Add(lockingRewards...), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	_, found = suite.k.GetAccrualCheckpoint(suite.ctx, delAddr, valAddr)
+	suite.Require().False(found)
+}
+
+// TestLockedDelegationRewardsAccrualOnChange tests that the locking rewards accrued before the locks change are kept
+func (suite *KeeperTestSuite) TestLockedDelegationRewardsAccrualOnChange() {
+	// Ensure that the hooks are set
+	suite.ensureDistributionHooksSet()
+
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr := sdk.AccAddress([]byte("address"))
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	setupDistributionHooksTest(suite, sdk.NewInt(100), delAddr, validator)
+
+	_, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(50), types.NewRate(200, sdk.NewDec(5)), false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+
+	// Rewards are allocated while locked
+	tokens := sdk.DecCoins{sdk.NewDecCoin(denom, sdk.TokensFromConsensusPower(1, PowerReduction))}
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, tokens)
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+	// Lock the rest of the delegation without withdrawing the rewards
+	period := suite.app.DistrKeeper.GetValidatorCurrentRewards(suite.ctx, valAddr).Period
+	_, err = suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(50), types.NewRate(100, sdk.NewDec(10)), false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+
+	// Taking the checkpoint doesn't end the validator period
+	suite.Require().Equal(period, suite.app.DistrKeeper.GetValidatorCurrentRewards(suite.ctx, valAddr).Period)
+
+	// The locking rewards accrued with the first lock are kept on the checkpoint
+	res, err := suite.k.LockedDelegationRewards(suite.ctx, &types.QueryLockedDelegationRewardsRequest{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	rewards, _ := res.DistributionReward.TruncateDecimal()
+	expected := sdk.NewDecCoinsFromCoins(rewards...).MulDecTruncate(sdk.NewDecWithPrec(25, 3))
+	suite.Require().Equal(expected, res.LockingReward)
+
+	checkpoint, found := suite.k.GetAccrualCheckpoint(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(expected, checkpoint.Accrued)
+}
+
+// TestGetValidatorRewardIndex tests that the validator reward index is read without ending the validator period
+func (suite *KeeperTestSuite) TestGetValidatorRewardIndex() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+
+	tokens := sdk.DecCoins{sdk.NewDecCoin(denom, sdk.TokensFromConsensusPower(1, PowerReduction))}
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, tokens)
+	period := suite.app.DistrKeeper.GetValidatorCurrentRewards(suite.ctx, valAddr).Period
+
+	index := suite.k.GetValidatorRewardIndex(suite.ctx, validator)
+	suite.Require().False(index.IsZero())
+	suite.Require().Equal(period, suite.app.DistrKeeper.GetValidatorCurrentRewards(suite.ctx, valAddr).Period)
+
+	// The index matches the ratio stored when the period ends
+	cacheCtx, _ := suite.ctx.CacheContext()
+	endingPeriod := suite.app.DistrKeeper.IncrementValidatorPeriod(cacheCtx, validator)
+	suite.Require().Equal(suite.app.DistrKeeper.GetValidatorHistoricalRewards(cacheCtx, valAddr, endingPeriod).CumulativeRewardRatio, index)
+}
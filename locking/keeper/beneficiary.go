@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// GetBonusBeneficiary returns the address receiving the locking rewards of a delegator
+// A delegator without bonus beneficiary is paid on its distribution withdraw address
+func (k Keeper) GetBonusBeneficiary(ctx sdk.Context, delAddr sdk.AccAddress) (beneficiary sdk.AccAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetBonusBeneficiaryKey(delAddr))
+	if bz == nil {
+		return nil, false
+	}
+
+	var bonusBeneficiary types.BonusBeneficiary
+	k.cdc.MustUnmarshal(bz, &bonusBeneficiary)
+	return sdk.MustAccAddressFromBech32(bonusBeneficiary.BeneficiaryAddress), true
+}
+
+// SetBonusBeneficiary sets the address receiving the locking rewards of a delegator
+func (k Keeper) SetBonusBeneficiary(ctx sdk.Context, bonusBeneficiary types.BonusBeneficiary) error {
+	if err := bonusBeneficiary.Validate(); err != nil {
+		return err
+	}
+	delAddr := sdk.MustAccAddressFromBech32(bonusBeneficiary.DelegatorAddress)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBonusBeneficiaryKey(delAddr), k.cdc.MustMarshal(&bonusBeneficiary))
+	return nil
+}
+
+// DeleteBonusBeneficiary removes the bonus beneficiary of a delegator
+func (k Keeper) DeleteBonusBeneficiary(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBonusBeneficiaryKey(delAddr))
+}
+
+// GetAllBonusBeneficiaries returns all the bonus beneficiaries, used for genesis dump
+func (k Keeper) GetAllBonusBeneficiaries(ctx sdk.Context) (bonusBeneficiaries []types.BonusBeneficiary) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BonusBeneficiaryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bonusBeneficiary types.BonusBeneficiary
+		k.cdc.MustUnmarshal(iterator.Value(), &bonusBeneficiary)
+		bonusBeneficiaries = append(bonusBeneficiaries, bonusBeneficiary)
+	}
+	return bonusBeneficiaries
+}
+
+// UpdateBonusBeneficiary changes the address receiving the locking rewards of a delegator
+// an empty beneficiary removes it, so the rewards follow the distribution withdraw address again
+func (k Keeper) UpdateBonusBeneficiary(ctx sdk.Context, delAddr sdk.AccAddress, beneficiary sdk.AccAddress) error {
+	if beneficiary.Empty() {
+		k.DeleteBonusBeneficiary(ctx, delAddr)
+	} else {
+		// The rewards couldn't be sent to a blocked address
+		if k.bankKeeper.BlockedAddr(beneficiary) {
+			return types.ErrBlockedBeneficiary.Wrap(beneficiary.String())
+		}
+		if err := k.SetBonusBeneficiary(ctx, types.NewBonusBeneficiary(delAddr, beneficiary)); err != nil {
+			return err
+		}
+	}
+
+	event := &types.EventBonusBeneficiaryChanged{DelegatorAddress: delAddr.String()}
+	if !beneficiary.Empty() {
+		event.BeneficiaryAddress = beneficiary.String()
+	}
+	return ctx.EventManager().EmitTypedEvent(event)
+}
+
+// GetLockingRewardRecipient returns the address the locking rewards of a delegator are paid to
+// the bonus beneficiary if set, otherwise the distribution withdraw address, the same as the delegation rewards
+func (k Keeper) GetLockingRewardRecipient(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress {
+	if beneficiary, found := k.GetBonusBeneficiary(ctx, delAddr); found {
+		return beneficiary
+	}
+	return k.distributionKeeper.GetDelegatorWithdrawAddr(ctx, delAddr)
+}
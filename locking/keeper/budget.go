@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// GetBudgetWindow returns the current locking rewards budget window
+func (k Keeper) GetBudgetWindow(ctx sdk.Context) (window types.BudgetWindow) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.BudgetWindowKey)
+	if bz == nil {
+		return types.NewBudgetWindow(ctx.BlockTime(), sdk.NewCoins())
+	}
+
+	k.cdc.MustUnmarshal(bz, &window)
+	return window
+}
+
+// SetBudgetWindow sets the current locking rewards budget window
+func (k Keeper) SetBudgetWindow(ctx sdk.Context, window types.BudgetWindow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BudgetWindowKey, k.cdc.MustMarshal(&window))
+}
+
+// GetLockingBudgetCap returns the max amount of locking rewards minted per budget window
+// The supply fraction budget is calculated with the current bond denom supply
+func (k Keeper) GetLockingBudgetCap(ctx sdk.Context, params types.Params) sdk.Coins {
+	switch params.BudgetType {
+	case types.BudgetTypeAbsolute:
+		return params.BudgetAmount
+	case types.BudgetTypeSupplyFraction:
+		supply := k.bankKeeper.GetSupply(ctx, k.stakingKeeper.BondDenom(ctx))
+		amount := sdk.NewDecFromInt(supply.Amount).
+			Mul(params.GetBudgetSupplyFraction()).
+			MulInt64(int64(params.BudgetWindow)).
+			QuoInt64(int64(types.BudgetYear)).
+			TruncateInt()
+		return sdk.NewCoins(sdk.NewCoin(supply.Denom, amount))
+	}
+	return sdk.NewCoins()
+}
+
+// consumeLockingBudget caps the amount to mint to what's left on the budget window
+// It returns the amount that can be minted and the amount over the budget
+func (k Keeper) consumeLockingBudget(ctx sdk.Context, params types.Params, amount sdk.Coins) (minted sdk.Coins, overBudget sdk.Coins) {
+	if params.BudgetType == types.BudgetTypeNone || amount.IsZero() {
+		return amount, sdk.NewCoins()
+	}
+
+	window := k.currentBudgetWindow(ctx, params)
+	minted = amount.Min(window.Remaining(k.GetLockingBudgetCap(ctx, params)))
+	window.Consumed = window.Consumed.Add(minted...)
+	k.SetBudgetWindow(ctx, window)
+
+	return minted, amount.Sub(minted...)
+}
+
+// currentBudgetWindow returns the current budget window, starting a new one if the previous is over
+func (k Keeper) currentBudgetWindow(ctx sdk.Context, params types.Params) types.BudgetWindow {
+	window := k.GetBudgetWindow(ctx)
+	if !ctx.BlockTime().Before(window.Start.Add(params.BudgetWindow)) {
+		window = types.NewBudgetWindow(ctx.BlockTime(), sdk.NewCoins())
+	}
+	return window
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// setBudgetParams sets an absolute budget per hour with the given action
+func setBudgetParams(suite *KeeperTestSuite, amount sdk.Coins, action types.BudgetExceededAction) {
+	params := suite.k.GetParams(suite.ctx)
+	params.BudgetType = types.BudgetTypeAbsolute
+	params.BudgetAmount = amount
+	params.BudgetWindow = time.Hour
+	params.BudgetExceededAction = action
+	suite.Require().NoError(suite.k.SetParams(suite.ctx, params))
+}
+
+// TestLockingBudgetDefer tests the rewards over the budget being kept as debt
+func (suite *KeeperTestSuite) TestLockingBudgetDefer() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr := setupFundingTest(suite)
+	setBudgetParams(suite, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), types.BudgetExceededActionDefer)
+
+	// Rewards of 10000 result in 132 locking rewards
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000))
+	initialBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+
+	// Only 100 is minted and the remaining is deferred
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	err := suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialBalance.Add(sdk.NewInt64Coin(denom, 100)), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 32)), suite.k.GetRewardDebt(suite.ctx, delAddr, valAddr))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), suite.k.GetBudgetWindow(suite.ctx).Consumed)
+
+	found := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeLockingBudgetExceeded {
+			found = true
+		}
+	}
+	suite.Require().True(found)
+
+	// The budget is exhausted for the window, so everything is deferred
+	err = suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialBalance.Add(sdk.NewInt64Coin(denom, 100)), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 32+132)), suite.k.GetRewardDebt(suite.ctx, delAddr, valAddr))
+
+	// On a new window the debt is paid up to the budget
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	res, err := suite.msgSrvr.ClaimRewardDebt(suite.ctx, types.NewMsgClaimRewardDebt(delAddr, valAddr))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), res.Amount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 64)), res.RemainingDebt)
+	suite.Require().Equal(suite.ctx.BlockTime(), suite.k.GetBudgetWindow(suite.ctx).Start)
+}
+
+// TestLockingBudgetTruncate tests the rewards over the budget being dropped
+func (suite *KeeperTestSuite) TestLockingBudgetTruncate() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr := setupFundingTest(suite)
+	setBudgetParams(suite, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), types.BudgetExceededActionTruncate)
+
+	// Rewards of 10000 result in 132 locking rewards
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000))
+	initialBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+
+	// Only 100 is paid and the remaining is dropped
+	err := suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialBalance.Add(sdk.NewInt64Coin(denom, 100)), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	suite.Require().True(suite.k.GetRewardDebt(suite.ctx, delAddr, valAddr).IsZero())
+
+	// The payouts aren't scaled, the budget is exhausted so the next payout on the window gets nothing
+	// A previous debt isn't dropped with the new rewards
+	err = suite.k.SetRewardDebt(suite.ctx, types.NewRewardDebt(delAddr, valAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	suite.Require().NoError(err)
+	err = suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialBalance.Add(sdk.NewInt64Coin(denom, 100)), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 10)), suite.k.GetRewardDebt(suite.ctx, delAddr, valAddr))
+}
+
+// TestLockingBudgetSupplyFraction tests the budget cap calculated from the bond denom supply
+func (suite *KeeperTestSuite) TestLockingBudgetSupplyFraction() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	params := suite.k.GetParams(suite.ctx)
+	params.BudgetType = types.BudgetTypeSupplyFraction
+	params.BudgetSupplyFraction = sdk.NewDecWithPrec(5, 2)
+	params.BudgetWindow = types.BudgetYear / 10
+	suite.Require().NoError(suite.k.SetParams(suite.ctx, params))
+
+	// A tenth of the year budget is available per window
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, denom)
+	expected := sdk.NewCoins(sdk.NewCoin(denom, supply.Amount.MulRaw(5).QuoRaw(1000)))
+	suite.Require().Equal(expected, suite.k.GetLockingBudgetCap(suite.ctx, params))
+}
+
+// TestGRPCLockingBudget tests the LockingBudget from the query server
+func (suite *KeeperTestSuite) TestGRPCLockingBudget() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+
+	_, err := suite.k.LockingBudget(suite.ctx, nil)
+	suite.Require().Error(err)
+
+	// Without a budget there's no cap
+	res, err := suite.k.LockingBudget(suite.ctx, &types.QueryLockingBudgetRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Cap.IsZero())
+
+	setBudgetParams(suite, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), types.BudgetExceededActionDefer)
+	suite.k.SetBudgetWindow(suite.ctx, types.NewBudgetWindow(suite.ctx.BlockTime(), sdk.NewCoins(sdk.NewInt64Coin(denom, 30))))
+
+	res, err = suite.k.LockingBudget(suite.ctx, &types.QueryLockingBudgetRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), res.Cap)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 30)), res.Window.Consumed)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 70)), res.Remaining)
+
+	// Once the window is over the whole budget is available
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	res, err = suite.k.LockingBudget(suite.ctx, &types.QueryLockingBudgetRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Window.Consumed.IsZero())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), res.Remaining)
+}
@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// ErrCompoundLockingRewardsPanic is the error of a pair whose compounding panicked
+const ErrCompoundLockingRewardsPanic = "compounding the locking rewards panicked: %v"
+
+// queuedCompound is a pair with the locking rewards waiting to be compounded
+type queuedCompound struct {
+	delAddr sdk.AccAddress
+	valAddr sdk.ValAddress
+	amount  math.Int
+}
+
+// SetLockedDelegationAutoCompound sets if the locking rewards of a locked delegation are compounded
+func (k Keeper) SetLockedDelegationAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, autoCompound bool) error {
+	lockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrLockedDelegationNotFound
+	}
+
+	lockedDelegation.AutoCompound = autoCompound
+	if err := k.SetLockedDelegation(ctx, lockedDelegation); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAutoCompoundChanged{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		AutoCompound:     autoCompound,
+	})
+}
+
+// IsAutoCompounded returns if the locking rewards of a delegator on a validator are compounded
+func (k Keeper) IsAutoCompounded(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	lockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, valAddr)
+	return found && lockedDelegation.AutoCompound
+}
+
+// sendLockingRewards sends the locking rewards of a pair from a module account
+// The bond denom of a compounded pair is delegated by the delegator, so it's sent to it,
+// the other denoms follow the locking reward recipient
+func (k Keeper) sendLockingRewards(ctx sdk.Context, module string, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coins) error {
+	if k.IsAutoCompounded(ctx, delAddr, valAddr) {
+		bondDenom := k.stakingKeeper.BondDenom(ctx)
+		compounded := sdk.NewCoins(sdk.NewCoin(bondDenom, amount.AmountOf(bondDenom)))
+		if !compounded.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, module, delAddr, compounded); err != nil {
+				return err
+			}
+		}
+		amount = amount.Sub(compounded...)
+	}
+	if amount.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, module, k.GetLockingRewardRecipient(ctx, delAddr), amount)
+}
+
+// queueLockingRewardCompound queues the bond denom locking rewards paid to a pair to be compounded at the end of the block
+// The staking hooks are running while the rewards are paid, so they can't be delegated right away
+func (k Keeper) queueLockingRewardCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetCompoundQueueKey(delAddr, valAddr)
+	if bz := store.Get(key); bz != nil {
+		var queued math.Int
+		if err := queued.Unmarshal(bz); err != nil {
+			return err
+		}
+		amount = amount.Add(queued)
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// SetLockingRewardCompound sets the locking rewards of a pair waiting to be compounded, used for genesis import
+func (k Keeper) SetLockingRewardCompound(ctx sdk.Context, compound types.LockingRewardCompound) error {
+	if err := compound.Validate(); err != nil {
+		return err
+	}
+	delAddr := sdk.MustAccAddressFromBech32(compound.DelegatorAddress)
+	valAddr, err := sdk.ValAddressFromBech32(compound.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	bz, err := compound.Amount.Marshal()
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCompoundQueueKey(delAddr, valAddr), bz)
+	return nil
+}
+
+// GetAllLockingRewardCompounds returns all the pairs waiting to be compounded, used for genesis dump
+func (k Keeper) GetAllLockingRewardCompounds(ctx sdk.Context) (compounds []types.LockingRewardCompound) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.CompoundQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		compound, err := parseQueuedCompound(iterator.Key(), iterator.Value())
+		if err != nil {
+			continue
+		}
+		compounds = append(compounds, types.NewLockingRewardCompound(compound.delAddr, compound.valAddr, compound.amount))
+	}
+	return compounds
+}
+
+// dequeueLockingRewardCompounds returns and removes all the pairs waiting to be compounded
+func (k Keeper) dequeueLockingRewardCompounds(ctx sdk.Context) (compounds []queuedCompound) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.CompoundQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+
+		compound, err := parseQueuedCompound(iterator.Key(), iterator.Value())
+		if err != nil {
+			continue
+		}
+		compounds = append(compounds, compound)
+	}
+	return compounds
+}
+
+// parseQueuedCompound returns the pair and the amount of a compound queue entry
+func parseQueuedCompound(key, value []byte) (queuedCompound, error) {
+	delAddr, valAddr, err := types.ParseCompoundQueueKey(key[len(types.CompoundQueueKey):])
+	if err != nil {
+		return queuedCompound{}, err
+	}
+	var amount math.Int
+	if err := amount.Unmarshal(value); err != nil {
+		return queuedCompound{}, err
+	}
+	return queuedCompound{delAddr: delAddr, valAddr: valAddr, amount: amount}, nil
+}
+
+// ProcessLockingRewardCompounds delegates the queued locking rewards and adds them to the locked delegations
+// The pairs that fail keep their rewards liquid instead of halting the chain
+func (k Keeper) ProcessLockingRewardCompounds(ctx sdk.Context) {
+	for _, compound := range k.dequeueLockingRewardCompounds(ctx) {
+		if err := k.compoundPair(ctx, compound); err != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeLockingRewardCompoundFailed,
+					sdk.NewAttribute(types.AttributeKeyDelegator, compound.delAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyValidator, compound.valAddr.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, compound.amount.String()),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+		}
+	}
+}
+
+// compoundPair compounds the locking rewards of a pair on a cached context
+// the changes are only written when it succeeds, and a panic is returned as an error
+func (k Keeper) compoundPair(ctx sdk.Context, compound queuedCompound) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf(ErrCompoundLockingRewardsPanic, r)
+		}
+	}()
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.CompoundLockingRewards(cacheCtx, compound.delAddr, compound.valAddr, compound.amount); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// CompoundLockingRewards delegates locking rewards paid to a delegator and adds the shares to the entry
+// of its locked delegation with the latest unlock time, so no new entry is created
+// Nothing is compounded if the compounding was disabled or there's no locked entry left
+func (k Keeper) CompoundLockingRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) error {
+	lockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, valAddr)
+	if !found || !lockedDelegation.AutoCompound {
+		return nil
+	}
+	entry, found := lockedDelegation.LatestUnlockingEntry(ctx.BlockTime())
+	if !found {
+		return nil
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+
+	// The paid rewards could have been spent since they were paid
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	amount = math.MinInt(amount, k.bankKeeper.SpendableCoins(ctx, delAddr).AmountOf(bondDenom))
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	shares, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true)
+	if err != nil {
+		return err
+	}
+
+	// The delegation withdraws the rewards, so the locked delegation is read again
+	lockedDelegation, found = k.GetLockedDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrLockedDelegationNotFound
+	}
+	entry.Shares = shares
+	entry = lockedDelegation.AddEntry(entry)
+	if err := k.checkpointLockedDelegationRewards(ctx, delAddr, valAddr); err != nil {
+		return err
+	}
+	if err := k.SetLockedDelegation(ctx, lockedDelegation); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventLockingRewardCompounded{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           sdk.NewCoin(bondDenom, amount),
+		Entry:            entry,
+	})
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// TestAutoCompound tests the locking rewards delegated and added to the locked delegation
+func (suite *KeeperTestSuite) TestAutoCompound() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr := setupFundingTest(suite)
+	withdrawAddr := sdk.AccAddress([]byte("withdraw"))
+	suite.Require().NoError(suite.app.DistrKeeper.SetWithdrawAddr(suite.ctx, delAddr, withdrawAddr))
+
+	// Rewards of 10000 result in 132 locking rewards
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000))
+	lockingRewards := sdk.NewInt64Coin(denom, 132)
+
+	// The preference needs a locked delegation
+	_, err := suite.msgSrvr.SetAutoCompound(suite.ctx, types.NewMsgSetAutoCompound(sdk.AccAddress([]byte("other")), valAddr, true))
+	suite.Require().ErrorIs(err, types.ErrLockedDelegationNotFound)
+
+	_, err = suite.msgSrvr.SetAutoCompound(suite.ctx, types.NewMsgSetAutoCompound(delAddr, valAddr, true))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.k.IsAutoCompounded(suite.ctx, delAddr, valAddr))
+
+	lockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	delBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+
+	// The compounded rewards are paid to the delegator instead of the withdraw address
+	err = suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(delBalance.Add(lockingRewards), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, withdrawAddr).IsZero())
+
+	// At the end of the block they are delegated and added to the entry with the latest unlock time
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.k.EndBlock(suite.ctx)
+	suite.Require().Equal(delBalance, suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+
+	compoundedDelegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	compoundedShares := compoundedDelegation.Shares.Sub(delegation.Shares)
+	suite.Require().True(compoundedShares.IsPositive())
+
+	compoundedLockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Len(compoundedLockedDelegation.Entries, len(lockedDelegation.Entries))
+	suite.Require().Equal(lockedDelegation.TotalShares().Add(compoundedShares), compoundedLockedDelegation.TotalShares())
+
+	latest, found := lockedDelegation.LatestUnlockingEntry(suite.ctx.BlockTime())
+	suite.Require().True(found)
+	latest.Shares = latest.Shares.Add(compoundedShares)
+	suite.Require().Contains(compoundedLockedDelegation.Entries, latest)
+	suite.Require().Equal([]proto.Message{
+		&types.EventLockingRewardCompounded{
+			DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Amount: lockingRewards, Entry: latest,
+		},
+	}, typedEvents(suite, &types.EventLockingRewardCompounded{}))
+
+	// Once disabled the rewards follow the withdraw address again and nothing is compounded
+	_, err = suite.msgSrvr.SetAutoCompound(suite.ctx, types.NewMsgSetAutoCompound(delAddr, valAddr, false))
+	suite.Require().NoError(err)
+	err = suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(lockingRewards), suite.app.BankKeeper.GetAllBalances(suite.ctx, withdrawAddr))
+
+	suite.k.EndBlock(suite.ctx)
+	lockedDelegation, found = suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(compoundedLockedDelegation.TotalShares(), lockedDelegation.TotalShares())
+}
+
+// TestAutoCompoundSpentRewards tests the compounding of locking rewards spent before the end of the block
+func (suite *KeeperTestSuite) TestAutoCompoundSpentRewards() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr := setupFundingTest(suite)
+	suite.Require().NoError(suite.k.SetLockedDelegationAutoCompound(suite.ctx, delAddr, valAddr, true))
+
+	lockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+
+	// The delegator spends all its balance after the rewards are paid
+	err := suite.afterWithdrawDelegationRewards(delAddr, valAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 10000)))
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, delAddr, sdk.AccAddress([]byte("other")), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	suite.Require().NoError(err)
+
+	// Nothing is left to compound
+	suite.k.EndBlock(suite.ctx)
+	compoundedLockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(lockedDelegation.TotalShares(), compoundedLockedDelegation.TotalShares())
+}
+
+// TestAutoCompoundOtherDenoms tests that only the bond denom locking rewards of a compounded pair are paid to the delegator
+// The other denoms can't be delegated, so they follow the withdraw address
+func (suite *KeeperTestSuite) TestAutoCompoundOtherDenoms() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr := setupFundingTest(suite)
+	withdrawAddr := sdk.AccAddress([]byte("withdraw"))
+	suite.Require().NoError(suite.app.DistrKeeper.SetWithdrawAddr(suite.ctx, delAddr, withdrawAddr))
+	suite.Require().NoError(suite.k.SetLockedDelegationAutoCompound(suite.ctx, delAddr, valAddr, true))
+	delBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+
+	// Rewards of 10000 result in 132 locking rewards on each denom
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000), sdk.NewInt64Coin("other", 10000))
+	err := suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(delBalance.Add(sdk.NewInt64Coin(denom, 132)), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("other", 132)), suite.app.BankKeeper.GetAllBalances(suite.ctx, withdrawAddr))
+
+	// Only the bond denom is compounded
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.k.EndBlock(suite.ctx)
+	suite.Require().Equal(delBalance, suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	events := typedEvents(suite, &types.EventLockingRewardCompounded{})
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 132), events[0].(*types.EventLockingRewardCompounded).Amount)
+}
+
+// TestAutoCompoundSpendableCap tests that no more than the delegator spendable balance is compounded
+func (suite *KeeperTestSuite) TestAutoCompoundSpendableCap() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr := setupFundingTest(suite)
+	suite.Require().NoError(suite.k.SetLockedDelegationAutoCompound(suite.ctx, delAddr, valAddr, true))
+
+	// The delegator spends all but 50 of its balance after the 132 locking rewards are paid
+	err := suite.afterWithdrawDelegationRewards(delAddr, valAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 10000)))
+	suite.Require().NoError(err)
+	spent := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr).Sub(sdk.NewInt64Coin(denom, 50))
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, delAddr, sdk.AccAddress([]byte("other")), spent)
+	suite.Require().NoError(err)
+
+	// Only what's left is compounded
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.k.EndBlock(suite.ctx)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr).IsZero())
+	events := typedEvents(suite, &types.EventLockingRewardCompounded{})
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 50), events[0].(*types.EventLockingRewardCompounded).Amount)
+}
@@ -1,19 +1,64 @@
 package keeper
 
 import (
+	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/aetherevm/locking/locking/types"
 )
 
-// CalculateLockedDelegationRewards calculates the locked delegation rewards for a validator
-// we use a ratio from all the locked delegation weights and the delegation shares
+// CalculateLockedDelegationRewards calculates the locked delegation rewards paid for a validator
+// these are the rewards on every distribution denom with the params reward denom policy applied
 func (k Keeper) CalculateLockedDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) sdk.DecCoins {
-	// Return if rewards is zero
-	if rewards.IsZero() {
-		return sdk.NewDecCoins()
+	return k.applyRewardDenomPolicy(ctx, k.calculateLockedDelegationRewards(ctx, delAddr, valAddr, rewards))
+}
+
+// calculateLockedDelegationRewards calculates the locked delegation rewards for a validator on every distribution denom
+// these are the locking rewards accrued on the pair accrual checkpoint with the ones earned since it
+// The rewards already held in escrow for the at maturity entries aren't part of them
+func (k Keeper) calculateLockedDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) sdk.DecCoins {
+	checkpoint, _ := k.GetAccrualCheckpoint(ctx, delAddr, valAddr)
+	accrued := sdk.NewDecCoins(checkpoint.Accrued...)
+
+	lockingRewards := k.calculateEarnedLockingRewards(ctx, delAddr, valAddr, checkpoint, rewards)
+	if accrued.IsZero() {
+		return lockingRewards
+	}
+	return lockingRewards.Add(accrued...)
+}
+
+// accrueLockedDelegationRewards calculates the locked delegation rewards for a validator on every distribution denom,
+// holding the share of the rewards earned since the pair accrual checkpoint by the at maturity entries in escrow
+// It's called with the locked delegation the rewards were earned with still in store
+func (k Keeper) accrueLockedDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) (sdk.DecCoins, error) {
+	checkpoint, _ := k.GetAccrualCheckpoint(ctx, delAddr, valAddr)
+	accrued := sdk.NewDecCoins(checkpoint.Accrued...)
+
+	lockingRewards := k.calculateEarnedLockingRewards(ctx, delAddr, valAddr, checkpoint, rewards)
+	if lockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, valAddr); found {
+		var err error
+		lockingRewards, err = k.escrowLockingRewards(ctx, lockedDelegation, lockingRewards)
+		if err != nil {
+			return nil, err
+		}
 	}
+	if accrued.IsZero() {
+		return lockingRewards, nil
+	}
+	return lockingRewards.Add(accrued...), nil
+}
 
+// calculateEarnedLockingRewards calculates the locking rewards earned since the pair accrual checkpoint
+// we use a ratio from all the locked delegation weights and the delegation shares
+// The locking rewards accrued before the checkpoint were calculated with the locked delegation of that time
+// On the standalone reward mode the rewards earned are taken from the validator reward index instead
+func (k Keeper) calculateEarnedLockingRewards(
+	ctx sdk.Context,
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	checkpoint types.AccrualCheckpoint,
+	rewards sdk.Coins,
+) sdk.DecCoins {
 	// Fetch the normal distribution rewards
 	delegation, found := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
 	if !found {
@@ -25,47 +70,206 @@ func (k Keeper) CalculateLockedDelegationRewards(ctx sdk.Context, delAddr sdk.Ac
 		return sdk.NewDecCoins()
 	}
 
+	// Calculate the rewards earned since the checkpoint
+	var earned sdk.DecCoins
+	if k.GetParams(ctx).RewardMode == types.RewardModeStandalone {
+		validator := k.stakingKeeper.Validator(ctx, valAddr)
+		if validator == nil {
+			return sdk.NewDecCoins()
+		}
+		stake := validator.TokensFromShares(delegation.Shares)
+		earned = checkpoint.EarnedSinceIndex(k.GetValidatorRewardIndex(ctx, validator), stake)
+	} else {
+		earned = sdk.NewDecCoinsFromCoins(checkpoint.EarnedSince(rewards)...)
+	}
+
+	// Return if nothing was earned since the checkpoint
+	if earned.IsZero() {
+		return sdk.NewDecCoins()
+	}
+
 	// Calculate the reward rate by the entries and the delegation
 	ratio := lockedDelegation.CalculateDelegationRatio(delegation.Shares)
 
-	// Convert the tokens to DecCoins and apply the ratio
-	rewardsDecCoins := sdk.NewDecCoinsFromCoins(rewards...)
-	return rewardsDecCoins.MulDecTruncate(ratio)
+	// Apply the ratio to the earned rewards
+	return earned.MulDecTruncate(ratio)
 }
 
-// withdrawLockedDelegationRewards does the minting of new coins on top of delegation rewards withdraw
-// currently we are minting the new rewards
+// applyRewardDenomPolicy returns the locking rewards paid with the params reward denom policy
+// The checkpoints accrue the rewards on every denom, so the policy is only applied when paying or reporting them
+func (k Keeper) applyRewardDenomPolicy(ctx sdk.Context, rewards sdk.DecCoins) sdk.DecCoins {
+	params := k.GetParams(ctx)
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	paid := sdk.NewDecCoins()
+	for _, reward := range rewards {
+		switch params.RewardDenomPolicy {
+		case types.RewardDenomPolicyAllowList:
+			if params.IsRewardDenomAllowed(reward.Denom) {
+				paid = paid.Add(reward)
+			}
+		case types.RewardDenomPolicyBondDenom:
+			// The bond denom is paid as is, other denoms are converted with their fixed price
+			if reward.Denom == bondDenom {
+				paid = paid.Add(reward)
+			} else if price, found := params.GetRewardDenomPrice(reward.Denom); found {
+				paid = paid.Add(sdk.NewDecCoinFromDec(bondDenom, reward.Amount.Mul(price)))
+			}
+		case types.RewardDenomPolicyNativeOnly:
+			if reward.Denom == bondDenom {
+				paid = paid.Add(reward)
+			}
+		default:
+			paid = paid.Add(reward)
+		}
+	}
+	return paid
+}
+
+// EstimateLockedRewards returns the pending distribution and locking rewards of a delegator on a validator
+// The locking rewards are the ones a withdraw pays, the share of the at maturity entries is held in escrow instead
+// and the remainder carried over from the previous payout is included
+// Calculating the pending rewards increments the validator period, so the estimation runs on a
+// cache wrapped context that is never written, leaving the store untouched
+func (k Keeper) EstimateLockedRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (types.LockedDelegationDelegatorReward, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	return k.estimateLockedRewards(cacheCtx, delAddr, valAddr)
+}
+
+// estimateLockedRewards estimates the pending rewards of a delegator on a validator, accruing them like a withdraw
+// It changes the state, so it must run on a cache wrapped context that is never written
+func (k Keeper) estimateLockedRewards(cacheCtx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (types.LockedDelegationDelegatorReward, error) {
+	// Find the validator and delegation
+	val := k.stakingKeeper.Validator(cacheCtx, valAddr)
+	if val == nil {
+		return types.LockedDelegationDelegatorReward{}, sdkerrors.Wrap(types.ErrNoValidatorExists, valAddr.String())
+	}
+	del := k.stakingKeeper.Delegation(cacheCtx, delAddr, valAddr)
+	if del == nil {
+		return types.LockedDelegationDelegatorReward{}, types.ErrNoDelegationExists
+	}
+
+	// Calculate the delegation rewards
+	endingPeriod := k.distributionKeeper.IncrementValidatorPeriod(cacheCtx, val)
+	distributionRewards := k.distributionKeeper.CalculateDelegationRewards(cacheCtx, val, del, endingPeriod)
+	rewards, _ := distributionRewards.TruncateDecimal()
+
+	// Accrue the locking rewards, holding the share of the at maturity entries in escrow
+	// the remainder of the previous payout is paid with them
+	accrued, err := k.accrueLockedDelegationRewards(cacheCtx, delAddr, valAddr, rewards)
+	if err != nil {
+		return types.LockedDelegationDelegatorReward{}, err
+	}
+	remainder := k.GetRewardRemainder(cacheCtx, delAddr, valAddr)
+	lockingRewards := k.applyRewardDenomPolicy(cacheCtx, accrued).Add(remainder...)
+	return types.LockedDelegationDelegatorReward{
+		ValidatorAddress:       valAddr.String(),
+		DistributionReward:     distributionRewards,
+		LockingReward:          lockingRewards,
+		Total:                  distributionRewards.Add(lockingRewards...),
+		LockingRewardRemainder: remainder,
+	}, nil
+}
+
+// EstimateLockedDelegationEntryBonus returns the pending locking rewards of a pair earned by one of its entries
+// An at maturity entry that is still locked earns its escrow, with the share of the pending rewards held for it
+// The other entries earn their share of the rewards paid right away, taken by the entry weight on the current
+// locked delegation, so it's an estimate for the rewards accrued before the last checkpoint
+func (k Keeper) EstimateLockedDelegationEntryBonus(ctx sdk.Context, lockedDelegation types.LockedDelegation, id uint64) (sdk.DecCoins, error) {
+	valAddr, err := lockedDelegation.GetValidatorAddr()
+	if err != nil {
+		return nil, err
+	}
+	exists, entries := lockedDelegation.EntriesForIds([]uint64{id})
+	if !exists {
+		return nil, types.ErrLockedDelegationEntryNotFound
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	reward, err := k.estimateLockedRewards(cacheCtx, lockedDelegation.GetDelegatorAddr(), valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if entries[0].EscrowsRewards(ctx.BlockTime()) {
+		return k.GetEntryEscrow(cacheCtx, id), nil
+	}
+	return reward.LockingReward.MulDecTruncate(lockedDelegation.StreamingRewardShare(id, ctx.BlockTime())), nil
+}
+
+// withdrawLockedDelegationRewards pays the locking rewards on top of delegation rewards withdraw
+// the rewards are funded depending on the params funding mode, what can't be paid is kept as debt
 func (k Keeper) withdrawLockedDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) (sdk.Coins, error) {
-	// Calculate the rewards on top of the normal delegation rewards
-	rewardsRaw := k.CalculateLockedDelegationRewards(ctx, delAddr, valAddr, rewards)
+	// Calculate the rewards on top of the normal delegation rewards, the at maturity entries share is held in escrow
+	accrued, err := k.accrueLockedDelegationRewards(ctx, delAddr, valAddr, rewards)
+	if err != nil {
+		return nil, err
+	}
+	rewardsRaw := k.applyRewardDenomPolicy(ctx, accrued)
 
-	// Truncate reward dec coins, we don't care about remainder at this point
-	// this also converts the DecCoins to Coins
-	finalRewards, _ := rewardsRaw.TruncateDecimal()
+	// The delegation rewards were withdrawn, so the accrual starts again
+	k.DeleteAccrualCheckpoint(ctx, delAddr, valAddr)
 
-	// if the rewards is not zero, we are safe to mint and send the rewards to the delegator
-	if !finalRewards.IsZero() {
-		// Mint new tokens
-		err := k.bankKeeper.MintCoins(ctx, types.ModuleName, finalRewards)
-		if err != nil {
-			return nil, err
-		}
-		// Send to the delegator address
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, finalRewards)
-		if err != nil {
+	return k.payLockedDelegationRewards(ctx, delAddr, valAddr, rewardsRaw)
+}
+
+// WithdrawLockingRewards pays the locking rewards accrued by a delegator on a validator
+// only available on the standalone reward mode, where the rewards aren't paid on the distribution withdraw
+func (k Keeper) WithdrawLockingRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	if k.GetParams(ctx).RewardMode != types.RewardModeStandalone {
+		return nil, types.ErrRewardModeNotStandalone
+	}
+
+	_, hasLockedDelegation := k.GetLockedDelegation(ctx, delAddr, valAddr)
+	if _, found := k.GetAccrualCheckpoint(ctx, delAddr, valAddr); !found && !hasLockedDelegation {
+		return nil, types.ErrLockedDelegationNotFound
+	}
+
+	// Accrue the locking rewards up to now
+	if err := k.checkpointLockedDelegationRewards(ctx, delAddr, valAddr); err != nil {
+		return nil, err
+	}
+	checkpoint, _ := k.GetAccrualCheckpoint(ctx, delAddr, valAddr)
+	rewardsRaw := k.applyRewardDenomPolicy(ctx, checkpoint.Accrued)
+
+	// The accrual starts again from the current index, without a locked delegation there's nothing left to accrue
+	if hasLockedDelegation {
+		checkpoint.Accrued = sdk.NewDecCoins()
+		if err := k.SetAccrualCheckpoint(ctx, checkpoint); err != nil {
 			return nil, err
 		}
+	} else {
+		k.DeleteAccrualCheckpoint(ctx, delAddr, valAddr)
+	}
+
+	return k.payLockedDelegationRewards(ctx, delAddr, valAddr, rewardsRaw)
+}
+
+// payLockedDelegationRewards pays locking rewards and emits the withdraw event
+func (k Keeper) payLockedDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewardsRaw sdk.DecCoins) (sdk.Coins, error) {
+	// Truncate reward dec coins together with the previous remainder, this also converts the DecCoins to Coins
+	// the new remainder is carried over to the next payout
+	finalRewards, remainder := rewardsRaw.Add(k.GetRewardRemainder(ctx, delAddr, valAddr)...).TruncateDecimal()
+	if err := k.SetRewardRemainder(ctx, types.NewRewardRemainder(delAddr, valAddr, remainder)); err != nil {
+		return nil, err
+	}
+
+	// Pay the rewards together with any previous debt
+	paid, debt, err := k.payLockingRewards(ctx, delAddr, valAddr, finalRewards)
+	if err != nil {
+		return nil, err
 	}
 
 	// Emit the rewards collection event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeWithdrawLockedDelegationRewards,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, finalRewards.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, paid.String()),
+			sdk.NewAttribute(types.AttributeKeyDebt, debt.String()),
 			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
 			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, k.GetLockingRewardRecipient(ctx, delAddr).String()),
 		),
 	)
 
-	return finalRewards, nil
+	return paid, nil
 }
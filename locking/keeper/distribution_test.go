@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/aetherevm/locking/locking/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -245,3 +246,208 @@ func (suite *KeeperTestSuite) TestCalculateLockedDelegationRewards() {
 		suite.Require().Equal(tc.expectedLockingReward, reward, tc.name)
 	}
 }
+
+// TestWithdrawLockingRewardsStandalone tests the locking rewards withdraw on the standalone reward mode
+func (suite *KeeperTestSuite) TestWithdrawLockingRewardsStandalone() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr := sdk.AccAddress([]byte("address"))
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	setupDistributionHooksTest(suite, sdk.NewInt(100), delAddr, validator)
+
+	// The withdraw isn't available on the hook reward mode
+	_, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(50), types.NewRate(200, sdk.NewDec(5)), false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+	_, err = suite.k.WithdrawLockingRewards(suite.ctx, delAddr, valAddr)
+	suite.Require().ErrorIs(err, types.ErrRewardModeNotStandalone)
+
+	params := suite.k.GetParams(suite.ctx)
+	params.RewardMode = types.RewardModeStandalone
+	suite.Require().NoError(suite.k.SetParams(suite.ctx, params))
+
+	// Rewards are allocated while locked, with a 0.025 ratio
+	tokens := sdk.DecCoins{sdk.NewDecCoin(denom, sdk.TokensFromConsensusPower(1, PowerReduction))}
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, tokens)
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+	res, err := suite.k.LockedDelegationRewards(suite.ctx, &types.QueryLockedDelegationRewardsRequest{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.DistributionReward.MulDecTruncate(sdk.NewDecWithPrec(25, 3)), res.LockingReward)
+
+	// The distribution withdraw doesn't pay the locking rewards
+	initialBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+	delegationRewards, err := suite.app.DistrKeeper.WithdrawDelegationRewards(suite.ctx, delAddr, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialBalance.Add(delegationRewards...), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+
+	// The locking rewards are paid by their own withdraw
+	lockingRewards, _ := res.LockingReward.TruncateDecimal()
+	paid, err := suite.k.WithdrawLockingRewards(suite.ctx, delAddr, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(lockingRewards, paid)
+	suite.Require().Equal(initialBalance.Add(delegationRewards...).Add(paid...), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+
+	// Nothing is left to withdraw until more rewards are allocated
+	paid, err = suite.k.WithdrawLockingRewards(suite.ctx, delAddr, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().True(paid.IsZero())
+}
+
+// TestEstimateLockedRewards tests that the rewards estimation doesn't change the state
+func (suite *KeeperTestSuite) TestEstimateLockedRewards() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr := sdk.AccAddress([]byte("address"))
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	setupDistributionHooksTest(suite, sdk.NewInt(100), delAddr, validator)
+
+	// Lock half of the delegation, with a 0.025 ratio
+	_, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(50), types.NewRate(200, sdk.NewDec(5)), false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+
+	tokens := sdk.DecCoins{sdk.NewDecCoin(denom, sdk.TokensFromConsensusPower(1, PowerReduction))}
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, tokens)
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+	// The estimation doesn't increment the validator period
+	period := suite.app.DistrKeeper.GetValidatorCurrentRewards(suite.ctx, valAddr).Period
+	reward, err := suite.k.EstimateLockedRewards(suite.ctx, delAddr, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(period, suite.app.DistrKeeper.GetValidatorCurrentRewards(suite.ctx, valAddr).Period)
+
+	suite.Require().Equal(valAddr.String(), reward.ValidatorAddress)
+	rewards, _ := reward.DistributionReward.TruncateDecimal()
+	suite.Require().False(rewards.IsZero())
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(rewards...).MulDecTruncate(sdk.NewDecWithPrec(25, 3)), reward.LockingReward)
+	suite.Require().Equal(reward.DistributionReward.Add(reward.LockingReward...), reward.Total)
+
+	// Estimating again returns the same rewards
+	again, err := suite.k.EstimateLockedRewards(suite.ctx, delAddr, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(reward, again)
+
+	// A pair without delegation can't be estimated
+	_, err = suite.k.EstimateLockedRewards(suite.ctx, sdk.AccAddress([]byte("other")), valAddr)
+	suite.Require().ErrorIs(err, types.ErrNoDelegationExists)
+}
+
+// TestRewardDenomPolicy tests that the reward denom policy is applied to the estimated and paid locking rewards
+func (suite *KeeperTestSuite) TestRewardDenomPolicy() {
+	// Ensure that the hooks are set
+	suite.ensureDistributionHooksSet()
+
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	ibcDenom := "uatom"
+	delAddr := sdk.AccAddress([]byte("address"))
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	setupDistributionHooksTest(suite, sdk.NewInt(100), delAddr, validator)
+
+	// Lock half of the delegation, with a 0.025 ratio
+	_, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(50), types.NewRate(200, sdk.NewDec(5)), false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+
+	// Allocate rewards on the bond denom and on a foreign denom held by the distribution module
+	amount := sdk.TokensFromConsensusPower(1, PowerReduction)
+	foreign := sdk.NewCoins(sdk.NewCoin(ibcDenom, amount))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, foreign))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, distrtypes.ModuleName, foreign))
+	tokens := sdk.DecCoins{sdk.NewDecCoin(denom, amount), sdk.NewDecCoin(ibcDenom, amount)}
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, tokens)
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+	testCases := []struct {
+		name      string
+		policy    types.RewardDenomPolicy
+		allowList []string
+		prices    []types.DenomPrice
+		expected  func(lockingRewards sdk.DecCoins) sdk.DecCoins
+	}{
+		{
+			"all denoms",
+			types.RewardDenomPolicyAll,
+			nil,
+			nil,
+			func(lockingRewards sdk.DecCoins) sdk.DecCoins {
+				return lockingRewards
+			},
+		},
+		{
+			"allow list",
+			types.RewardDenomPolicyAllowList,
+			[]string{ibcDenom},
+			nil,
+			func(lockingRewards sdk.DecCoins) sdk.DecCoins {
+				return sdk.NewDecCoins(sdk.NewDecCoinFromDec(ibcDenom, lockingRewards.AmountOf(ibcDenom)))
+			},
+		},
+		{
+			"bond denom converted",
+			types.RewardDenomPolicyBondDenom,
+			nil,
+			[]types.DenomPrice{{Denom: ibcDenom, Price: sdk.NewDec(2)}},
+			func(lockingRewards sdk.DecCoins) sdk.DecCoins {
+				converted := lockingRewards.AmountOf(ibcDenom).MulInt64(2)
+				return sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, lockingRewards.AmountOf(denom).Add(converted)))
+			},
+		},
+		{
+			"bond denom without price",
+			types.RewardDenomPolicyBondDenom,
+			nil,
+			nil,
+			func(lockingRewards sdk.DecCoins) sdk.DecCoins {
+				return sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, lockingRewards.AmountOf(denom)))
+			},
+		},
+		{
+			"native only",
+			types.RewardDenomPolicyNativeOnly,
+			[]string{ibcDenom},
+			[]types.DenomPrice{{Denom: ibcDenom, Price: sdk.NewDec(2)}},
+			func(lockingRewards sdk.DecCoins) sdk.DecCoins {
+				return sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, lockingRewards.AmountOf(denom)))
+			},
+		},
+	}
+	for _, tc := range testCases {
+		ctx, _ := suite.ctx.CacheContext()
+		params := suite.k.GetParams(ctx)
+		params.RewardDenomPolicy = tc.policy
+		params.RewardDenomAllowList = tc.allowList
+		params.RewardDenomPrices = tc.prices
+		suite.Require().NoError(suite.k.SetParams(ctx, params), tc.name)
+
+		// The estimation reports the rewards with the policy applied
+		reward, err := suite.k.EstimateLockedRewards(ctx, delAddr, valAddr)
+		suite.Require().NoError(err, tc.name)
+		rewards, _ := reward.DistributionReward.TruncateDecimal()
+		suite.Require().Equal(tc.expected(sdk.NewDecCoinsFromCoins(rewards...).MulDecTruncate(sdk.NewDecWithPrec(25, 3))), reward.LockingReward, tc.name)
+
+		// The withdraw pays exactly the estimated rewards
+		initialBalance := suite.app.BankKeeper.GetAllBalances(ctx, delAddr)
+		delegationRewards, err := suite.app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		suite.Require().NoError(err, tc.name)
+		paid, _ := reward.LockingReward.TruncateDecimal()
+		suite.Require().Equal(initialBalance.Add(delegationRewards...).Add(paid...), suite.app.BankKeeper.GetAllBalances(ctx, delAddr), tc.name)
+	}
+}
+
+// setupDistributionHooksTest setup a testing delegation
+func setupDistributionHooksTest(suite *KeeperTestSuite, delegationValue math.Int, delAddr sdk.AccAddress, validator stakingtypes.Validator) {
+	// Set a delegator address and get the current chain validator
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+
+	// Send a few tokens to the delegator
+	err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, delAddr, sdk.NewCoins(
+		sdk.NewCoin(denom, delegationValue.Mul(math.NewInt(2))),
+	))
+	suite.Require().NoError(err)
+
+	// Create a delegation
+	_, err = suite.app.StakingKeeper.Delegate(suite.ctx, delAddr, delegationValue, stakingtypes.Unbonded, validator, true)
+	suite.Require().NoError(err)
+}
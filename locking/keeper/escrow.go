@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// GetEntryEscrow returns the locking rewards held in escrow for a locked delegation entry
+func (k Keeper) GetEntryEscrow(ctx sdk.Context, id uint64) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetEntryEscrowKey(id))
+	if bz == nil {
+		return sdk.NewDecCoins()
+	}
+
+	var escrow types.EntryEscrow
+	k.cdc.MustUnmarshal(bz, &escrow)
+	return escrow.Amount
+}
+
+// SetEntryEscrow sets the locking rewards held in escrow for a locked delegation entry
+// the escrow is removed when it's empty
+func (k Keeper) SetEntryEscrow(ctx sdk.Context, escrow types.EntryEscrow) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetEntryEscrowKey(escrow.EntryId)
+	if escrow.Amount.IsZero() {
+		store.Delete(key)
+		return nil
+	}
+	if err := escrow.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&escrow))
+	return nil
+}
+
+// GetAllEntryEscrows returns all the entry escrows, used for genesis dump
+func (k Keeper) GetAllEntryEscrows(ctx sdk.Context) (escrows []types.EntryEscrow) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.EntryEscrowKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow types.EntryEscrow
+		k.cdc.MustUnmarshal(iterator.Value(), &escrow)
+		escrows = append(escrows, escrow)
+	}
+	return escrows
+}
+
+// escrowLockingRewards holds the share of the locking rewards earned since the last accrual checkpoint by the at maturity entries
+// The shares are taken from the stored locked delegation, the one the rewards were earned with, so each term of an
+// entry only holds what it earned. The escrow is held with the reward denom policy applied
+// It returns the earned rewards left to be accrued
+// The expired entries reached their unlock time, so their rewards are accrued to be paid with the others
+func (k Keeper) escrowLockingRewards(ctx sdk.Context, lockedDelegation types.LockedDelegation, earned sdk.DecCoins) (sdk.DecCoins, error) {
+	if earned.IsZero() {
+		return earned, nil
+	}
+
+	for _, entry := range lockedDelegation.Entries {
+		if !entry.EscrowsRewards(ctx.BlockTime()) {
+			continue
+		}
+
+		escrowed := earned.MulDecTruncate(lockedDelegation.EntryRewardShare(entry.Id))
+		if escrowed.IsZero() {
+			continue
+		}
+		held := k.GetEntryEscrow(ctx, entry.Id).Add(k.applyRewardDenomPolicy(ctx, escrowed)...)
+		if err := k.SetEntryEscrow(ctx, types.NewEntryEscrow(entry.Id, held)); err != nil {
+			return nil, err
+		}
+		earned = earned.Sub(escrowed)
+	}
+	return earned, nil
+}
+
+// releaseEntryEscrow pays the locking rewards held in escrow for an entry of a locked delegation
+func (k Keeper) releaseEntryEscrow(ctx sdk.Context, lockedDelegation types.LockedDelegation, id uint64) error {
+	escrowed := k.GetEntryEscrow(ctx, id)
+	if escrowed.IsZero() {
+		return nil
+	}
+
+	delAddr := lockedDelegation.GetDelegatorAddr()
+	valAddr, err := lockedDelegation.GetValidatorAddr()
+	if err != nil {
+		return err
+	}
+
+	k.deleteEntryEscrow(ctx, id)
+	if _, err := k.payLockedDelegationRewards(ctx, delAddr, valAddr, escrowed); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventEscrowReleased{
+		DelegatorAddress: lockedDelegation.DelegatorAddress,
+		ValidatorAddress: lockedDelegation.ValidatorAddress,
+		EntryId:          id,
+		Amount:           escrowed,
+	})
+}
+
+// forfeitEntryEscrow drops the locking rewards held in escrow for an entry of a locked delegation
+// The escrowed rewards were never funded, so nothing is moved
+func (k Keeper) forfeitEntryEscrow(ctx sdk.Context, lockedDelegation types.LockedDelegation, id uint64) error {
+	escrowed := k.GetEntryEscrow(ctx, id)
+	if escrowed.IsZero() {
+		return nil
+	}
+
+	k.deleteEntryEscrow(ctx, id)
+	return ctx.EventManager().EmitTypedEvent(&types.EventEscrowForfeited{
+		DelegatorAddress: lockedDelegation.DelegatorAddress,
+		ValidatorAddress: lockedDelegation.ValidatorAddress,
+		EntryId:          id,
+		Amount:           escrowed,
+	})
+}
+
+// splitEntryEscrow divides the escrow of a split entry between the new entries by their shares
+func (k Keeper) splitEntryEscrow(ctx sdk.Context, id uint64, split, remainder types.LockedDelegationEntry) error {
+	escrowed := k.GetEntryEscrow(ctx, id)
+	if escrowed.IsZero() {
+		return nil
+	}
+
+	k.deleteEntryEscrow(ctx, id)
+	splitEscrow := escrowed.MulDecTruncate(split.Shares.Quo(split.Shares.Add(remainder.Shares)))
+	if err := k.SetEntryEscrow(ctx, types.NewEntryEscrow(split.Id, splitEscrow)); err != nil {
+		return err
+	}
+	return k.SetEntryEscrow(ctx, types.NewEntryEscrow(remainder.Id, escrowed.Sub(splitEscrow)))
+}
+
+// deleteEntryEscrow removes the escrow of an entry
+func (k Keeper) deleteEntryEscrow(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetEntryEscrowKey(id))
+}
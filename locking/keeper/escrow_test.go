@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// setupEscrowTest creates a locked delegation with an at maturity and a streaming entry, with a 0.016 rewards ratio
+// The at maturity entry earns 3/4 of the locking rewards, the entries are renewed depending on autoRenew
+func setupEscrowTest(suite *KeeperTestSuite, autoRenew bool) (sdk.AccAddress, sdk.ValAddress, types.LockedDelegationEntry) {
+	initial := sdk.TokensFromConsensusPower(1_000_000, PowerReduction)
+	delAddr := sdk.AccAddress([]byte("address"))
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+
+	atMaturity := types.NewRate(200, sdk.NewDec(5))
+	atMaturity.PayoutMode = types.PayoutModeAtMaturity
+
+	setupDistributionHooksTest(suite, initial.Mul(math.NewInt(250)), delAddr, validator)
+	entry, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, initial.Mul(math.NewInt(60)), atMaturity, autoRenew, types.ExpiryActionStayDelegated, "")
+	suite.Require().NoError(err)
+	_, err = suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, initial.Mul(math.NewInt(20)), types.NewRate(200, sdk.NewDec(5)), autoRenew, types.ExpiryActionStayDelegated, "")
+	suite.Require().NoError(err)
+
+	return delAddr, valAddr, entry
+}
+
+// TestEntryEscrowRelease tests the locking rewards of an at maturity entry paid when it reaches its unlock time
+func (suite *KeeperTestSuite) TestEntryEscrowRelease() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr, entry := setupEscrowTest(suite, false)
+	delBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+
+	// Rewards of 10000 result in 160 locking rewards, 120 are held for the at maturity entry
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000))
+	escrowed := sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 120))
+	err := suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(delBalance.Add(sdk.NewInt64Coin(denom, 40)), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	suite.Require().Equal(escrowed, suite.k.GetEntryEscrow(suite.ctx, entry.Id))
+
+	// The escrow accrues on every withdraw
+	err = suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	escrowed = escrowed.Add(escrowed...)
+	res, err := suite.k.EntryEscrow(suite.ctx, &types.QueryEntryEscrowRequest{Id: entry.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(escrowed, res.Amount)
+	suite.Require().Equal([]types.EntryEscrow{types.NewEntryEscrow(entry.Id, escrowed)}, suite.k.GetAllEntryEscrows(suite.ctx))
+
+	// The escrow is paid when the entry reaches its unlock time
+	delBalance = suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+	suite.ctx = suite.ctx.WithBlockTime(entry.UnlockOn).WithEventManager(sdk.NewEventManager())
+	err = suite.k.CompleteLockedDelegations(suite.ctx, types.LockedDelegationPair{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]proto.Message{
+		&types.EventEscrowReleased{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), EntryId: entry.Id, Amount: escrowed},
+	}, typedEvents(suite, &types.EventEscrowReleased{}))
+	suite.Require().True(suite.k.GetEntryEscrow(suite.ctx, entry.Id).IsZero())
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr).IsAllGTE(delBalance.Add(sdk.NewInt64Coin(denom, 240))))
+
+	// Nothing is found for an entry without escrow
+	res, err = suite.k.EntryEscrow(suite.ctx, &types.QueryEntryEscrowRequest{Id: entry.Id})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Amount.IsZero())
+	_, err = suite.k.EntryEscrow(suite.ctx, nil)
+	suite.Require().Error(err)
+}
+
+// TestEntryEscrowForfeit tests the locking rewards of an at maturity entry forfeited when it's unlocked early
+func (suite *KeeperTestSuite) TestEntryEscrowForfeit() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr, entry := setupEscrowTest(suite, false)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000))
+	err := suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().False(suite.k.GetEntryEscrow(suite.ctx, entry.Id).IsZero())
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, _, err = suite.k.EarlyUnlockLockedDelegationEntries(suite.ctx, delAddr, valAddr, []uint64{entry.Id})
+	suite.Require().NoError(err)
+
+	// The escrow, with what accrued on the unlock withdraw, is forfeited and never paid
+	events := typedEvents(suite, &types.EventEscrowForfeited{})
+	suite.Require().Len(events, 1)
+	forfeited := events[0].(*types.EventEscrowForfeited)
+	suite.Require().Equal(entry.Id, forfeited.EntryId)
+	_, hasNeg := forfeited.Amount.SafeSub(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 120)))
+	suite.Require().False(hasNeg)
+	suite.Require().True(suite.k.GetEntryEscrow(suite.ctx, entry.Id).IsZero())
+	suite.Require().Empty(typedEvents(suite, &types.EventEscrowReleased{}))
+}
+
+// TestEntryEscrowSplit tests the escrow of a split entry divided between the new entries
+func (suite *KeeperTestSuite) TestEntryEscrowSplit() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr, entry := setupEscrowTest(suite, false)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000))
+	err := suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+
+	split, remainder, err := suite.k.SplitLockedDelegationEntry(suite.ctx, delAddr, valAddr, entry.Id, entry.Shares.QuoInt64(5))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.k.GetEntryEscrow(suite.ctx, entry.Id).IsZero())
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 24)), suite.k.GetEntryEscrow(suite.ctx, split.Id))
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 96)), suite.k.GetEntryEscrow(suite.ctx, remainder.Id))
+}
+
+// TestEntryEscrowCheckpointWeights tests the escrow held with the entry weights the rewards were earned with
+func (suite *KeeperTestSuite) TestEntryEscrowCheckpointWeights() {
+	suite.ensureDistributionHooksSet()
+
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr, entry := setupEscrowTest(suite, false)
+	validator := suite.app.StakingKeeper.Validator(suite.ctx, valAddr)
+
+	// Rewards are allocated with the at maturity entry earning 3/4 of the locking rewards
+	tokens := sdk.DecCoins{sdk.NewDecCoin(denom, sdk.TokensFromConsensusPower(1, PowerReduction))}
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, tokens)
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+	// The estimates report the streaming share as paid and the at maturity share as held for the entry
+	res, err := suite.k.LockedDelegationRewards(suite.ctx, &types.QueryLockedDelegationRewardsRequest{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(res.LockingReward.IsZero())
+	entryRes, err := suite.k.LockedDelegationEntry(suite.ctx, &types.QueryLockedDelegationEntryRequest{Id: entry.Id})
+	suite.Require().NoError(err)
+	escrowed := entryRes.BonusEstimate
+	suite.Require().True(escrowed.AmountOf(denom).GT(res.LockingReward.AmountOf(denom).MulInt64(2)))
+	suite.Require().True(suite.k.GetEntryEscrow(suite.ctx, entry.Id).IsZero())
+
+	// Locking the rest of the delegation lowers the entry weight, the checkpoint holds the rewards earned before it
+	initial := sdk.TokensFromConsensusPower(1_000_000, PowerReduction)
+	_, err = suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, initial.Mul(math.NewInt(170)), types.NewRate(200, sdk.NewDec(5)), false, types.ExpiryActionStayDelegated, "")
+	suite.Require().NoError(err)
+	suite.Require().Equal(escrowed, suite.k.GetEntryEscrow(suite.ctx, entry.Id))
+	checkpoint, found := suite.k.GetAccrualCheckpoint(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(res.LockingReward, checkpoint.Accrued)
+
+	// The withdraw pays the streaming share without taking the escrow again with the new weights
+	_, err = suite.app.DistrKeeper.WithdrawDelegationRewards(suite.ctx, delAddr, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(escrowed, suite.k.GetEntryEscrow(suite.ctx, entry.Id))
+	_, found = suite.k.GetAccrualCheckpoint(suite.ctx, delAddr, valAddr)
+	suite.Require().False(found)
+}
+
+// TestEntryEscrowAutoRenew tests the escrow of an auto renewed entry paid for the previous term
+func (suite *KeeperTestSuite) TestEntryEscrowAutoRenew() {
+	suite.ensureDistributionHooksSet()
+
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr, entry := setupEscrowTest(suite, true)
+	validator := suite.app.StakingKeeper.Validator(suite.ctx, valAddr)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000))
+	escrowed := sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 120))
+	err := suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(escrowed, suite.k.GetEntryEscrow(suite.ctx, entry.Id))
+
+	// More rewards are earned on the term, without being withdrawn before the entry is renewed
+	tokens := sdk.DecCoins{sdk.NewDecCoin(denom, sdk.TokensFromConsensusPower(1, PowerReduction))}
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, tokens)
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+	// The escrow is released on the renewal, the rewards earned on the term are accrued without escrow
+	suite.ctx = suite.ctx.WithBlockTime(entry.UnlockOn).WithEventManager(sdk.NewEventManager())
+	res, err := suite.k.LockedDelegationRewards(suite.ctx, &types.QueryLockedDelegationRewardsRequest{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	err = suite.k.CompleteLockedDelegations(suite.ctx, types.LockedDelegationPair{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]proto.Message{
+		&types.EventEscrowReleased{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), EntryId: entry.Id, Amount: escrowed},
+	}, typedEvents(suite, &types.EventEscrowReleased{}))
+	suite.Require().Len(typedEvents(suite, &types.EventLockRenewed{}), 2)
+	suite.Require().Empty(suite.k.GetAllEntryEscrows(suite.ctx))
+	checkpoint, found := suite.k.GetAccrualCheckpoint(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(res.LockingReward, checkpoint.Accrued)
+
+	// The previous term rewards are paid on the next withdraw instead of being held for the new term
+	delBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+	distributionRewards, _ := res.DistributionReward.TruncateDecimal()
+	lockingRewards, _ := res.LockingReward.TruncateDecimal()
+	_, err = suite.app.DistrKeeper.WithdrawDelegationRewards(suite.ctx, delAddr, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.k.GetAllEntryEscrows(suite.ctx))
+	suite.Require().Equal(delBalance.Add(distributionRewards.Add(lockingRewards...)...), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+
+	// The renewed entry holds the rewards earned on its new term
+	err = suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(escrowed, suite.k.GetEntryEscrow(suite.ctx, entry.Id))
+}
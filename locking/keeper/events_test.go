@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// TestTypedEvents tests the typed events emitted through the locked delegation life cycle
+func (suite *KeeperTestSuite) TestTypedEvents() {
+	delAddresses, valAddresses, _ := setupEndblockTest(suite)
+	delAddr := delAddresses[0]
+	valAddr := valAddresses[0]
+	dstValAddr := valAddresses[1]
+
+	// Creating entries emits their full context
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	renewEntry, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(1000), rate, true, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+	expireEntry, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(2000), rate, false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+	moveEntry, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(3000), types.DefaultRates[0], false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]proto.Message{
+		&types.EventLockCreated{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Entry: renewEntry},
+		&types.EventLockCreated{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Entry: expireEntry},
+		&types.EventLockCreated{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Entry: moveEntry},
+	}, typedEvents(suite, &types.EventLockCreated{}))
+
+	// Toggling the auto renew emits the entry ID
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.k.ToggleLockedDelegationEntryAutoRenew(suite.ctx, delAddr, valAddr, expireEntry.Id)
+	suite.Require().NoError(err)
+	_, err = suite.k.ToggleLockedDelegationEntryAutoRenew(suite.ctx, delAddr, valAddr, expireEntry.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]proto.Message{
+		&types.EventAutoRenewChanged{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), EntryId: expireEntry.Id, AutoRenew: true},
+		&types.EventAutoRenewChanged{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), EntryId: expireEntry.Id, AutoRenew: false},
+	}, typedEvents(suite, &types.EventAutoRenewChanged{}))
+
+	// Setting the expiry action emits the new action
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.k.SetLockedDelegationEntryExpiryAction(suite.ctx, delAddr, valAddr, expireEntry.Id, types.ExpiryActionRedelegate, dstValAddr.String())
+	suite.Require().NoError(err)
+	_, err = suite.k.SetLockedDelegationEntryExpiryAction(suite.ctx, delAddr, valAddr, expireEntry.Id, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]proto.Message{
+		&types.EventExpiryActionChanged{
+			DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), EntryId: expireEntry.Id,
+			ExpiryAction: types.ExpiryActionRedelegate, RedelegateTo: dstValAddr.String(),
+		},
+		&types.EventExpiryActionChanged{
+			DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), EntryId: expireEntry.Id,
+			ExpiryAction: types.ExpiryActionUndelegate,
+		},
+	}, typedEvents(suite, &types.EventExpiryActionChanged{}))
+
+	// Setting the auto compound emits the new preference
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.k.SetLockedDelegationAutoCompound(suite.ctx, delAddr, valAddr, true))
+	suite.Require().NoError(suite.k.SetLockedDelegationAutoCompound(suite.ctx, delAddr, valAddr, false))
+	suite.Require().Equal([]proto.Message{
+		&types.EventAutoCompoundChanged{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), AutoCompound: true},
+		&types.EventAutoCompoundChanged{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), AutoCompound: false},
+	}, typedEvents(suite, &types.EventAutoCompoundChanged{}))
+
+	// Redelegating emits the entries on the destination validator
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, _, err = suite.k.LockedDelegationRedelegation(suite.ctx, delAddr, valAddr, dstValAddr, []uint64{moveEntry.Id})
+	suite.Require().NoError(err)
+	events := typedEvents(suite, &types.EventLockRedelegated{})
+	suite.Require().Len(events, 1)
+	redelegated := events[0].(*types.EventLockRedelegated)
+	suite.Require().Equal(delAddr.String(), redelegated.DelegatorAddress)
+	suite.Require().Equal(valAddr.String(), redelegated.ValidatorSrcAddress)
+	suite.Require().Equal(dstValAddr.String(), redelegated.ValidatorDstAddress)
+	suite.Require().Len(redelegated.Entries, 1)
+	suite.Require().Equal(moveEntry.Id, redelegated.Entries[0].Id)
+
+	// Completing the expired entries emits the renewal and the expiration
+	suite.ctx = suite.ctx.WithBlockTime(renewEntry.UnlockOn).WithEventManager(sdk.NewEventManager())
+	err = suite.k.CompleteLockedDelegations(suite.ctx, types.LockedDelegationPair{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	renewed := renewEntry
+	renewed.UnlockOn = renewEntry.UnlockOn.Add(rate.Duration)
+	suite.Require().Equal([]proto.Message{
+		&types.EventLockRenewed{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Previous: renewEntry, Entry: renewed},
+	}, typedEvents(suite, &types.EventLockRenewed{}))
+	suite.Require().Equal([]proto.Message{
+		&types.EventLockExpired{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Entry: expireEntry},
+	}, typedEvents(suite, &types.EventLockExpired{}))
+
+	// Paying the locking rewards emits the paid amount and the remaining debt
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	debt := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	suite.Require().NoError(suite.k.SetRewardDebt(suite.ctx, types.NewRewardDebt(delAddr, valAddr, debt)))
+	_, _, err = suite.k.ClaimRewardDebt(suite.ctx, delAddr, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]proto.Message{
+		&types.EventLockingRewardPaid{
+			DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Amount: debt, Debt: sdk.NewCoins(),
+			RecipientAddress: delAddr.String(),
+		},
+	}, typedEvents(suite, &types.EventLockingRewardPaid{}))
+
+	// Changing the bonus beneficiary emits the new beneficiary
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	beneficiary := sdk.AccAddress([]byte("beneficiary"))
+	suite.Require().NoError(suite.k.UpdateBonusBeneficiary(suite.ctx, delAddr, beneficiary))
+	suite.Require().NoError(suite.k.UpdateBonusBeneficiary(suite.ctx, delAddr, nil))
+	suite.Require().Equal([]proto.Message{
+		&types.EventBonusBeneficiaryChanged{DelegatorAddress: delAddr.String(), BeneficiaryAddress: beneficiary.String()},
+		&types.EventBonusBeneficiaryChanged{DelegatorAddress: delAddr.String()},
+	}, typedEvents(suite, &types.EventBonusBeneficiaryChanged{}))
+
+	// Updating the params emits the new params
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	params := types.DefaultParams()
+	_, err = suite.msgSrvr.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: suite.k.GetAuthority(), Params: params})
+	suite.Require().NoError(err)
+	events = typedEvents(suite, &types.EventParamsUpdated{})
+	suite.Require().Len(events, 1)
+	paramsUpdated := events[0].(*types.EventParamsUpdated)
+	suite.Require().Equal(suite.k.GetAuthority(), paramsUpdated.Authority)
+	suite.Require().Equal(params.String(), paramsUpdated.Params.String())
+}
+
+// TestLockUnlockedEvents tests the typed event emitted when entries are removed before their unlock time
+func (suite *KeeperTestSuite) TestLockUnlockedEvents() {
+	delAddr := sdk.AccAddress([]byte("address1"))
+
+	testCases := []struct {
+		name   string
+		reason types.UnlockReason
+		unlock func(valAddr sdk.ValAddress) (ids []uint64, penalty sdk.Coin)
+	}{
+		{
+			"early unlock",
+			types.UnlockReasonEarlyUnlock,
+			func(valAddr sdk.ValAddress) ([]uint64, sdk.Coin) {
+				_, penalty, err := suite.k.EarlyUnlockLockedDelegationEntries(suite.ctx, delAddr, valAddr, []uint64{1, 3})
+				suite.Require().NoError(err)
+				suite.Require().True(penalty.IsPositive())
+				return []uint64{1, 3}, penalty
+			},
+		},
+		{
+			"double sign release",
+			types.UnlockReasonDoubleSign,
+			func(valAddr sdk.ValAddress) ([]uint64, sdk.Coin) {
+				params := suite.k.GetParams(suite.ctx)
+				params.DoubleSignPolicy = types.DoubleSignPolicyRelease
+				suite.Require().NoError(suite.k.SetParams(suite.ctx, params))
+				tombstoneValidator(suite, valAddr)
+
+				suite.Require().NoError(suite.k.CompleteSlashedValidator(suite.ctx, valAddr))
+				return []uint64{1, 2, 3}, sdk.NewCoin(suite.app.StakingKeeper.BondDenom(suite.ctx), math.ZeroInt())
+			},
+		},
+		{
+			"validator exit force unlock",
+			types.UnlockReasonValidatorExit,
+			func(valAddr sdk.ValAddress) ([]uint64, sdk.Coin) {
+				params := suite.k.GetParams(suite.ctx)
+				params.ValidatorExitPolicy = types.ValidatorExitPolicyForceUnlock
+				suite.Require().NoError(suite.k.SetParams(suite.ctx, params))
+				tombstoneValidator(suite, valAddr)
+
+				suite.Require().NoError(suite.k.CompleteExitedValidator(suite.ctx, valAddr))
+				return []uint64{1, 2, 3}, sdk.NewCoin(suite.app.StakingKeeper.BondDenom(suite.ctx), math.ZeroInt())
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.SetupTest() // Restart the whole app each time
+
+		valAddr := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0].GetOperator()
+		mintAndCreateLockeDelegations(suite, 3, delAddr, valAddr)
+		before, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+		suite.Require().True(found, tc.name)
+
+		suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+		ids, penalty := tc.unlock(valAddr)
+		_, entries := before.EntriesForIds(ids)
+		suite.Require().Equal([]proto.Message{
+			&types.EventLockUnlocked{
+				DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Entries: entries,
+				Penalty: penalty, Reason: tc.reason,
+			},
+		}, typedEvents(suite, &types.EventLockUnlocked{}), tc.name)
+	}
+}
+
+// tombstoneValidator tombstones a validator like the evidence module does
+func tombstoneValidator(suite *KeeperTestSuite, valAddr sdk.ValAddress) {
+	validator, found := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	suite.app.SlashingKeeper.SetValidatorSigningInfo(suite.ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+		consAddr, 0, 0, time.Unix(0, 0), false, 0,
+	))
+	suite.app.SlashingKeeper.Tombstone(suite.ctx, consAddr)
+}
+
+// typedEvents returns the typed events of the same type as msg emitted on the suite context
+func typedEvents(suite *KeeperTestSuite, msg proto.Message) (events []proto.Message) {
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(msg) {
+			continue
+		}
+		typed, err := sdk.ParseTypedEvent(abci.Event(event))
+		suite.Require().NoError(err)
+		events = append(events, typed)
+	}
+	return events
+}
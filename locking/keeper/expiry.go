@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// Errors of the completions that panicked
+const (
+	ErrCompleteLockedDelegationsPanic = "completing the locked delegations panicked: %v"
+	ErrCompleteValidatorPanic         = "applying the validator policy panicked: %v"
+)
+
+// GetQuarantinedPair returns a quarantined locked delegation pair
+func (k Keeper) GetQuarantinedPair(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (quarantined types.QuarantinedPair, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetQuarantinedPairKey(delAddr, valAddr))
+	if bz == nil {
+		return quarantined, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &quarantined)
+	return quarantined, true
+}
+
+// SetQuarantinedPair sets a quarantined locked delegation pair
+func (k Keeper) SetQuarantinedPair(ctx sdk.Context, quarantined types.QuarantinedPair) error {
+	if err := quarantined.Validate(); err != nil {
+		return err
+	}
+	delAddr := sdk.MustAccAddressFromBech32(quarantined.DelegatorAddress)
+	valAddr, err := sdk.ValAddressFromBech32(quarantined.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetQuarantinedPairKey(delAddr, valAddr), k.cdc.MustMarshal(&quarantined))
+	return nil
+}
+
+// DeleteQuarantinedPair removes a quarantined locked delegation pair
+func (k Keeper) DeleteQuarantinedPair(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQuarantinedPairKey(delAddr, valAddr))
+}
+
+// GetAllQuarantinedPairs returns all the quarantined locked delegation pairs, used for genesis dump
+func (k Keeper) GetAllQuarantinedPairs(ctx sdk.Context) (quarantinedPairs []types.QuarantinedPair) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.QuarantinedPairKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var quarantined types.QuarantinedPair
+		k.cdc.MustUnmarshal(iterator.Value(), &quarantined)
+		quarantinedPairs = append(quarantinedPairs, quarantined)
+	}
+	return quarantinedPairs
+}
+
+// ProcessExpiredLockedDelegations completes the expired locked delegation pairs up to the per block limit
+// The pairs that don't fit on the block stay on the queue for the next ones, and the pairs that fail
+// are quarantined instead of halting the chain
+func (k Keeper) ProcessExpiredLockedDelegations(ctx sdk.Context) {
+	limit := k.GetParams(ctx).MaxExpiredPairsPerBlock
+
+	// Complete the expired entries
+	for _, pair := range k.DequeueExpiredLockedDelegations(ctx, ctx.BlockTime(), limit) {
+		if err := k.completeExpiredPair(ctx, pair); err != nil {
+			k.quarantinePair(ctx, pair, err)
+		}
+	}
+}
+
+// RetryQuarantinedPair completes the expired entries of a quarantined pair, removing it from the quarantine
+func (k Keeper) RetryQuarantinedPair(ctx sdk.Context, pair types.LockedDelegationPair) error {
+	delAddr, err := sdk.AccAddressFromBech32(pair.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(pair.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetQuarantinedPair(ctx, delAddr, valAddr); !found {
+		return types.ErrPairNotQuarantined
+	}
+
+	return k.completeExpiredPair(ctx, pair)
+}
+
+// completeExpiredPair completes the expired entries of a pair on a cached context
+// the changes are only written when it succeeds, and a panic is returned as an error
+// A successful completion also completes the entries of a previous failure, so the pair leaves the quarantine
+func (k Keeper) completeExpiredPair(ctx sdk.Context, pair types.LockedDelegationPair) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf(ErrCompleteLockedDelegationsPanic, r)
+		}
+	}()
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.CompleteLockedDelegations(cacheCtx, pair); err != nil {
+		return err
+	}
+	write()
+
+	delAddr := sdk.MustAccAddressFromBech32(pair.DelegatorAddress)
+	valAddr, err := sdk.ValAddressFromBech32(pair.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	k.DeleteQuarantinedPair(ctx, delAddr, valAddr)
+	return nil
+}
+
+// quarantinePair stores a pair that couldn't be completed and emits the quarantine event
+// A pair that can't be stored, like one with invalid addresses, is still reported by the event and the logs
+func (k Keeper) quarantinePair(ctx sdk.Context, pair types.LockedDelegationPair, cause error) {
+	quarantined := types.QuarantinedPair{
+		DelegatorAddress: pair.DelegatorAddress,
+		ValidatorAddress: pair.ValidatorAddress,
+		Error:            cause.Error(),
+		Height:           ctx.BlockHeight(),
+	}
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyDelegator, pair.DelegatorAddress),
+		sdk.NewAttribute(types.AttributeKeyValidator, pair.ValidatorAddress),
+		sdk.NewAttribute(types.AttributeKeyError, cause.Error()),
+	}
+	if err := k.SetQuarantinedPair(ctx, quarantined); err != nil {
+		k.Logger(ctx).Error("failed to store the quarantined pair", "delegator", pair.DelegatorAddress, "validator", pair.ValidatorAddress, "error", err)
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyStoreError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeLockedDelegationQuarantined, attributes...))
+}
+
+// completeValidator applies a validator policy on a cached context
+// the changes are only written when it succeeds, a failure or a panic is logged and emitted as an event instead
+func (k Keeper) completeValidator(ctx sdk.Context, valAddr sdk.ValAddress, policy string, complete func(sdk.Context, sdk.ValAddress) error) {
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf(ErrCompleteValidatorPanic, r)
+			}
+		}()
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := complete(cacheCtx, valAddr); err != nil {
+			return err
+		}
+		write()
+		return nil
+	}()
+	if err == nil {
+		return
+	}
+
+	k.Logger(ctx).Error("failed to apply the validator policy", "validator", valAddr.String(), "policy", policy, "error", err)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorPolicyFailed,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyAction, policy),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/aetherevm/locking/locking/keeper"
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// TestProcessExpiredLockedDelegationsLimit tests that the expired pairs over the per block limit stay on the queue
+func (suite *KeeperTestSuite) TestProcessExpiredLockedDelegationsLimit() {
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	rate := types.DefaultRates[0]
+
+	// Create three pairs expiring at the same time
+	delAddresses := []sdk.AccAddress{
+		sdk.AccAddress([]byte("address1")),
+		sdk.AccAddress([]byte("address2")),
+		sdk.AccAddress([]byte("address3")),
+	}
+	for _, delAddr := range delAddresses {
+		setupDistributionHooksTest(suite, sdk.NewInt(100), delAddr, validator)
+		_, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(50), rate, false, types.ExpiryActionUndelegate, "")
+		suite.Require().NoError(err)
+	}
+
+	params := suite.k.GetParams(suite.ctx)
+	params.MaxExpiredPairsPerBlock = 2
+	suite.Require().NoError(suite.k.SetParams(suite.ctx, params))
+
+	// Only two pairs are completed, the last one stays on the queue
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(rate.Duration))
+	suite.k.EndBlock(suite.ctx)
+
+	pending := suite.k.GetAllLockedDelegationQueuePairs(suite.ctx, suite.ctx.BlockTime())
+	suite.Require().Len(pending, 1)
+	remaining := 0
+	for _, delAddr := range delAddresses {
+		if _, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr); found {
+			suite.Require().Equal(delAddr.String(), pending[0].DelegatorAddress)
+			remaining++
+		}
+	}
+	suite.Require().Equal(1, remaining)
+
+	// The next block completes the remaining pair
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	suite.k.EndBlock(suite.ctx)
+
+	suite.Require().Empty(suite.k.GetAllLockedDelegationQueuePairs(suite.ctx, suite.ctx.BlockTime()))
+	for _, delAddr := range delAddresses {
+		_, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+		suite.Require().False(found)
+	}
+}
+
+// TestProcessExpiredLockedDelegationsQuarantine tests that a pair that can't be completed is quarantined and can be retried
+func (suite *KeeperTestSuite) TestProcessExpiredLockedDelegationsQuarantine() {
+	delAddr := sdk.AccAddress([]byte("address"))
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	setupDistributionHooksTest(suite, sdk.NewInt(100), delAddr, validator)
+	delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+
+	// Store an expired locked delegation with more shares than the delegation
+	entry := types.NewLockedDelegationEntry(delegation.Shares.MulInt64(2), types.DefaultRates[0], suite.ctx.BlockTime(), false, 1)
+	lockedDelegation := types.NewLockedDelegation(delAddr, valAddr, []types.LockedDelegationEntry{entry})
+	suite.Require().NoError(suite.k.SetLockedDelegation(suite.ctx, lockedDelegation))
+
+	// The end block doesn't halt, the pair is quarantined untouched
+	suite.Require().NotPanics(func() {
+		suite.k.EndBlock(suite.ctx)
+	})
+	quarantined, found := suite.k.GetQuarantinedPair(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(types.ErrLockedSharesSmallerThanDelegation.Error(), quarantined.Error)
+	suite.Require().Equal(suite.ctx.BlockHeight(), quarantined.Height)
+	storedLockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(lockedDelegation.Entries, storedLockedDelegation.Entries)
+
+	// The entry left the queue, so the pair isn't processed again on the next blocks
+	suite.Require().Empty(suite.k.GetAllLockedDelegationQueuePairs(suite.ctx, suite.ctx.BlockTime()))
+	_, broken := keeper.LockedDelegationQueueInvariant(suite.k)(suite.ctx)
+	suite.Require().False(broken)
+
+	// The quarantined pairs are queried
+	res, err := suite.k.QuarantinedPairs(suite.ctx, &types.QueryQuarantinedPairsRequest{Pagination: &query.PageRequest{Limit: 10}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.QuarantinedPair{quarantined}, res.QuarantinedPairs)
+
+	// Retrying fails while the pair is still broken
+	msg := &types.MsgRetryQuarantinedPairs{
+		Authority: suite.k.GetAuthority(),
+		Pairs:     []types.LockedDelegationPair{quarantined.Pair()},
+	}
+	_, err = suite.msgSrvr.RetryQuarantinedPairs(suite.ctx, &types.MsgRetryQuarantinedPairs{Authority: "bad", Pairs: msg.Pairs})
+	suite.Require().ErrorContains(err, "invalid authority")
+	_, err = suite.msgSrvr.RetryQuarantinedPairs(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrLockedSharesSmallerThanDelegation)
+
+	// Once fixed, the retry completes the pair and removes it from the quarantine
+	lockedDelegation.Entries[0].Shares = delegation.Shares.QuoInt64(2)
+	suite.Require().NoError(suite.k.SetLockedDelegation(suite.ctx, lockedDelegation))
+	_, err = suite.msgSrvr.RetryQuarantinedPairs(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.k.GetAllLockedDelegationQueuePairs(suite.ctx, suite.ctx.BlockTime()))
+
+	_, found = suite.k.GetQuarantinedPair(suite.ctx, delAddr, valAddr)
+	suite.Require().False(found)
+	_, found = suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().False(found)
+
+	// Pairs that aren't quarantined can't be retried
+	_, err = suite.msgSrvr.RetryQuarantinedPairs(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrPairNotQuarantined)
+}
+
+// TestProcessExpiredLockedDelegationsQuarantineStoreError tests that a pair that can't be stored in the quarantine
+// is still reported by the quarantine event
+func (suite *KeeperTestSuite) TestProcessExpiredLockedDelegationsQuarantineStoreError() {
+	// Queue a pair with invalid addresses
+	pair := types.LockedDelegationPair{DelegatorAddress: "invalid", ValidatorAddress: "invalid"}
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Set(types.GetLockedDelegationQueueKey(suite.ctx.BlockTime(), 1), suite.app.AppCodec().MustMarshal(&pair))
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NotPanics(func() {
+		suite.k.ProcessExpiredLockedDelegations(suite.ctx)
+	})
+	suite.Require().Empty(suite.k.GetAllQuarantinedPairs(suite.ctx))
+
+	var quarantined []sdk.Event
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeLockedDelegationQuarantined {
+			quarantined = append(quarantined, event)
+		}
+	}
+	suite.Require().Len(quarantined, 1)
+	attributes := quarantined[0].Attributes
+	suite.Require().Len(attributes, 4)
+	suite.Require().Equal(types.AttributeKeyStoreError, string(attributes[3].Key))
+	suite.Require().NotEmpty(string(attributes[3].Value))
+}
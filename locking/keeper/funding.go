@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// GetRewardPoolBalance returns the reward pool balance
+func (k Keeper) GetRewardPoolBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.RewardPoolName))
+}
+
+// FundRewardPool sends coins from an account to the reward pool
+func (k Keeper) FundRewardPool(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coins) error {
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.RewardPoolName, amount)
+}
+
+// FundRewardPoolFromCommunityPool moves coins from the community pool to the reward pool
+// The reward pool must be allowed to receive funds by the app
+func (k Keeper) FundRewardPoolFromCommunityPool(ctx sdk.Context, amount sdk.Coins) error {
+	return k.distributionKeeper.DistributeFromFeePool(ctx, amount, authtypes.NewModuleAddress(types.RewardPoolName))
+}
+
+// GetRewardDebt returns the locking rewards owed to a delegator on a validator
+func (k Keeper) GetRewardDebt(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetRewardDebtKey(delAddr, valAddr))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var debt types.RewardDebt
+	k.cdc.MustUnmarshal(bz, &debt)
+	return debt.Amount
+}
+
+// SetRewardDebt sets the locking rewards owed to a delegator on a validator
+// the debt is removed when there's nothing owed
+func (k Keeper) SetRewardDebt(ctx sdk.Context, debt types.RewardDebt) error {
+	delAddr, err := sdk.AccAddressFromBech32(debt.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(debt.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetRewardDebtKey(delAddr, valAddr)
+	if debt.Amount.IsZero() {
+		store.Delete(key)
+		return nil
+	}
+	if err := debt.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&debt))
+	return nil
+}
+
+// IterateDelegatorRewardDebts iterates through the reward debts of a delegator
+func (k Keeper) IterateDelegatorRewardDebts(ctx sdk.Context, delAddr sdk.AccAddress, cb func(debt types.RewardDebt) (stop bool)) {
+	k.iterateRewardDebts(ctx, types.GetRewardDebtsPerDelegatorKey(delAddr), cb)
+}
+
+// GetAllRewardDebts returns all the reward debts, used for queries and genesis dump
+func (k Keeper) GetAllRewardDebts(ctx sdk.Context) (debts []types.RewardDebt) {
+	k.iterateRewardDebts(ctx, types.RewardDebtKey, func(debt types.RewardDebt) bool {
+		debts = append(debts, debt)
+		return false
+	})
+	return debts
+}
+
+// iterateRewardDebts iterates through the reward debts under a prefix
+func (k Keeper) iterateRewardDebts(ctx sdk.Context, prefix []byte, cb func(debt types.RewardDebt) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var debt types.RewardDebt
+		k.cdc.MustUnmarshal(iterator.Value(), &debt)
+		if cb(debt) {
+			break
+		}
+	}
+}
+
+// GetRewardRemainder returns the truncated locking rewards of a delegator on a validator
+func (k Keeper) GetRewardRemainder(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetRewardRemainderKey(delAddr, valAddr))
+	if bz == nil {
+		return sdk.NewDecCoins()
+	}
+
+	var remainder types.RewardRemainder
+	k.cdc.MustUnmarshal(bz, &remainder)
+	return remainder.Amount
+}
+
+// SetRewardRemainder sets the truncated locking rewards of a delegator on a validator
+// the remainder is removed when it's empty
+func (k Keeper) SetRewardRemainder(ctx sdk.Context, remainder types.RewardRemainder) error {
+	delAddr, err := sdk.AccAddressFromBech32(remainder.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(remainder.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetRewardRemainderKey(delAddr, valAddr)
+	if remainder.Amount.IsZero() {
+		store.Delete(key)
+		return nil
+	}
+	if err := remainder.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&remainder))
+	return nil
+}
+
+// GetAllRewardRemainders returns all the reward remainders, used for genesis dump
+func (k Keeper) GetAllRewardRemainders(ctx sdk.Context) (remainders []types.RewardRemainder) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.RewardRemainderKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var remainder types.RewardRemainder
+		k.cdc.MustUnmarshal(iterator.Value(), &remainder)
+		remainders = append(remainders, remainder)
+	}
+	return remainders
+}
+
+// GetMintEpoch returns the current hybrid funding mint epoch
+func (k Keeper) GetMintEpoch(ctx sdk.Context) (epoch types.MintEpoch) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.MintEpochKey)
+	if bz == nil {
+		return types.NewMintEpoch(ctx.BlockTime(), sdk.NewCoins())
+	}
+
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return epoch
+}
+
+// SetMintEpoch sets the current hybrid funding mint epoch
+func (k Keeper) SetMintEpoch(ctx sdk.Context, epoch types.MintEpoch) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MintEpochKey, k.cdc.MustMarshal(&epoch))
+}
+
+// ClaimRewardDebt pays the locking rewards owed to a delegator on a validator
+// It returns the paid amount and the debt that remains
+func (k Keeper) ClaimRewardDebt(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, sdk.Coins, error) {
+	if k.GetRewardDebt(ctx, delAddr, valAddr).IsZero() {
+		return nil, nil, types.ErrNoRewardDebt
+	}
+	return k.payLockingRewards(ctx, delAddr, valAddr, sdk.NewCoins())
+}
+
+// payLockingRewards pays the rewards and the previous debt of a pair using the params funding mode
+// The rewards follow the delegation rewards, unless a bonus beneficiary is set, the bond denom of a compounded
+// pair is paid to the delegator instead; what can't be paid is kept as debt for the pair
+func (k Keeper) payLockingRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) (paid sdk.Coins, debt sdk.Coins, err error) {
+	owed := k.GetRewardDebt(ctx, delAddr, valAddr).Add(rewards...)
+	if owed.IsZero() {
+		return sdk.NewCoins(), sdk.NewCoins(), nil
+	}
+
+	// Mint what the funding mode and the locking budget allow
+	params := k.GetParams(ctx)
+	minted := sdk.NewCoins()
+	var epoch types.MintEpoch
+	switch params.FundingMode {
+	case types.FundingModeMint:
+		minted = owed
+	case types.FundingModeHybrid:
+		epoch = k.currentMintEpoch(ctx, params)
+		available, hasNeg := params.HybridMintCap.SafeSub(epoch.Minted...)
+		if !hasNeg {
+			minted = owed.Min(available)
+		}
+	}
+	minted, overBudget := k.consumeLockingBudget(ctx, params, minted)
+	if params.FundingMode == types.FundingModeHybrid {
+		epoch.Minted = epoch.Minted.Add(minted...)
+		k.SetMintEpoch(ctx, epoch)
+	}
+	if !minted.IsZero() {
+		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, minted)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = k.sendLockingRewards(ctx, types.ModuleName, delAddr, valAddr, minted)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// When truncating, the new rewards over the budget are dropped, the previous debt is always kept
+	remaining := owed.Sub(minted...)
+	if !overBudget.IsZero() {
+		if params.BudgetExceededAction == types.BudgetExceededActionTruncate {
+			remaining = remaining.Sub(overBudget.Min(rewards)...)
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLockingBudgetExceeded,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, overBudget.String()),
+				sdk.NewAttribute(types.AttributeKeyConsumed, k.GetBudgetWindow(ctx).Consumed.String()),
+				sdk.NewAttribute(types.AttributeKeyAction, params.BudgetExceededAction.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			),
+		)
+	}
+
+	// The remaining is paid by the reward pool, as far as it can
+	fromPool := sdk.NewCoins()
+	if !remaining.IsZero() {
+		fromPool = remaining.Min(k.GetRewardPoolBalance(ctx))
+	}
+	if !fromPool.IsZero() {
+		err = k.sendLockingRewards(ctx, types.RewardPoolName, delAddr, valAddr, fromPool)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Anything else is owed to the delegator
+	debt = remaining.Sub(fromPool...)
+	err = k.SetRewardDebt(ctx, types.NewRewardDebt(delAddr, valAddr, debt))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The paid bond denom of compounded pairs is delegated at the end of the block
+	paid = minted.Add(fromPool...)
+	if k.IsAutoCompounded(ctx, delAddr, valAddr) {
+		err = k.queueLockingRewardCompound(ctx, delAddr, valAddr, paid.AmountOf(k.stakingKeeper.BondDenom(ctx)))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockingRewardPaid{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           paid,
+		Debt:             debt,
+		RecipientAddress: k.GetLockingRewardRecipient(ctx, delAddr).String(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return paid, debt, nil
+}
+
+// currentMintEpoch returns the current mint epoch, starting a new one if the previous is over
+func (k Keeper) currentMintEpoch(ctx sdk.Context, params types.Params) types.MintEpoch {
+	epoch := k.GetMintEpoch(ctx)
+	if !ctx.BlockTime().Before(epoch.Start.Add(params.HybridEpochDuration)) {
+		epoch = types.NewMintEpoch(ctx.BlockTime(), sdk.NewCoins())
+	}
+	return epoch
+}
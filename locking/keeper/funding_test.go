@@ -0,0 +1,284 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// setupFundingTest creates a locked delegation with a 0.0132 rewards ratio and returns the pair
+func setupFundingTest(suite *KeeperTestSuite) (sdk.AccAddress, sdk.ValAddress) {
+	initial := sdk.TokensFromConsensusPower(1_000_000, PowerReduction)
+	delAddr := sdk.AccAddress([]byte("address"))
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+
+	// This replicates the case from TestAfterWithdrawDelegationRewardsDirectCall
+	setupDistributionHooksTest(suite, initial.Mul(math.NewInt(250)), delAddr, validator)
+	_, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, initial.Mul(math.NewInt(50)), types.NewRate(200, sdk.NewDec(5)), false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+	_, err = suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, initial.Mul(math.NewInt(20)), types.NewRate(200, sdk.NewDec(4)), false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+
+	return delAddr, valAddr
+}
+
+// fundRewardPool sends coins from the locking module to a depositor and funds the reward pool
+func fundRewardPool(suite *KeeperTestSuite, amount sdk.Coins) {
+	depositor := sdk.AccAddress([]byte("depositor"))
+	err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, depositor, amount)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.FundRewardPool(suite.ctx, types.NewMsgFundRewardPool(depositor, amount))
+	suite.Require().NoError(err)
+}
+
+// TestRewardPoolFunding tests the locking rewards funded by the reward pool
+func (suite *KeeperTestSuite) TestRewardPoolFunding() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr := setupFundingTest(suite)
+	params := suite.k.GetParams(suite.ctx)
+	params.FundingMode = types.FundingModePool
+	suite.Require().NoError(suite.k.SetParams(suite.ctx, params))
+
+	// Rewards of 10000 result in 132 locking rewards
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000))
+	lockingRewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 132))
+
+	// With an empty pool everything is kept as debt
+	initialBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+	err := suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialBalance, suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	suite.Require().Equal(lockingRewards, suite.k.GetRewardDebt(suite.ctx, delAddr, valAddr))
+
+	// Fund the pool with part of the debt and claim it
+	fundRewardPool(suite, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), suite.k.GetRewardPoolBalance(suite.ctx))
+
+	res, err := suite.msgSrvr.ClaimRewardDebt(suite.ctx, types.NewMsgClaimRewardDebt(delAddr, valAddr))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), res.Amount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 32)), res.RemainingDebt)
+	suite.Require().Equal(initialBalance.Add(sdk.NewInt64Coin(denom, 100)), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	suite.Require().True(suite.k.GetRewardPoolBalance(suite.ctx).IsZero())
+
+	// The next withdraw pays the previous debt together with the new rewards
+	fundRewardPool(suite, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)))
+	err = suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialBalance.Add(sdk.NewInt64Coin(denom, 100+32+132)), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 1000-32-132)), suite.k.GetRewardPoolBalance(suite.ctx))
+	suite.Require().True(suite.k.GetRewardDebt(suite.ctx, delAddr, valAddr).IsZero())
+
+	// Without debt there's nothing to claim
+	_, err = suite.msgSrvr.ClaimRewardDebt(suite.ctx, types.NewMsgClaimRewardDebt(delAddr, valAddr))
+	suite.Require().ErrorIs(err, types.ErrNoRewardDebt)
+}
+
+// TestHybridFunding tests the locking rewards minted up to the epoch cap and paid by the reward pool
+func (suite *KeeperTestSuite) TestHybridFunding() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr := setupFundingTest(suite)
+	params := suite.k.GetParams(suite.ctx)
+	params.FundingMode = types.FundingModeHybrid
+	params.HybridMintCap = sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	params.HybridEpochDuration = time.Hour
+	suite.Require().NoError(suite.k.SetParams(suite.ctx, params))
+
+	// Rewards of 10000 result in 132 locking rewards
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000))
+	fundRewardPool(suite, sdk.NewCoins(sdk.NewInt64Coin(denom, 20)))
+	initialBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+
+	// 100 is minted, 20 paid by the pool and 12 kept as debt
+	err := suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialBalance.Add(sdk.NewInt64Coin(denom, 120)), suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 12)), suite.k.GetRewardDebt(suite.ctx, delAddr, valAddr))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), suite.k.GetMintEpoch(suite.ctx).Minted)
+
+	// The cap was reached, so nothing else is minted on the same epoch
+	_, err = suite.msgSrvr.ClaimRewardDebt(suite.ctx, types.NewMsgClaimRewardDebt(delAddr, valAddr))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 12)), suite.k.GetRewardDebt(suite.ctx, delAddr, valAddr))
+
+	// On a new epoch the debt can be minted
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	res, err := suite.msgSrvr.ClaimRewardDebt(suite.ctx, types.NewMsgClaimRewardDebt(delAddr, valAddr))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 12)), res.Amount)
+	suite.Require().True(res.RemainingDebt.IsZero())
+	suite.Require().Equal(suite.ctx.BlockTime(), suite.k.GetMintEpoch(suite.ctx).Start)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 12)), suite.k.GetMintEpoch(suite.ctx).Minted)
+}
+
+// TestFundFromCommunityPool tests the reward pool funded from the community pool by the authority
+func (suite *KeeperTestSuite) TestFundFromCommunityPool() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+
+	// Fund the community pool
+	depositor := sdk.AccAddress([]byte("depositor"))
+	err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, depositor, amount)
+	suite.Require().NoError(err)
+	err = suite.app.DistrKeeper.FundCommunityPool(suite.ctx, amount, depositor)
+	suite.Require().NoError(err)
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+
+	// Only the authority can move the funds
+	_, err = suite.msgSrvr.FundFromCommunityPool(suite.ctx, &types.MsgFundFromCommunityPool{
+		Authority: depositor.String(),
+		Amount:    amount,
+	})
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	// The community pool must hold the amount
+	_, err = suite.msgSrvr.FundFromCommunityPool(suite.ctx, &types.MsgFundFromCommunityPool{
+		Authority: suite.k.GetAuthority(),
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000_000_000)),
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.msgSrvr.FundFromCommunityPool(suite.ctx, &types.MsgFundFromCommunityPool{
+		Authority: suite.k.GetAuthority(),
+		Amount:    amount,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(amount, suite.k.GetRewardPoolBalance(suite.ctx))
+	suite.Require().Equal(communityPool.Sub(sdk.NewDecCoinsFromCoins(amount...)), suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+}
+
+// TestGRPCRewardPool tests the RewardPool and DelegatorRewardDebts from the query server
+func (suite *KeeperTestSuite) TestGRPCRewardPool() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr := sdk.AccAddress([]byte("address"))
+	valAddrs := []sdk.ValAddress{sdk.ValAddress([]byte("val1")), sdk.ValAddress([]byte("val2"))}
+	for _, valAddr := range valAddrs {
+		err := suite.k.SetRewardDebt(suite.ctx, types.NewRewardDebt(delAddr, valAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+		suite.Require().NoError(err)
+	}
+	fundRewardPool(suite, sdk.NewCoins(sdk.NewInt64Coin(denom, 5)))
+
+	_, err := suite.k.RewardPool(suite.ctx, nil)
+	suite.Require().Error(err)
+
+	poolRes, err := suite.k.RewardPool(suite.ctx, &types.QueryRewardPoolRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 5)), poolRes.Balance)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 20)), poolRes.TotalDebt)
+
+	_, err = suite.k.DelegatorRewardDebts(suite.ctx, &types.QueryDelegatorRewardDebtsRequest{})
+	suite.Require().Error(err)
+
+	debtsRes, err := suite.k.DelegatorRewardDebts(suite.ctx, &types.QueryDelegatorRewardDebtsRequest{
+		DelegatorAddress: delAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(debtsRes.Debts, 2)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 20)), debtsRes.Total)
+}
+
+// TestLockingRewardRecipient tests the locking rewards paid to the withdraw address and to the bonus beneficiary
+func (suite *KeeperTestSuite) TestLockingRewardRecipient() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr := setupFundingTest(suite)
+	withdrawAddr := sdk.AccAddress([]byte("withdraw"))
+	beneficiary := sdk.AccAddress([]byte("beneficiary"))
+
+	// Rewards of 10000 result in 132 locking rewards
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000))
+	lockingRewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 132))
+	delBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+
+	// Without withdraw address the rewards are paid to the delegator
+	suite.Require().Equal(delAddr, suite.k.GetLockingRewardRecipient(suite.ctx, delAddr))
+
+	// The rewards follow the distribution withdraw address
+	suite.Require().NoError(suite.app.DistrKeeper.SetWithdrawAddr(suite.ctx, delAddr, withdrawAddr))
+	err := suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(delBalance, suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+	suite.Require().Equal(lockingRewards, suite.app.BankKeeper.GetAllBalances(suite.ctx, withdrawAddr))
+
+	// A blocked address can't be the bonus beneficiary
+	_, err = suite.msgSrvr.SetBonusBeneficiary(suite.ctx, types.NewMsgSetBonusBeneficiary(delAddr, authtypes.NewModuleAddress(distrtypes.ModuleName)))
+	suite.Require().ErrorIs(err, types.ErrBlockedBeneficiary)
+
+	// The bonus beneficiary takes precedence over the withdraw address
+	_, err = suite.msgSrvr.SetBonusBeneficiary(suite.ctx, types.NewMsgSetBonusBeneficiary(delAddr, beneficiary))
+	suite.Require().NoError(err)
+	res, err := suite.k.BonusBeneficiary(suite.ctx, &types.QueryBonusBeneficiaryRequest{DelegatorAddress: delAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(beneficiary.String(), res.BeneficiaryAddress)
+	suite.Require().Equal(beneficiary.String(), res.RecipientAddress)
+
+	err = suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+	suite.Require().NoError(err)
+	suite.Require().Equal(lockingRewards, suite.app.BankKeeper.GetAllBalances(suite.ctx, withdrawAddr))
+	suite.Require().Equal(lockingRewards, suite.app.BankKeeper.GetAllBalances(suite.ctx, beneficiary))
+
+	// Removing the bonus beneficiary sends the rewards to the withdraw address again
+	_, err = suite.msgSrvr.SetBonusBeneficiary(suite.ctx, types.NewMsgSetBonusBeneficiary(delAddr, nil))
+	suite.Require().NoError(err)
+	res, err = suite.k.BonusBeneficiary(suite.ctx, &types.QueryBonusBeneficiaryRequest{DelegatorAddress: delAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.BeneficiaryAddress)
+	suite.Require().Equal(withdrawAddr.String(), res.RecipientAddress)
+
+	// Invalid requests
+	_, err = suite.k.BonusBeneficiary(suite.ctx, nil)
+	suite.Require().Error(err)
+	_, err = suite.k.BonusBeneficiary(suite.ctx, &types.QueryBonusBeneficiaryRequest{})
+	suite.Require().Error(err)
+}
+
+// TestRewardRemainderCarryOver tests the truncated locking rewards carried over to the next payout
+func (suite *KeeperTestSuite) TestRewardRemainderCarryOver() {
+	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	delAddr, valAddr := setupFundingTest(suite)
+	delBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+
+	// Rewards of 100 result in 1.32 locking rewards, the decimals are kept until they add up
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	testCases := []struct {
+		paid      int64
+		remainder sdk.Dec
+	}{
+		{1, sdk.NewDecWithPrec(32, 2)},
+		{1, sdk.NewDecWithPrec(64, 2)},
+		{1, sdk.NewDecWithPrec(96, 2)},
+		{2, sdk.NewDecWithPrec(28, 2)},
+	}
+	for _, tc := range testCases {
+		err := suite.afterWithdrawDelegationRewards(delAddr, valAddr, rewards)
+		suite.Require().NoError(err)
+
+		delBalance = delBalance.Add(sdk.NewInt64Coin(denom, tc.paid))
+		suite.Require().Equal(delBalance, suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+		suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, tc.remainder)), suite.k.GetRewardRemainder(suite.ctx, delAddr, valAddr))
+	}
+
+	// The remainder is part of the estimated locking rewards
+	res, err := suite.k.LockedDelegationRewards(suite.ctx, &types.QueryLockedDelegationRewardsRequest{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.k.GetRewardRemainder(suite.ctx, delAddr, valAddr), res.LockingRewardRemainder)
+	_, hasNeg := res.LockingReward.SafeSub(res.LockingRewardRemainder)
+	suite.Require().False(hasNeg)
+
+	// A remainder can't reach a whole coin
+	err = suite.k.SetRewardRemainder(suite.ctx, types.NewRewardRemainder(delAddr, valAddr, sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 1))))
+	suite.Require().Error(err)
+
+	// An empty remainder is removed
+	suite.Require().NoError(suite.k.SetRewardRemainder(suite.ctx, types.NewRewardRemainder(delAddr, valAddr, sdk.NewDecCoins())))
+	suite.Require().Empty(suite.k.GetAllRewardRemainders(suite.ctx))
+}
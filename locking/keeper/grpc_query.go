@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
 	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -19,6 +21,9 @@ const (
 	ErrEmptyRequest   = "empty request"
 	ErrEmptyDelegator = "delegator address cannot be empty"
 	ErrEmptyValidator = "validator address cannot be empty"
+	ErrInvalidRange   = "end time cannot be before the start time"
+	ErrInvalidPageKey = "pagination key is out of the requested range"
+	ErrOffsetAndKey   = "either offset or key is expected, got both"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -126,6 +131,52 @@ func (k Keeper) DelegatorLockedDelegations(c context.Context, req *types.QueryDe
 	return &types.QueryDelegatorLockedDelegationsResponse{LockedDelegations: lockedDelegations, Pagination: pageRes}, nil
 }
 
+// ValidatorLockedDelegations queries all locked delegations on a given validator address
+func (k Keeper) ValidatorLockedDelegations(c context.Context, req *types.QueryValidatorLockedDelegationsRequest) (*types.QueryValidatorLockedDelegationsResponse, error) {
+	// Validate the parameters
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrEmptyRequest)
+	}
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrEmptyValidator)
+	}
+
+	// Get the validator address
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the validator index prefix store
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.GetLockedDelegationsByValidatorIndexKey(valAddr))
+
+	// Now iterates over the index and get the locked delegations based on pagination
+	var lockedDelegations []types.LockedDelegationWithTotalShares
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, _ []byte) error {
+		delAddr, err := types.ParseLockedDelegationByValidatorIndexKey(key)
+		if err != nil {
+			return err
+		}
+		lockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, valAddr)
+		if !found {
+			return types.ErrLockedDelegationNotFound
+		}
+		lockedDelegations = append(lockedDelegations, types.LockedDelegationWithTotalShares{
+			LockedDelegation: lockedDelegation,
+			TotalLocked:      lockedDelegation.TotalShares(),
+		})
+		return nil
+	})
+	// The iterator may error out
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorLockedDelegationsResponse{LockedDelegations: lockedDelegations, Pagination: pageRes}, nil
+}
+
 // LockedDelegationRewards implements the types.QueryServer
 // returns rewards per delegator validator pair
 func (k Keeper) LockedDelegationRewards(c context.Context, req *types.QueryLockedDelegationRewardsRequest) (*types.QueryLockedDelegationRewardsResponse, error) {
@@ -140,35 +191,25 @@ func (k Keeper) LockedDelegationRewards(c context.Context, req *types.QueryLocke
 	// Wrap the context
 	ctx := sdk.UnwrapSDKContext(c)
 
-	// Find the validator and delegations
 	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
 	if err != nil {
 		return nil, err
 	}
-	val := k.stakingKeeper.Validator(ctx, valAdr)
-	if val == nil {
-		return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, req.ValidatorAddress)
-	}
 	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
-	del := k.stakingKeeper.Delegation(ctx, delAdr, valAdr)
-	if del == nil {
-		return nil, types.ErrNoDelegationExists
-	}
-
-	// Calculate the delegation rewards
-	endingPeriod := k.distributionKeeper.IncrementValidatorPeriod(ctx, val)
-	distributionRewards := k.distributionKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
-	rewards, _ := distributionRewards.TruncateDecimal()
 
-	// Calculated the locked rewards
-	lockedRewards := k.CalculateLockedDelegationRewards(ctx, delAdr, valAdr, rewards)
+	// Estimate the delegation and locked rewards without changing the state
+	reward, err := k.EstimateLockedRewards(ctx, delAdr, valAdr)
+	if err != nil {
+		return nil, err
+	}
 	return &types.QueryLockedDelegationRewardsResponse{
-		DistributionReward: distributionRewards,
-		LockingReward:      lockedRewards,
-		Total:              distributionRewards.Add(lockedRewards...),
+		DistributionReward:     reward.DistributionReward,
+		LockingReward:          reward.LockingReward,
+		Total:                  reward.Total,
+		LockingRewardRemainder: reward.LockingRewardRemainder,
 	}, nil
 }
 
@@ -196,31 +237,450 @@ func (k Keeper) LockedDelegationTotalRewards(c context.Context, req *types.Query
 	}
 
 	// Iterate over the delegations, building the set
+	var estimateErr error
 	k.stakingKeeper.IterateDelegations(
 		ctx, delAdr,
 		func(_ int64, del stakingtypes.DelegationI) (stop bool) {
-			valAddr := del.GetValidatorAddr()
-			val := k.stakingKeeper.Validator(ctx, valAddr)
-
-			// Calculate the distribution rewards
-			endingPeriod := k.distributionKeeper.IncrementValidatorPeriod(ctx, val)
-			distributionRewards := k.distributionKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
-			delReward, _ := distributionRewards.TruncateDecimal()
-
-			// Calculated the locked rewards
-			lockingRewards := k.CalculateLockedDelegationRewards(ctx, delAdr, valAddr, delReward)
-
-			delTotal := distributionRewards.Add(lockingRewards...)
-			delLockedRewards = append(delLockedRewards, types.LockedDelegationDelegatorReward{
-				ValidatorAddress:   valAddr.String(),
-				DistributionReward: distributionRewards,
-				LockingReward:      lockingRewards,
-				Total:              delTotal,
-			})
-			total = total.Add(delTotal...)
+			// Estimate the delegation and locked rewards without changing the state
+			reward, err := k.EstimateLockedRewards(ctx, delAdr, del.GetValidatorAddr())
+			if err != nil {
+				estimateErr = err
+				return true
+			}
+
+			delLockedRewards = append(delLockedRewards, reward)
+			total = total.Add(reward.Total...)
 			return false
 		},
 	)
+	if estimateErr != nil {
+		return nil, estimateErr
+	}
 
 	return &types.QueryLockedDelegationTotalRewardsResponse{Rewards: delLockedRewards, Total: total}, nil
 }
+
+// LockedDelegationEntrySlashes implements the types.QueryServer
+// returns the entry token value and its value before and after each validator slash
+func (k Keeper) LockedDelegationEntrySlashes(c context.Context, req *types.QueryLockedDelegationEntrySlashesRequest) (*types.QueryLockedDelegationEntrySlashesResponse, error) {
+	// Validate the parameters
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrEmptyRequest)
+	}
+
+	// Wrap the context
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Find the locked delegation and the entry
+	lockedDelegation, found := k.GetLockedDelegationByEntryID(ctx, req.Id)
+	if !found {
+		return nil, types.ErrLockedDelegationEntryNotFound
+	}
+	exists, entries := lockedDelegation.EntriesForIds([]uint64{req.Id})
+	if !exists {
+		return nil, types.ErrLockedDelegationEntryNotFound
+	}
+	entry := entries[0]
+
+	// Calculate the current entry token value
+	valAddr, err := lockedDelegation.GetValidatorAddr()
+	if err != nil {
+		return nil, err
+	}
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, lockedDelegation.ValidatorAddress)
+	}
+
+	return &types.QueryLockedDelegationEntrySlashesResponse{
+		ValidatorAddress: lockedDelegation.ValidatorAddress,
+		Entry:            entry,
+		Tokens:           validator.TokensFromShares(entry.Shares),
+		Slashes:          k.CalculateEntrySlashes(ctx, valAddr, entry),
+	}, nil
+}
+
+// LockingStats implements the types.QueryServer
+// returns the module wide locked totals and the totals per validator
+func (k Keeper) LockingStats(c context.Context, req *types.QueryLockingStatsRequest) (*types.QueryLockingStatsResponse, error) {
+	// Validate the parameters
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrEmptyRequest)
+	}
+
+	// The validator is optional
+	var valAddrs []sdk.ValAddress
+	if req.ValidatorAddr != "" {
+		valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+		if err != nil {
+			return nil, err
+		}
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	// Wrap the context
+	ctx := sdk.UnwrapSDKContext(c)
+
+	total, validators, err := k.GetLockingStats(ctx, valAddrs...)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryLockingStatsResponse{Total: total, Validators: validators}, nil
+}
+
+// RewardPool implements the types.QueryServer
+// returns the reward pool balance, the total outstanding debt and the current mint epoch
+func (k Keeper) RewardPool(c context.Context, req *types.QueryRewardPoolRequest) (*types.QueryRewardPoolResponse, error) {
+	// Validate the parameters
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrEmptyRequest)
+	}
+
+	// Wrap the context
+	ctx := sdk.UnwrapSDKContext(c)
+
+	totalDebt := sdk.NewCoins()
+	for _, debt := range k.GetAllRewardDebts(ctx) {
+		totalDebt = totalDebt.Add(debt.Amount...)
+	}
+
+	return &types.QueryRewardPoolResponse{
+		Balance:   k.GetRewardPoolBalance(ctx),
+		TotalDebt: totalDebt,
+		MintEpoch: k.GetMintEpoch(ctx),
+	}, nil
+}
+
+// DelegatorRewardDebts implements the types.QueryServer
+// returns the locking rewards owed to a delegator per validator
+func (k Keeper) DelegatorRewardDebts(c context.Context, req *types.QueryDelegatorRewardDebtsRequest) (*types.QueryDelegatorRewardDebtsResponse, error) {
+	// Validate the parameters
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrEmptyRequest)
+	}
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrEmptyDelegator)
+	}
+
+	// Get the delegator address
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrap the context
+	ctx := sdk.UnwrapSDKContext(c)
+
+	debts := []types.RewardDebt{}
+	total := sdk.NewCoins()
+	k.IterateDelegatorRewardDebts(ctx, delAddr, func(debt types.RewardDebt) bool {
+		debts = append(debts, debt)
+		total = total.Add(debt.Amount...)
+		return false
+	})
+
+	return &types.QueryDelegatorRewardDebtsResponse{Debts: debts, Total: total}, nil
+}
+
+// BonusBeneficiary implements the types.QueryServer
+// returns the bonus beneficiary of a delegator and the address its locking rewards are paid to
+func (k Keeper) BonusBeneficiary(c context.Context, req *types.QueryBonusBeneficiaryRequest) (*types.QueryBonusBeneficiaryResponse, error) {
+	// Validate the parameters
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrEmptyRequest)
+	}
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrEmptyDelegator)
+	}
+
+	// Get the delegator address
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrap the context
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryBonusBeneficiaryResponse{
+		RecipientAddress: k.GetLockingRewardRecipient(ctx, delAddr).String(),
+	}
+	if beneficiary, found := k.GetBonusBeneficiary(ctx, delAddr); found {
+		res.BeneficiaryAddress = beneficiary.String()
+	}
+	return res, nil
+}
+
+// LockingBudget implements the types.QueryServer
+// returns the current budget window with its cap and what can still be minted on it
+func (k Keeper) LockingBudget(c context.Context, req *types.QueryLockingBudgetRequest) (*types.QueryLockingBudgetResponse, error) {
+	// Validate the parameters
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrEmptyRequest)
+	}
+
+	// Wrap the context
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := k.GetParams(ctx)
+	if params.BudgetType == types.BudgetTypeNone {
+		return &types.QueryLockingBudgetResponse{Window: k.GetBudgetWindow(ctx)}, nil
+	}
+
+	// An expired window is reported as the new one, even before anything is minted on it
+	window := k.currentBudgetWindow(ctx, params)
+	budgetCap := k.GetLockingBudgetCap(ctx, params)
+	return &types.QueryLockingBudgetResponse{
+		Window:    window,
+		Cap:       budgetCap,
+		Remaining: window.Remaining(budgetCap),
+	}, nil
+}
+
+// QuarantinedPairs implements the types.QueryServer
+// returns the locked delegation pairs whose expired entries couldn't be completed
+func (k Keeper) QuarantinedPairs(c context.Context, req *types.QueryQuarantinedPairsRequest) (*types.QueryQuarantinedPairsResponse, error) {
+	// Validate the parameters
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrEmptyRequest)
+	}
+
+	// Get the quarantine prefix store
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.QuarantinedPairKey)
+
+	var quarantinedPairs []types.QuarantinedPair
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var quarantined types.QuarantinedPair
+		if err := k.cdc.Unmarshal(value, &quarantined); err != nil {
+			return err
+		}
+		quarantinedPairs = append(quarantinedPairs, quarantined)
+		return nil
+	})
+	// The iterator may error out
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQuarantinedPairsResponse{QuarantinedPairs: quarantinedPairs, Pagination: pageRes}, nil
+}
+
+// Unlocks implements the types.QueryServer
+// returns the queued locked delegation entries unlocking between two timestamps
+func (k Keeper) Unlocks(c context.Context, req *types.QueryUnlocksRequest) (*types.QueryUnlocksResponse, error) {
+	// Validate the parameters
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrEmptyRequest)
+	}
+	if req.EndTime.Before(req.StartTime) {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidRange)
+	}
+	if req.DelegatorAddr != "" {
+		if _, err := sdk.AccAddressFromBech32(req.DelegatorAddr); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.ValidatorAddr != "" {
+		if _, err := sdk.ValAddressFromBech32(req.ValidatorAddr); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, ErrOffsetAndKey)
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	// The page key is relative to the queue prefix, like on the other paginated queries
+	startKey := types.GetLockedDelegationTimeKey(req.StartTime)
+	if len(pageReq.Key) > 0 {
+		pageKey := append(append([]byte{}, types.LockedDelegationsQueueKey...), pageReq.Key...)
+		if bytes.Compare(pageKey, startKey) < 0 {
+			return nil, status.Error(codes.InvalidArgument, ErrInvalidPageKey)
+		}
+		startKey = pageKey
+	}
+
+	// Walk the queue, skipping the entries filtered out
+	ctx := sdk.UnwrapSDKContext(c)
+	iterator := k.LockedDelegationQueueRangeIterator(ctx, startKey, req.EndTime)
+	defer iterator.Close()
+
+	var (
+		unlocks []types.LockedDelegationUnlock
+		nextKey []byte
+		skipped uint64
+		total   uint64
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.LockedDelegationPair
+		k.cdc.MustUnmarshal(iterator.Value(), &pair)
+		if (req.DelegatorAddr != "" && pair.DelegatorAddress != req.DelegatorAddr) ||
+			(req.ValidatorAddr != "" && pair.ValidatorAddress != req.ValidatorAddr) {
+			continue
+		}
+		total++
+
+		// Skip the offset, then stop at the limit unless the total is requested
+		if skipped < pageReq.Offset {
+			skipped++
+			continue
+		}
+		if uint64(len(unlocks)) == limit {
+			if nextKey == nil {
+				nextKey = iterator.Key()[len(types.LockedDelegationsQueueKey):]
+			}
+			if !pageReq.CountTotal {
+				break
+			}
+			continue
+		}
+
+		_, id, err := types.ParseLockedDelegationQueueKey(iterator.Key()[len(types.LockedDelegationsQueueKey):])
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		unlock, err := k.getLockedDelegationUnlock(ctx, pair, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		unlocks = append(unlocks, unlock)
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+	return &types.QueryUnlocksResponse{Unlocks: unlocks, Pagination: pageRes}, nil
+}
+
+// getLockedDelegationUnlock returns a queued entry with its pair and current token value
+func (k Keeper) getLockedDelegationUnlock(ctx sdk.Context, pair types.LockedDelegationPair, id uint64) (types.LockedDelegationUnlock, error) {
+	delAddr, err := sdk.AccAddressFromBech32(pair.DelegatorAddress)
+	if err != nil {
+		return types.LockedDelegationUnlock{}, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(pair.ValidatorAddress)
+	if err != nil {
+		return types.LockedDelegationUnlock{}, err
+	}
+
+	lockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.LockedDelegationUnlock{}, types.ErrLockedDelegationNotFound
+	}
+	exists, entries := lockedDelegation.EntriesForIds([]uint64{id})
+	if !exists {
+		return types.LockedDelegationUnlock{}, types.ErrLockedDelegationEntryNotFound
+	}
+
+	// The entry has no token value if the validator isn't found
+	tokens := math.LegacyZeroDec()
+	if validator, found := k.stakingKeeper.GetValidator(ctx, valAddr); found {
+		tokens = validator.TokensFromShares(entries[0].Shares)
+	}
+
+	return types.LockedDelegationUnlock{
+		DelegatorAddress: pair.DelegatorAddress,
+		ValidatorAddress: pair.ValidatorAddress,
+		Entry:            entries[0],
+		Tokens:           tokens,
+	}, nil
+}
+
+// LockedDelegationEntry implements the types.QueryServer
+// returns a locked delegation entry by its id with its pair, token value, time remaining and bonus estimate
+func (k Keeper) LockedDelegationEntry(c context.Context, req *types.QueryLockedDelegationEntryRequest) (*types.QueryLockedDelegationEntryResponse, error) {
+	// Validate the parameters
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrEmptyRequest)
+	}
+
+	// Wrap the context
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Find the locked delegation and the entry
+	lockedDelegation, found := k.GetLockedDelegationByEntryID(ctx, req.Id)
+	if !found {
+		return nil, types.ErrLockedDelegationEntryNotFound
+	}
+	exists, entries := lockedDelegation.EntriesForIds([]uint64{req.Id})
+	if !exists {
+		return nil, types.ErrLockedDelegationEntryNotFound
+	}
+	entry := entries[0]
+
+	// Calculate the current entry token value
+	valAddr, err := lockedDelegation.GetValidatorAddr()
+	if err != nil {
+		return nil, err
+	}
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, lockedDelegation.ValidatorAddress)
+	}
+
+	// Estimate the entry share of the locking rewards without changing the state
+	bonus, err := k.EstimateLockedDelegationEntryBonus(ctx, lockedDelegation, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLockedDelegationEntryResponse{
+		DelegatorAddress: lockedDelegation.DelegatorAddress,
+		ValidatorAddress: lockedDelegation.ValidatorAddress,
+		Entry:            entry,
+		Tokens:           validator.TokensFromShares(entry.Shares),
+		TimeRemaining:    entry.TimeRemaining(ctx.BlockTime()),
+		BonusEstimate:    bonus,
+	}, nil
+}
+
+// DelegatorLockingSummary implements the types.QueryServer
+// returns the locking summary of a delegator per validator and in total
+func (k Keeper) DelegatorLockingSummary(c context.Context, req *types.QueryDelegatorLockingSummaryRequest) (*types.QueryDelegatorLockingSummaryResponse, error) {
+	// Validate the parameters
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrEmptyRequest)
+	}
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrEmptyDelegator)
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrap the context
+	ctx := sdk.UnwrapSDKContext(c)
+
+	total, validators, err := k.GetDelegatorLockingSummary(ctx, delAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDelegatorLockingSummaryResponse{Total: total, Validators: validators}, nil
+}
+
+// EntryEscrow implements the types.QueryServer
+// returns the locking rewards held in escrow for a locked delegation entry
+func (k Keeper) EntryEscrow(c context.Context, req *types.QueryEntryEscrowRequest) (*types.QueryEntryEscrowResponse, error) {
+	// Validate the parameters
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrEmptyRequest)
+	}
+
+	// Wrap the context
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryEntryEscrowResponse{Amount: k.GetEntryEscrow(ctx, req.Id)}, nil
+}
@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/aetherevm/locking/locking/keeper"
 	"github.com/aetherevm/locking/locking/tests"
@@ -179,6 +182,91 @@ func (suite *KeeperTestSuite) TestDelegatorLockedDelegations() {
 	}
 }
 
+// TestValidatorLockedDelegations tests the ValidatorLockedDelegations from the query server
+func (suite *KeeperTestSuite) TestValidatorLockedDelegations() {
+	// Start the test with a few delegations in store
+	addresses, valAddresses := createLockedDelegations(suite)
+
+	testCases := []struct {
+		name    string
+		request *types.QueryValidatorLockedDelegationsRequest
+		pass    bool
+	}{
+		{
+			"fail - Empty request",
+			nil,
+			false,
+		},
+		{
+			"fail - Empty validator",
+			&types.QueryValidatorLockedDelegationsRequest{
+				ValidatorAddr: "",
+			},
+			false,
+		},
+		{
+			"fail - invalid validator",
+			&types.QueryValidatorLockedDelegationsRequest{
+				ValidatorAddr: "test",
+			},
+			false,
+		},
+		{
+			"pass - Returns the correct values for the validator",
+			&types.QueryValidatorLockedDelegationsRequest{
+				ValidatorAddr: valAddresses[0].String(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.k.ValidatorLockedDelegations(suite.ctx, tc.request)
+
+			if tc.pass {
+				suite.Require().NoError(err, tc.name)
+
+				// Should only return the locked delegations on the requested validator
+				var expcLockedDelegations []types.LockedDelegationWithTotalShares
+				for _, lockedDelegation := range suite.k.GetAllLockedDelegations(suite.ctx) {
+					if lockedDelegation.ValidatorAddress == tc.request.ValidatorAddr {
+						expcLockedDelegations = append(expcLockedDelegations, types.LockedDelegationWithTotalShares{
+							LockedDelegation: lockedDelegation,
+							TotalLocked:      lockedDelegation.TotalShares(),
+						})
+					}
+				}
+
+				suite.Require().NotEmpty(res.LockedDelegations, tc.name)
+				suite.Require().ElementsMatch(expcLockedDelegations, res.LockedDelegations, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+
+	// The results should be paginated
+	res, err := suite.k.ValidatorLockedDelegations(suite.ctx, &types.QueryValidatorLockedDelegationsRequest{
+		ValidatorAddr: valAddresses[0].String(),
+		Pagination:    &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.LockedDelegations, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	// Deleting a locked delegation should also remove it from the index
+	err = suite.k.DeleteLockedDelegation(suite.ctx, types.NewLockedDelegation(addresses[0], valAddresses[0], nil))
+	suite.Require().NoError(err)
+	var lockedDelegations []types.LockedDelegation
+	suite.k.IterateValidatorLockedDelegations(suite.ctx, valAddresses[0], func(lockedDelegation types.LockedDelegation) (stop bool) {
+		lockedDelegations = append(lockedDelegations, lockedDelegation)
+		return false
+	})
+	suite.Require().Len(lockedDelegations, 1)
+	suite.Require().Equal(addresses[1].String(), lockedDelegations[0].DelegatorAddress)
+}
+
 // TestLockedDelegationRewards tests the LockedDelegationRewards from the query server
 func (suite *KeeperTestSuite) TestLockedDelegationRewards() {
 	// Start the test with a few delegations in store
@@ -264,6 +352,8 @@ func (suite *KeeperTestSuite) TestLockedDelegationRewards() {
 					sdk.NewInt(1_000_000),
 					types.DefaultRates[0].Duration,
 					true,
+					types.ExpiryActionUndelegate,
+					"",
 				)
 				suite.Require().NoError(err)
 
@@ -362,6 +452,8 @@ func (suite *KeeperTestSuite) TestLockedDelegationTotalRewards() {
 					sdk.NewInt(1_000_000),
 					types.DefaultRates[0].Duration,
 					true,
+					types.ExpiryActionUndelegate,
+					"",
 				)
 				suite.Require().NoError(err)
 
@@ -429,6 +521,7 @@ func (suite *KeeperTestSuite) TestStoreLockedDelegationQueryBadMarshal() {
 		suite.app.StakingKeeper,
 		suite.app.DistrKeeper,
 		suite.app.BankKeeper,
+		suite.app.SlashingKeeper,
 		authAddr,
 	)
 
@@ -446,6 +539,286 @@ func (suite *KeeperTestSuite) TestStoreLockedDelegationQueryBadMarshal() {
 	suite.Require().Error(err)
 }
 
+// TestGRPCLockingStats tests the LockingStats from the query server
+func (suite *KeeperTestSuite) TestGRPCLockingStats() {
+	rate := types.DefaultRates[0]
+	createMultipleLDsWithEntries(3, rate, suite)
+	setStatsValidators(suite)
+	valAddr := sdk.ValAddress([]byte("val1"))
+
+	testCases := []struct {
+		name          string
+		request       *types.QueryLockingStatsRequest
+		expValidators int
+		pass          bool
+	}{
+		{
+			"fail - Empty request",
+			nil,
+			0,
+			false,
+		},
+		{
+			"fail - invalid validator",
+			&types.QueryLockingStatsRequest{
+				ValidatorAddr: "test",
+			},
+			0,
+			false,
+		},
+		{
+			"pass - Returns all the validators",
+			&types.QueryLockingStatsRequest{},
+			3,
+			true,
+		},
+		{
+			"pass - Returns only the requested validator",
+			&types.QueryLockingStatsRequest{
+				ValidatorAddr: valAddr.String(),
+			},
+			1,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.k.LockingStats(suite.ctx, tc.request)
+
+			if tc.pass {
+				suite.Require().NoError(err, tc.name)
+
+				// The module wide totals are always returned
+				suite.Require().Equal(math.LegacyNewDec(2*6*(1+2+3)), res.Total.Tokens, tc.name)
+				suite.Require().Len(res.Total.Rates, 1, tc.name)
+				suite.Require().Len(res.Validators, tc.expValidators, tc.name)
+				for _, validator := range res.Validators {
+					suite.Require().Equal(math.LegacyNewDec(2*6), validator.Stats.Shares, tc.name)
+				}
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
+
+// TestGRPCUnlocks tests the Unlocks from the query server
+func (suite *KeeperTestSuite) TestGRPCUnlocks() {
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	otherValAddr := sdk.ValAddress([]byte("val2"))
+	delAddr1 := sdk.AccAddress([]byte("address1"))
+	delAddr2 := sdk.AccAddress([]byte("address2"))
+	rate := types.DefaultRates[0]
+	now := suite.ctx.BlockTime()
+
+	// Store the entries directly, they are queued by unlock time and ID
+	newEntry := func(id uint64, unlockOn time.Time) types.LockedDelegationEntry {
+		return types.NewLockedDelegationEntry(math.LegacyNewDec(int64(id)), rate, unlockOn, id%2 == 0, id)
+	}
+	for _, lockedDelegation := range []types.LockedDelegation{
+		types.NewLockedDelegation(delAddr1, valAddr, []types.LockedDelegationEntry{
+			newEntry(1, now.Add(time.Hour)), newEntry(2, now.Add(2*time.Hour)),
+		}),
+		types.NewLockedDelegation(delAddr2, valAddr, []types.LockedDelegationEntry{
+			newEntry(3, now.Add(time.Hour)), newEntry(4, now.Add(3*time.Hour)),
+		}),
+		types.NewLockedDelegation(delAddr1, otherValAddr, []types.LockedDelegationEntry{
+			newEntry(5, now.Add(2*time.Hour)),
+		}),
+	} {
+		suite.Require().NoError(suite.k.SetLockedDelegation(suite.ctx, lockedDelegation))
+	}
+
+	testCases := []struct {
+		name    string
+		request *types.QueryUnlocksRequest
+		expIds  []uint64
+		pass    bool
+	}{
+		{
+			"fail - Empty request",
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - end before start",
+			&types.QueryUnlocksRequest{StartTime: now.Add(time.Hour), EndTime: now},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid delegator",
+			&types.QueryUnlocksRequest{StartTime: now, EndTime: now.Add(time.Hour), DelegatorAddr: "test"},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid validator",
+			&types.QueryUnlocksRequest{StartTime: now, EndTime: now.Add(time.Hour), ValidatorAddr: "test"},
+			nil,
+			false,
+		},
+		{
+			"pass - ordered by unlock time and ID with inclusive bounds",
+			&types.QueryUnlocksRequest{StartTime: now.Add(time.Hour), EndTime: now.Add(2 * time.Hour)},
+			[]uint64{1, 3, 2, 5},
+			true,
+		},
+		{
+			"pass - filtered by delegator",
+			&types.QueryUnlocksRequest{StartTime: now, EndTime: now.Add(3 * time.Hour), DelegatorAddr: delAddr1.String()},
+			[]uint64{1, 2, 5},
+			true,
+		},
+		{
+			"pass - filtered by validator",
+			&types.QueryUnlocksRequest{StartTime: now, EndTime: now.Add(3 * time.Hour), ValidatorAddr: otherValAddr.String()},
+			[]uint64{5},
+			true,
+		},
+		{
+			"pass - nothing on the range",
+			&types.QueryUnlocksRequest{StartTime: now.Add(4 * time.Hour), EndTime: now.Add(5 * time.Hour)},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.k.Unlocks(suite.ctx, tc.request)
+
+			if tc.pass {
+				suite.Require().NoError(err, tc.name)
+
+				var ids []uint64
+				for _, unlock := range res.Unlocks {
+					ids = append(ids, unlock.Entry.Id)
+
+					// The token value is only known for existing validators
+					expcTokens := math.LegacyZeroDec()
+					if unlock.ValidatorAddress == valAddr.String() {
+						expcTokens = validator.TokensFromShares(unlock.Entry.Shares)
+					}
+					suite.Require().Equal(expcTokens, unlock.Tokens, tc.name)
+				}
+				suite.Require().Equal(tc.expIds, ids, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+
+	// The results should be paginated
+	request := &types.QueryUnlocksRequest{
+		StartTime:  now,
+		EndTime:    now.Add(3 * time.Hour),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	}
+	res, err := suite.k.Unlocks(suite.ctx, request)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Unlocks, 2)
+	suite.Require().Equal(uint64(5), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	request.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	res, err = suite.k.Unlocks(suite.ctx, request)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.Unlocks[0].Entry.Id)
+	suite.Require().Equal(uint64(5), res.Unlocks[1].Entry.Id)
+
+	request.Pagination = &query.PageRequest{Offset: 4}
+	res, err = suite.k.Unlocks(suite.ctx, request)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Unlocks, 1)
+	suite.Require().Equal(uint64(4), res.Unlocks[0].Entry.Id)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	// The key and the offset can't be used together
+	request.Pagination = &query.PageRequest{Key: []byte{0x01}, Offset: 1}
+	_, err = suite.k.Unlocks(suite.ctx, request)
+	suite.Require().Error(err)
+}
+
+// TestGRPCLockedDelegationEntry tests the LockedDelegationEntry from the query server
+func (suite *KeeperTestSuite) TestGRPCLockedDelegationEntry() {
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	delAddr := sdk.AccAddress([]byte("address1"))
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+
+	// Create two entries with different rates
+	err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(
+		suite.ctx,
+		types.ModuleName,
+		delAddr,
+		sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(2_000_000))),
+	)
+	suite.Require().NoError(err)
+	var entries []types.LockedDelegationEntry
+	for _, rate := range []types.Rate{types.DefaultRates[0], types.DefaultRates[3]} {
+		entry, err := suite.k.CreateLockedDelegationEntryAndDelegate(
+			suite.ctx, delAddr, valAddr, sdk.NewInt(1_000_000), rate.Duration, false, types.ExpiryActionUndelegate, "",
+		)
+		suite.Require().NoError(err)
+		entries = append(entries, entry)
+	}
+
+	// Allocate rewards to the validator and move a block forward
+	initial := sdk.TokensFromConsensusPower(10, PowerReduction)
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, sdk.DecCoins{sdk.NewDecCoin(bondDenom, initial)})
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+
+	// Invalid requests
+	_, err = suite.k.LockedDelegationEntry(suite.ctx, nil)
+	suite.Require().Error(err)
+	_, err = suite.k.LockedDelegationEntry(suite.ctx, &types.QueryLockedDelegationEntryRequest{Id: 1000})
+	suite.Require().ErrorIs(err, types.ErrLockedDelegationEntryNotFound)
+
+	reward, err := suite.k.EstimateLockedRewards(suite.ctx, delAddr, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().False(reward.LockingReward.IsZero())
+
+	validator, found := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.Require().True(found)
+
+	totalBonus := sdk.DecCoins{}
+	var bonuses []sdk.DecCoins
+	for _, entry := range entries {
+		res, err := suite.k.LockedDelegationEntry(suite.ctx, &types.QueryLockedDelegationEntryRequest{Id: entry.Id})
+		suite.Require().NoError(err)
+
+		suite.Require().Equal(delAddr.String(), res.DelegatorAddress)
+		suite.Require().Equal(valAddr.String(), res.ValidatorAddress)
+		suite.Require().Equal(entry, res.Entry)
+		suite.Require().Equal(validator.TokensFromShares(entry.Shares), res.Tokens)
+		suite.Require().Equal(entry.Rate.Duration-time.Hour, res.TimeRemaining)
+
+		bonuses = append(bonuses, res.BonusEstimate)
+		totalBonus = totalBonus.Add(res.BonusEstimate...)
+	}
+
+	// The entry with the higher rate earns a bigger share, and the shares add up to the pair rewards
+	suite.Require().True(bonuses[0].AmountOf(bondDenom).LT(bonuses[1].AmountOf(bondDenom)))
+	expected, _ := reward.LockingReward.TruncateDecimal()
+	actual, _ := totalBonus.TruncateDecimal()
+	suite.Require().True(expected.Sub(actual...).IsAllLTE(sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.OneInt()))))
+
+	// The query doesn't change the state
+	rewardAfter, err := suite.k.EstimateLockedRewards(suite.ctx, delAddr, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(reward, rewardAfter)
+
+	// An expired entry has no time remaining
+	suite.ctx = suite.ctx.WithBlockTime(entries[0].UnlockOn.Add(time.Second))
+	res, err := suite.k.LockedDelegationEntry(suite.ctx, &types.QueryLockedDelegationEntryRequest{Id: entries[0].Id})
+	suite.Require().NoError(err)
+	suite.Require().Zero(res.TimeRemaining)
+}
+
 // createLockedDelegations sets up locked delegations for a predefined set of addresses and validators
 func createLockedDelegations(suite *KeeperTestSuite) (addresses []sdk.AccAddress, valAddresses []sdk.ValAddress) {
 	addresses = []sdk.AccAddress{
@@ -1,8 +1,15 @@
+//go:build !nodisthooks
+
+// The distribution hooks are only available on the aetherevm cosmos-sdk fork
+// Build with the nodisthooks tag to use the module with the stock cosmos-sdk on the standalone reward mode
+
 package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/aetherevm/locking/locking/types"
 )
 
 // Wrapper struct
@@ -28,7 +35,12 @@ func (k Keeper) DistributionHooks() DistributionHooks {
 //     The staking hook for undelegate will also call this hook
 //
 // This means that we are making a withdraw at the locked delegation state critical moments
+// On the standalone reward mode the hook does nothing, the locking rewards are withdrawn by their own message
 func (h DistributionHooks) AfterWithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) error {
+	if h.k.GetParams(ctx).RewardMode == types.RewardModeStandalone {
+		return nil
+	}
+
 	_, err := h.k.withdrawLockedDelegationRewards(ctx, delAddr, valAddr, rewards)
 	if err != nil {
 		return err
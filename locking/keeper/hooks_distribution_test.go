@@ -1,3 +1,5 @@
+//go:build !nodisthooks
+
 package keeper_test
 
 import (
@@ -17,6 +19,11 @@ func (suite *KeeperTestSuite) ensureDistributionHooksSet() {
 	suite.app.DistrKeeper.SetHooks(suite.k.DistributionHooks())
 }
 
+// afterWithdrawDelegationRewards calls the distribution hook run after a delegation rewards withdraw
+func (suite *KeeperTestSuite) afterWithdrawDelegationRewards(delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) error {
+	return suite.k.DistributionHooks().AfterWithdrawDelegationRewards(suite.ctx, delAddr, valAddr, rewards)
+}
+
 // TestAfterWithdrawDelegationRewardsDirectCall tests the AfterWithdrawDelegationRewards function from a direct call
 func (suite *KeeperTestSuite) TestAfterWithdrawDelegationRewardsDirectCall() {
 	// Ensure that the hooks are set
@@ -42,6 +49,8 @@ func (suite *KeeperTestSuite) TestAfterWithdrawDelegationRewardsDirectCall() {
 		initial.Mul(math.NewInt(50)),
 		types.NewRate(200, sdk.NewDec(5)),
 		false,
+		types.ExpiryActionUndelegate,
+		"",
 	)
 	suite.Require().NoError(err)
 	_, err = suite.k.CreateLockedDelegationEntry(
@@ -51,6 +60,8 @@ func (suite *KeeperTestSuite) TestAfterWithdrawDelegationRewardsDirectCall() {
 		initial.Mul(math.NewInt(20)),
 		types.NewRate(200, sdk.NewDec(4)),
 		false,
+		types.ExpiryActionUndelegate,
+		"",
 	)
 	suite.Require().NoError(err)
 
@@ -98,8 +109,9 @@ func (suite *KeeperTestSuite) TestAfterWithdrawDelegationRewardsDirectCall() {
 		},
 	}
 	for _, tc := range testCases {
-		// Save the original balance and call the hook
+		// Save the original balance and remainder and call the hook
 		initialBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
+		remainder := suite.k.GetRewardRemainder(suite.ctx, delAddr, valAddr)
 		err = suite.k.DistributionHooks().AfterWithdrawDelegationRewards(
 			suite.ctx,
 			delAddr,
@@ -110,18 +122,19 @@ func (suite *KeeperTestSuite) TestAfterWithdrawDelegationRewardsDirectCall() {
 
 		// We ensure that the new tokens were transferred
 		newBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr)
-		// Ratio of 0.0132
+		// Ratio of 0.0132, the previous remainder is paid with the rewards
 		ratio := sdk.NewDecWithPrec(132, 4)
-		expectedRewards := sdk.NewDecCoinsFromCoins(tc.rewards...).MulDecTruncate(ratio)
+		expectedRewards := sdk.NewDecCoinsFromCoins(tc.rewards...).MulDecTruncate(ratio).Add(remainder...)
 
 		// Add the expected rewards on top of the initial balance
 		for _, coin := range initialBalance {
 			expectedRewards = expectedRewards.Add(sdk.NewDecCoinFromCoin(coin))
 		}
 
-		expectedRewardsTruncated, _ := expectedRewards.TruncateDecimal()
+		expectedRewardsTruncated, expectedRemainder := expectedRewards.TruncateDecimal()
 
 		suite.Require().Equal(expectedRewardsTruncated, newBalance)
+		suite.Require().Equal(expectedRemainder, suite.k.GetRewardRemainder(suite.ctx, delAddr, valAddr))
 	}
 }
 
@@ -148,6 +161,8 @@ func (suite *KeeperTestSuite) TestAfterWithdrawDelegationRewardsDistributionClai
 		math.NewInt(25),
 		types.NewRate(200, sdk.NewDec(5)),
 		false,
+		types.ExpiryActionUndelegate,
+		"",
 	)
 	suite.Require().NoError(err)
 	_, err = suite.k.CreateLockedDelegationEntry(
@@ -157,6 +172,8 @@ func (suite *KeeperTestSuite) TestAfterWithdrawDelegationRewardsDistributionClai
 		math.NewInt(50),
 		types.NewRate(200, sdk.NewDec(5)),
 		false,
+		types.ExpiryActionUndelegate,
+		"",
 	)
 	suite.Require().NoError(err)
 
@@ -220,6 +237,8 @@ func (suite *KeeperTestSuite) TestAfterWithdrawDelegationRewardsBeforeDelegation
 		math.NewInt(50),
 		types.NewRate(200, sdk.NewDec(5)),
 		false,
+		types.ExpiryActionUndelegate,
+		"",
 	)
 	suite.Require().NoError(err)
 	_, err = suite.k.CreateLockedDelegationEntry(
@@ -229,6 +248,8 @@ func (suite *KeeperTestSuite) TestAfterWithdrawDelegationRewardsBeforeDelegation
 		math.NewInt(50),
 		types.NewRate(200, sdk.NewDec(5)),
 		false,
+		types.ExpiryActionUndelegate,
+		"",
 	)
 	suite.Require().NoError(err)
 
@@ -277,19 +298,3 @@ func (suite *KeeperTestSuite) TestAfterWithdrawDelegationRewardsBeforeDelegation
 	expectedRewardsTruncated, _ := expectedRewards.TruncateDecimal()
 	suite.Require().Equal(expectedRewardsTruncated.String(), newBalance.String())
 }
-
-// setupDistributionHooksTest setup a testing delegation
-func setupDistributionHooksTest(suite *KeeperTestSuite, delegationValue math.Int, delAddr sdk.AccAddress, validator stakingtypes.Validator) {
-	// Set a delegator address and get the current chain validator
-	denom := suite.app.StakingKeeper.BondDenom(suite.ctx)
-
-	// Send a few tokens to the delegator
-	err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, delAddr, sdk.NewCoins(
-		sdk.NewCoin(denom, delegationValue.Mul(math.NewInt(2))),
-	))
-	suite.Require().NoError(err)
-
-	// Create a delegation
-	_, err = suite.app.StakingKeeper.Delegate(suite.ctx, delAddr, delegationValue, stakingtypes.Unbonded, validator, true)
-	suite.Require().NoError(err)
-}
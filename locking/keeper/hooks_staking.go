@@ -104,12 +104,18 @@ func (StakingHooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 }
 
 // AfterValidatorBeginUnbonding implements types.StakingHooks
-func (StakingHooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+// The validator left the active set, it's checked at the end block to see if it was tombstoned
+func (h StakingHooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	if h.k.GetParams(ctx).ValidatorExitPolicy != types.ValidatorExitPolicyNone {
+		h.k.SetExitedValidatorQueue(ctx, valAddr)
+	}
 	return nil
 }
 
 // AfterValidatorBonded implements types.StakingHooks
-func (StakingHooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+// A validator back on the active set loses the free redelegation
+func (h StakingHooks) AfterValidatorBonded(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	h.k.DeleteFreeRedelegationValidator(ctx, valAddr)
 	return nil
 }
 
@@ -119,7 +125,12 @@ func (StakingHooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error
 }
 
 // AfterValidatorRemoved implements types.StakingHooks
-func (StakingHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+// A removed validator has no delegations left, so its slash events can't apply to any entry
+func (h StakingHooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	h.k.DeleteValidatorSlashEvents(ctx, valAddr)
+	if h.k.GetParams(ctx).ValidatorExitPolicy != types.ValidatorExitPolicyNone {
+		h.k.SetExitedValidatorQueue(ctx, valAddr)
+	}
 	return nil
 }
 
@@ -129,6 +140,7 @@ func (StakingHooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) err
 }
 
 // BeforeValidatorSlashed implements types.StakingHooks
-func (StakingHooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ math.LegacyDec) error {
-	return nil
+// The slash is recorded, so locked entries token value can be tracked after the slash
+func (h StakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error {
+	return h.k.RecordValidatorSlash(ctx, valAddr, fraction)
 }
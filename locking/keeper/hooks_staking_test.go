@@ -50,6 +50,8 @@ func (suite *KeeperTestSuite) TestDelegationModified() {
 						sdk.NewCoin(bondDenom, sdk.NewInt(20)),
 						rate.Duration,
 						false,
+						types.ExpiryActionUndelegate,
+						"",
 					),
 				)
 				suite.Require().NoError(err)
@@ -75,6 +77,8 @@ func (suite *KeeperTestSuite) TestDelegationModified() {
 						sdk.NewCoin(bondDenom, sdk.NewInt(20)),
 						rate.Duration,
 						false,
+						types.ExpiryActionUndelegate,
+						"",
 					),
 				)
 				suite.Require().NoError(err)
@@ -100,6 +104,8 @@ func (suite *KeeperTestSuite) TestDelegationModified() {
 						sdk.NewCoin(bondDenom, sdk.NewInt(20)),
 						rate.Duration,
 						false,
+						types.ExpiryActionUndelegate,
+						"",
 					),
 				)
 				suite.Require().NoError(err)
@@ -129,6 +135,8 @@ func (suite *KeeperTestSuite) TestDelegationModified() {
 						sdk.NewCoin(bondDenom, sdk.NewInt(20)),
 						rate.Duration,
 						false,
+						types.ExpiryActionUndelegate,
+						"",
 					),
 				)
 				suite.Require().NoError(err)
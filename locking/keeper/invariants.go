@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/aetherevm/locking/locking/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -13,18 +15,41 @@ const (
 	InvariantLDBiggerDelegation = "\tlocked delegation with shares bigger than delegation: %+v\n"
 
 	InvariantLDFound = "%d invalid locked delegations found\n%s"
+
+	InvariantStatsValidator = "\tvalidator %s locked shares for %s: stored %s, expected %s\n"
+	InvariantStatsTotal     = "\tmodule wide locked shares for %s: stored %s, expected %s\n"
+
+	InvariantStatsFound = "%d invalid locking stats found\n%s"
+
+	InvariantQueueStale   = "\tqueued entry %d unlocking on %s for %s has no matching locked delegation entry\n"
+	InvariantQueueMissing = "\tlocked delegation entry %d unlocking on %s for %s is not queued\n"
+	InvariantQueueInvalid = "\tinvalid queue key: %s\n"
+
+	InvariantQueueFound = "%d invalid locked delegation queue entries found\n%s"
 )
 
 // RegisterInvariants registers all locking invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 	ir.RegisterRoute(types.ModuleName, "valid-locked-delegation",
 		ValidLockedDelegation(k))
+	ir.RegisterRoute(types.ModuleName, "locking-stats",
+		LockingStatsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "locked-delegation-queue",
+		LockedDelegationQueueInvariant(k))
 }
 
 // AllInvariants runs all invariants of the locking module.
 func AllInvariants(k *Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return ValidLockedDelegation(k)(ctx)
+		res, stop := ValidLockedDelegation(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = LockingStatsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return LockedDelegationQueueInvariant(k)(ctx)
 	}
 }
 
@@ -64,3 +89,140 @@ func ValidLockedDelegation(k *Keeper) sdk.Invariant {
 			InvariantLDFound, count, msg)), broken
 	}
 }
+
+// LockingStatsInvariant checks if the locking stats match the locked delegations on store
+func LockingStatsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		// Calculate the expected stats from all the locked delegations
+		type validatorDuration struct {
+			validator string
+			duration  time.Duration
+		}
+		expcValidators := make(map[validatorDuration]math.LegacyDec)
+		expcTotals := make(map[time.Duration]math.LegacyDec)
+		k.IterateLockedDelegations(ctx, func(lockedDelegation types.LockedDelegation) bool {
+			for _, entry := range lockedDelegation.Entries {
+				key := validatorDuration{lockedDelegation.ValidatorAddress, entry.Rate.Duration}
+				if _, found := expcValidators[key]; !found {
+					expcValidators[key] = math.LegacyZeroDec()
+				}
+				expcValidators[key] = expcValidators[key].Add(entry.Shares)
+
+				if _, found := expcTotals[entry.Rate.Duration]; !found {
+					expcTotals[entry.Rate.Duration] = math.LegacyZeroDec()
+				}
+				expcTotals[entry.Rate.Duration] = expcTotals[entry.Rate.Duration].Add(entry.Shares)
+			}
+			return false
+		})
+
+		// Check the stored stats against the expected ones, the checked ones are removed
+		k.IterateValidatorLockedShares(ctx, func(valAddr sdk.ValAddress, duration time.Duration, shares math.LegacyDec) bool {
+			key := validatorDuration{valAddr.String(), duration}
+			expected, found := expcValidators[key]
+			if !found {
+				expected = math.LegacyZeroDec()
+			}
+			if !shares.Equal(expected) {
+				count++
+				msg += fmt.Sprintf(InvariantStatsValidator, valAddr, duration, shares, expected)
+			}
+			delete(expcValidators, key)
+			return false
+		})
+		k.IterateTotalLockedShares(ctx, func(duration time.Duration, shares math.LegacyDec) bool {
+			expected, found := expcTotals[duration]
+			if !found {
+				expected = math.LegacyZeroDec()
+			}
+			if !shares.Equal(expected) {
+				count++
+				msg += fmt.Sprintf(InvariantStatsTotal, duration, shares, expected)
+			}
+			delete(expcTotals, duration)
+			return false
+		})
+
+		// Any remaining expected stat is missing from the store
+		for key, expected := range expcValidators {
+			if !expected.IsZero() {
+				count++
+				msg += fmt.Sprintf(InvariantStatsValidator, key.validator, key.duration, math.LegacyZeroDec(), expected)
+			}
+		}
+		for duration, expected := range expcTotals {
+			if !expected.IsZero() {
+				count++
+				msg += fmt.Sprintf(InvariantStatsTotal, duration, math.LegacyZeroDec(), expected)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "locking stats", fmt.Sprintf(
+			InvariantStatsFound, count, msg)), broken
+	}
+}
+
+// LockedDelegationQueueInvariant checks if the queue and the locked delegation entries on store agree
+// Every queued entry must exist with the same unlock time and pair, and every entry not expired yet must be queued
+// Expired entries may already be dequeued while their pair waits to be completed or is quarantined
+func LockedDelegationQueueInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		// Map the stored entries by their ID
+		type queueItem struct {
+			pair  types.LockedDelegationPair
+			entry types.LockedDelegationEntry
+		}
+		expcItems := make(map[uint64]queueItem)
+		k.IterateLockedDelegations(ctx, func(lockedDelegation types.LockedDelegation) bool {
+			pair := types.LockedDelegationPair{
+				DelegatorAddress: lockedDelegation.DelegatorAddress,
+				ValidatorAddress: lockedDelegation.ValidatorAddress,
+			}
+			for _, entry := range lockedDelegation.Entries {
+				expcItems[entry.Id] = queueItem{pair, entry}
+			}
+			return false
+		})
+
+		// Check the queued entries against the stored ones, the checked ones are removed
+		err := k.IterateLockedDelegationQueue(ctx, func(unlockOn time.Time, id uint64, pair types.LockedDelegationPair) bool {
+			expected, found := expcItems[id]
+			if !found || expected.pair != pair || !expected.entry.UnlockOn.Equal(unlockOn) {
+				count++
+				msg += fmt.Sprintf(InvariantQueueStale, id, unlockOn, pair.DelegatorAddress)
+				return false
+			}
+			delete(expcItems, id)
+			return false
+		})
+		if err != nil {
+			count++
+			msg += fmt.Sprintf(InvariantQueueInvalid, err)
+		}
+
+		// Any remaining entry that didn't expire is missing from the queue
+		for id, expected := range expcItems {
+			if !expected.entry.Expired(ctx.BlockTime()) {
+				count++
+				msg += fmt.Sprintf(InvariantQueueMissing, id, expected.entry.UnlockOn, expected.pair.DelegatorAddress)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "locked delegation queue", fmt.Sprintf(
+			InvariantQueueFound, count, msg)), broken
+	}
+}
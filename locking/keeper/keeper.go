@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +24,7 @@ type Keeper struct {
 	stakingKeeper      types.StakingKeeper
 	distributionKeeper types.DistributionKeeper
 	bankKeeper         types.BankKeeper
+	slashingKeeper     types.SlashingKeeper
 
 	authority string
 }
@@ -32,6 +36,7 @@ func NewKeeper(
 	sk types.StakingKeeper,
 	dk types.DistributionKeeper,
 	bk types.BankKeeper,
+	slk types.SlashingKeeper,
 	authority string,
 ) *Keeper {
 	// ensure that authority is a valid AccAddress
@@ -45,6 +50,7 @@ func NewKeeper(
 		stakingKeeper:      sk,
 		distributionKeeper: dk,
 		bankKeeper:         bk,
+		slashingKeeper:     slk,
 		authority:          authority,
 	}
 }
@@ -63,3 +69,8 @@ func (k *Keeper) SetDistributionKeeper(dk types.DistributionKeeper) {
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
+
+// Logger returns a module specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -64,8 +64,33 @@ func (k Keeper) IterateDelegatorLockedDelegations(ctx sdk.Context, delegator sdk
 	}
 }
 
+// IterateValidatorLockedDelegations iterates through the locked delegations on a validator using the validator index
+func (k Keeper) IterateValidatorLockedDelegations(ctx sdk.Context, validator sdk.ValAddress, cb func(lockedDelegation types.LockedDelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.GetLockedDelegationsByValidatorIndexKey(validator)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// The index keys are built by the keeper, so they are always valid
+		delAddr, err := types.ParseLockedDelegationByValidatorIndexKey(iterator.Key()[len(prefix):])
+		if err != nil {
+			panic(err)
+		}
+		lockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, validator)
+		if !found {
+			continue
+		}
+		if cb(lockedDelegation) {
+			break
+		}
+	}
+}
+
 // SetLockedDelegation sets a locked delegation
-// This doesn't add current entries to the queue
+// The queue is updated with the entries that were added, moved or removed
+// It doesn't accrue the locking rewards, the callers changing the entry weights take the checkpoint before it
 func (k Keeper) SetLockedDelegation(ctx sdk.Context, lockedDelegation types.LockedDelegation) error {
 	// First we validate
 	err := lockedDelegation.Validate()
@@ -87,12 +112,25 @@ func (k Keeper) SetLockedDelegation(ctx sdk.Context, lockedDelegation types.Lock
 		return err
 	}
 
+	// Keep the locking stats and the queue updated, replacing the stored entries by the new ones
+	var oldEntries []types.LockedDelegationEntry
+	if oldLockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, valAddr); found {
+		oldEntries = oldLockedDelegation.Entries
+		k.updateLockingStats(ctx, valAddr, oldEntries, false)
+	}
+	k.updateLockingStats(ctx, valAddr, lockedDelegation.Entries, true)
+	k.updateLockedDelegationQueue(ctx, lockedDelegation, oldEntries, lockedDelegation.Entries)
+
 	key := types.GetLockedDelegationKey(delAddr, valAddr)
 	store.Set(key, bz)
+
+	// Keep the validator index updated
+	store.Set(types.GetLockedDelegationByValidatorIndexKey(delAddr, valAddr), []byte{})
 	return nil
 }
 
 // DeleteLockedDelegation removes a locked delegation
+// It doesn't accrue the locking rewards, the callers take the checkpoint before it
 func (k Keeper) DeleteLockedDelegation(ctx sdk.Context, lockedDelegation types.LockedDelegation) error {
 	// We set the delAddr and valAddr to be used to get the key
 	delAddr := sdk.MustAccAddressFromBech32(lockedDelegation.DelegatorAddress)
@@ -106,14 +144,21 @@ func (k Keeper) DeleteLockedDelegation(ctx sdk.Context, lockedDelegation types.L
 		return err
 	}
 
+	// Remove the stored entries from the locking stats and the queue
+	if oldLockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, valAddr); found {
+		k.updateLockingStats(ctx, valAddr, oldLockedDelegation.Entries, false)
+		k.updateLockedDelegationQueue(ctx, lockedDelegation, oldLockedDelegation.Entries, nil)
+	}
+
 	key := types.GetLockedDelegationKey(delAddr, valAddr)
 	store.Delete(key)
+	store.Delete(types.GetLockedDelegationByValidatorIndexKey(delAddr, valAddr))
 	return nil
 }
 
 // SetLockedDelegationEntry adds an entry to the locked delegation
 // It creates the locked delegation if it does not exist.
-// this doesn't directly add the entry to the queue or to the look up
+// The entry is queued with the locked delegation, but it isn't added to the look up
 func (k Keeper) SetLockedDelegationEntry(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
 	entry types.LockedDelegationEntry,
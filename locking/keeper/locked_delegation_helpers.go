@@ -3,8 +3,10 @@ package keeper
 import (
 	"time"
 
+	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/aetherevm/locking/locking/types"
@@ -12,6 +14,7 @@ import (
 
 // CreateLockedDelegationEntry creates a new locked delegation
 // It also adds the new entry to the queue and add it to index loop up
+// An entry with the same values as a stored one is merged into it, so the stored entry is returned
 func (k Keeper) CreateLockedDelegationEntry(
 	ctx sdk.Context,
 	delAddr sdk.AccAddress,
@@ -19,12 +22,19 @@ func (k Keeper) CreateLockedDelegationEntry(
 	amount math.Int,
 	rate types.Rate,
 	autoRenew bool,
+	expiryAction types.ExpiryAction,
+	redelegateTo string,
 ) (types.LockedDelegationEntry, error) {
 	// Check if we have reach the limit of entries
 	if k.HasMaxLockedDelegationEntries(ctx, delAddr, valAddr) {
 		return types.LockedDelegationEntry{}, types.ErrMaxLockedDelegationEntriesReached
 	}
 
+	// Check the expiry action before anything is stored
+	if err := k.validateExpiryAction(ctx, valAddr, expiryAction, redelegateTo); err != nil {
+		return types.LockedDelegationEntry{}, err
+	}
+
 	// Create a new entry with a new ID
 	unlockOn := ctx.BlockTime().Add(rate.Duration)
 	id := k.IncrementLockedDelegationEntryID(ctx)
@@ -43,26 +53,41 @@ func (k Keeper) CreateLockedDelegationEntry(
 		autoRenew,
 		id,
 	)
+	entry.ExpiryAction = expiryAction
+	entry.RedelegateTo = redelegateTo
 	if err := entry.Validate(); err != nil {
 		return types.LockedDelegationEntry{}, err
 	}
 
-	// Store the entry on the system
+	// Accrue the locking rewards earned with the stored entries before adding the new one
+	if err := k.checkpointLockedDelegationRewards(ctx, delAddr, valAddr); err != nil {
+		return types.LockedDelegationEntry{}, err
+	}
+
+	// Store the entry on the system, this also adds it to the queue
 	lockedDelegation, err := k.SetLockedDelegationEntry(ctx, delAddr, valAddr, entry)
 	if err != nil {
 		return types.LockedDelegationEntry{}, err
 	}
 
-	// Add it to the queue
-	k.InsertLockedDelegationQueue(ctx, lockedDelegation, entry.UnlockOn)
-
 	// Add it to the index look up
-	err = k.SetLockedDelegationByEntryID(ctx, lockedDelegation, id)
+	// An entry merged into one with the same values is looked up by the ID it's stored under
+	stored, _ := lockedDelegation.MatchingEntry(entry)
+	err = k.SetLockedDelegationByEntryID(ctx, lockedDelegation, stored.Id)
 	if err != nil {
 		return types.LockedDelegationEntry{}, err
 	}
 
-	return entry, nil
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockCreated{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Entry:            stored,
+	})
+	if err != nil {
+		return types.LockedDelegationEntry{}, err
+	}
+
+	return stored, nil
 }
 
 // CreateLockedDelegationEntryAndDelegate creates a new locked delegation entry and a new delegation on top
@@ -73,6 +98,8 @@ func (k Keeper) CreateLockedDelegationEntryAndDelegate(
 	amount math.Int,
 	lockDuration time.Duration,
 	autoRenew bool,
+	expiryAction types.ExpiryAction,
+	redelegateTo string,
 ) (types.LockedDelegationEntry, error) {
 	// Check if the selected rate exists
 	params := k.GetParams(ctx)
@@ -96,6 +123,8 @@ func (k Keeper) CreateLockedDelegationEntryAndDelegate(
 		amount,
 		rate,
 		autoRenew,
+		expiryAction,
+		redelegateTo,
 	)
 	if err != nil {
 		return types.LockedDelegationEntry{}, err
@@ -120,6 +149,61 @@ func (k Keeper) CreateLockedDelegationEntryAndDelegate(
 	return entry, nil
 }
 
+// LockExistingDelegationEntry creates a new locked delegation entry on top of an existing delegation
+// Only the delegation shares that aren't locked yet can be used
+func (k Keeper) LockExistingDelegationEntry(
+	ctx sdk.Context,
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	amount math.Int,
+	lockDuration time.Duration,
+	autoRenew bool,
+	expiryAction types.ExpiryAction,
+	redelegateTo string,
+) (types.LockedDelegationEntry, error) {
+	// Check if the selected rate exists
+	params := k.GetParams(ctx)
+	rate, found := params.GetRateFromDuration(lockDuration)
+	if !found {
+		return types.LockedDelegationEntry{}, types.ErrCreateLockedDelegationDurationUnmatch
+	}
+
+	// Check the validator and the delegation
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.LockedDelegationEntry{}, stakingtypes.ErrNoValidatorFound
+	}
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.LockedDelegationEntry{}, types.ErrNoDelegationExists
+	}
+
+	// The shares not locked yet must cover the requested amount
+	shares := types.CalculateSharesFromValidator(amount, validator)
+	unlockedShares := delegation.Shares.Sub(k.LockedDelegationTotalShares(ctx, delAddr, valAddr))
+	if unlockedShares.LT(shares) {
+		return types.LockedDelegationEntry{}, types.ErrInsufficientUnlockedShares
+	}
+
+	// Do a rewards withdraw before the locked shares change
+	_, err := k.distributionKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return types.LockedDelegationEntry{}, err
+	}
+
+	// Create the entry, this also sets the queue and the look up
+	return k.CreateLockedDelegationEntry(
+		ctx,
+		delAddr,
+		valAddr,
+		amount,
+		rate,
+		autoRenew,
+		expiryAction,
+		redelegateTo,
+	)
+}
+
 // LockedDelegationRedelegation move the locked delegations from one validator to another
 // This also sets the new queue for the target validator
 func (k Keeper) LockedDelegationRedelegation(
@@ -147,10 +231,32 @@ func (k Keeper) LockedDelegationRedelegation(
 		return math.LegacyDec{}, math.Int{}, types.ErrLockedDelegationEntryNotFound
 	}
 
+	// Accrue the locking rewards earned on both validators before the entries are moved
+	for _, valAddr := range []sdk.ValAddress{valSrcAddr, valDstAddr} {
+		if err := k.checkpointLockedDelegationRewards(ctx, delAddr, valAddr); err != nil {
+			return math.LegacyDec{}, math.Int{}, err
+		}
+	}
+
+	// Delete all the ids from the src locked delegation
+	// This is done first, so the entries leave the queue before being queued for the destination
+	srcLockedDelegation.RemoveEntries(foundSrcEntries)
+
+	// Delete the empty locked delegation if empty
+	// If not, update the old entry
+	if len(srcLockedDelegation.Entries) == 0 {
+		err = k.DeleteLockedDelegation(ctx, srcLockedDelegation)
+	} else {
+		err = k.SetLockedDelegation(ctx, srcLockedDelegation)
+	}
+	if err != nil {
+		return math.LegacyDec{}, math.Int{}, err
+	}
+
 	// Now apply the real redelegate
-	var dstLockedDelegation types.LockedDelegation
 	tokensMoved := math.ZeroInt()
 	sharesMoved := math.LegacyZeroDec()
+	movedEntries := make([]types.LockedDelegationEntry, 0, len(foundSrcEntries))
 	for _, entry := range foundSrcEntries {
 		// Delete the old ID from lookup, since we are changing validators
 		k.DeleteLockedDelegationIndex(ctx, entry.Id)
@@ -165,35 +271,39 @@ func (k Keeper) LockedDelegationRedelegation(
 		// Update the share value
 		// This is necessary, since the new validator may use a different share ratio
 		shares := types.CalculateSharesFromValidator(tokensToRemove, dstValidator)
-		if err != nil {
-			return math.LegacyDec{}, math.Int{}, err
-		}
 		entry.Shares = shares
 
-		// Store the new locked delegation entries on top of the destination validator
-		dstLockedDelegation, err = k.SetLockedDelegationEntry(ctx, delAddr, valDstAddr, entry)
+		// An entry can't be redelegated to the validator it's already on, so it stays delegated there
+		if entry.ExpiryAction == types.ExpiryActionRedelegate && entry.RedelegateTo == valDstAddr.String() {
+			entry.ExpiryAction = types.ExpiryActionStayDelegated
+			entry.RedelegateTo = ""
+		}
+
+		// Store the entry on top of the destination validator, it keeps its ID so it's never merged
+		// This also queues it for the destination validator
+		dstLockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, valDstAddr)
+		if !found {
+			dstLockedDelegation = types.NewLockedDelegation(delAddr, valDstAddr, nil)
+		}
+		dstLockedDelegation.AppendEntry(entry)
+		err = k.SetLockedDelegation(ctx, dstLockedDelegation)
 		if err != nil {
 			return math.LegacyDec{}, math.Int{}, err
 		}
-		// Add it to the queue
-		k.InsertLockedDelegationQueue(ctx, dstLockedDelegation, entry.UnlockOn)
 		// Add it to the look up
 		err = k.SetLockedDelegationByEntryID(ctx, dstLockedDelegation, entry.Id)
 		if err != nil {
 			return math.LegacyDec{}, math.Int{}, err
 		}
+		movedEntries = append(movedEntries, entry)
 	}
 
-	// Delete all the ids from the src locked delegation
-	srcLockedDelegation.RemoveEntries(foundSrcEntries)
-
-	// Delete the empty locked delegation if empty
-	// If not, update the old entry
-	if len(srcLockedDelegation.Entries) == 0 {
-		err = k.DeleteLockedDelegation(ctx, srcLockedDelegation)
-	} else {
-		err = k.SetLockedDelegation(ctx, srcLockedDelegation)
-	}
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockRedelegated{
+		DelegatorAddress:    delAddr.String(),
+		ValidatorSrcAddress: valSrcAddr.String(),
+		ValidatorDstAddress: valDstAddr.String(),
+		Entries:             movedEntries,
+	})
 	if err != nil {
 		return math.LegacyDec{}, math.Int{}, err
 	}
@@ -210,7 +320,9 @@ func (k Keeper) prepareLockedDelegationRedelegation(
 	valDstAddr sdk.ValAddress,
 ) (srcLockedDelegation types.LockedDelegation, srcValidator, dstValidator stakingtypes.Validator, err error) {
 	// Check if we will reach the max entries
-	if k.LDRedelegationWillReachMaxEntries(ctx, delAddr, valSrcAddr, valDstAddr) {
+	// Locks on exited validators can be redelegated freely
+	if !k.HasFreeRedelegationValidator(ctx, valSrcAddr) &&
+		k.LDRedelegationWillReachMaxEntries(ctx, delAddr, valSrcAddr, valDstAddr) {
 		return types.LockedDelegation{}, stakingtypes.Validator{}, stakingtypes.Validator{}, types.ErrLDRedelegationMaxEntriesReached
 	}
 
@@ -310,17 +422,397 @@ func (k Keeper) ToggleLockedDelegationEntryAutoRenew(
 		return entry, types.ErrLockedDelegationEntryNotFound
 	}
 
-	// Save the locked delegation
-	// We don't need to update the queue, since the unlock on has not changed
+	// Save the locked delegation, this replaces the original entry by the new ones in the queue
 	// And since the ID haven't changed, we don't need to update the look up
 	err = k.SetLockedDelegation(ctx, lockedDelegation)
 	if err != nil {
 		return entry, err
 	}
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAutoRenewChanged{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		EntryId:          entry.Id,
+		AutoRenew:        entry.AutoRenew,
+	})
+	if err != nil {
+		return entry, err
+	}
+
+	return entry, nil
+}
+
+// SetLockedDelegationEntryExpiryAction sets the expiry action of a locked delegation entry based on the entry Id
+func (k Keeper) SetLockedDelegationEntryExpiryAction(
+	ctx sdk.Context,
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	entryID uint64,
+	expiryAction types.ExpiryAction,
+	redelegateTo string,
+) (entry types.LockedDelegationEntry, err error) {
+	// Find the locked delegation
+	lockedDelegation, found := k.GetLockedDelegation(
+		ctx, delAddr, valAddr,
+	)
+	if !found {
+		return entry, types.ErrLockedDelegationNotFound
+	}
+
+	if err := k.validateExpiryAction(ctx, valAddr, expiryAction, redelegateTo); err != nil {
+		return entry, err
+	}
+
+	// Update the entry expiry action
+	entry, found = lockedDelegation.SetExpiryActionForID(entryID, expiryAction, redelegateTo)
+	if !found {
+		return entry, types.ErrLockedDelegationEntryNotFound
+	}
+
+	// Save the locked delegation, the ID and unlock time haven't changed so the queue and look up are kept
+	err = k.SetLockedDelegation(ctx, lockedDelegation)
+	if err != nil {
+		return entry, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventExpiryActionChanged{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		EntryId:          entry.Id,
+		ExpiryAction:     entry.ExpiryAction,
+		RedelegateTo:     entry.RedelegateTo,
+	})
+	if err != nil {
+		return entry, err
+	}
+
+	return entry, nil
+}
+
+// validateExpiryAction validates an entry expiry action against the state
+// The redelegation validator must exist and differ from the entry validator
+func (k Keeper) validateExpiryAction(
+	ctx sdk.Context,
+	valAddr sdk.ValAddress,
+	expiryAction types.ExpiryAction,
+	redelegateTo string,
+) error {
+	if err := types.ValidateExpiryAction(expiryAction, redelegateTo); err != nil {
+		return err
+	}
+	if expiryAction != types.ExpiryActionRedelegate {
+		return nil
+	}
+
+	dstValAddr, err := sdk.ValAddressFromBech32(redelegateTo)
+	if err != nil {
+		return err
+	}
+	if dstValAddr.Equals(valAddr) {
+		return types.ErrInvalidExpiryAction.Wrap("cannot redelegate to the entry validator")
+	}
+	if _, found := k.stakingKeeper.GetValidator(ctx, dstValAddr); !found {
+		return sdkerrors.Wrap(types.ErrNoValidatorExists, redelegateTo)
+	}
+	return nil
+}
+
+// SplitLockedDelegationEntry splits a locked delegation entry in two new entries with new IDs
+// The rate, unlock time and auto renew are kept, so the rewards aren't affected
+func (k Keeper) SplitLockedDelegationEntry(
+	ctx sdk.Context,
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	entryID uint64,
+	shares math.LegacyDec,
+) (split, remainder types.LockedDelegationEntry, err error) {
+	// Find the locked delegation and the entry
+	lockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return split, remainder, types.ErrLockedDelegationNotFound
+	}
+	exists, entries := lockedDelegation.EntriesForIds([]uint64{entryID})
+	if !exists {
+		return split, remainder, types.ErrLockedDelegationEntryNotFound
+	}
+	if !shares.IsPositive() || shares.GTE(entries[0].Shares) {
+		return split, remainder, types.ErrInvalidSplitShares
+	}
+
+	// The split adds a new entry, so it must respect the max entries
+	if k.HasMaxLockedDelegationEntries(ctx, delAddr, valAddr) {
+		return split, remainder, types.ErrMaxLockedDelegationEntriesReached
+	}
+
+	// Split the entry using new IDs
+	split, remainder, err = lockedDelegation.SplitEntry(
+		entryID,
+		shares,
+		k.IncrementLockedDelegationEntryID(ctx),
+		k.IncrementLockedDelegationEntryID(ctx),
+	)
+	if err != nil {
+		return split, remainder, err
+	}
+
+	// Save the locked delegation, this replaces the original entry by the new ones in the queue
+	if err := k.checkpointLockedDelegationRewards(ctx, delAddr, valAddr); err != nil {
+		return split, remainder, err
+	}
+	err = k.SetLockedDelegation(ctx, lockedDelegation)
+	if err != nil {
+		return split, remainder, err
+	}
+
+	// Update the look up with the new IDs
+	k.DeleteLockedDelegationIndex(ctx, entryID)
+	err = k.SetLockedDelegationByEntryID(ctx, lockedDelegation, split.Id)
+	if err != nil {
+		return split, remainder, err
+	}
+	err = k.SetLockedDelegationByEntryID(ctx, lockedDelegation, remainder.Id)
+	if err != nil {
+		return split, remainder, err
+	}
+
+	// The escrowed rewards are divided between the new entries
+	err = k.splitEntryEscrow(ctx, entryID, split, remainder)
+	if err != nil {
+		return split, remainder, err
+	}
+
+	// The original entry is replaced by the new ones
+	for _, entry := range []types.LockedDelegationEntry{split, remainder} {
+		err = ctx.EventManager().EmitTypedEvent(&types.EventLockCreated{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+			Entry:            entry,
+		})
+		if err != nil {
+			return split, remainder, err
+		}
+	}
+
+	return split, remainder, nil
+}
+
+// ExtendLockedDelegationEntry moves a locked delegation entry to a longer duration rate
+// The rewards are withdrawn with the old rate before the entry is updated
+func (k Keeper) ExtendLockedDelegationEntry(
+	ctx sdk.Context,
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	entryID uint64,
+	lockDuration time.Duration,
+) (types.LockedDelegationEntry, error) {
+	// Check if the selected rate exists
+	params := k.GetParams(ctx)
+	rate, found := params.GetRateFromDuration(lockDuration)
+	if !found {
+		return types.LockedDelegationEntry{}, types.ErrCreateLockedDelegationDurationUnmatch
+	}
+
+	// Find the locked delegation and the entry
+	lockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.LockedDelegationEntry{}, types.ErrLockedDelegationNotFound
+	}
+	exists, entries := lockedDelegation.EntriesForIds([]uint64{entryID})
+	if !exists {
+		return types.LockedDelegationEntry{}, types.ErrLockedDelegationEntryNotFound
+	}
+	if rate.Duration <= entries[0].Rate.Duration {
+		return types.LockedDelegationEntry{}, types.ErrExtendLockDurationNotLonger
+	}
+
+	// Do a rewards withdraw before the entry weight changes
+	_, err := k.distributionKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return types.LockedDelegationEntry{}, err
+	}
+
+	// Update the entry, this also moves it in the queue
+	// The ID is kept, so we don't need to update the look up
+	previous, entry, _ := lockedDelegation.ExtendEntryForID(entryID, rate, ctx.BlockTime())
+	if err := k.checkpointLockedDelegationRewards(ctx, delAddr, valAddr); err != nil {
+		return types.LockedDelegationEntry{}, err
+	}
+	err = k.SetLockedDelegation(ctx, lockedDelegation)
+	if err != nil {
+		return types.LockedDelegationEntry{}, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockRenewed{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Previous:         previous,
+		Entry:            entry,
+	})
+	if err != nil {
+		return types.LockedDelegationEntry{}, err
+	}
+
 	return entry, nil
 }
 
+// EarlyUnlockLockedDelegationEntries removes locked delegation entries before their unlock time
+// The entries shares are undelegated and a penalty, set per rate on the params, is taken from them
+// It returns the undelegation completion time and the penalty coin
+func (k Keeper) EarlyUnlockLockedDelegationEntries(
+	ctx sdk.Context,
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	ids []uint64,
+) (completionTime time.Time, penalty sdk.Coin, err error) {
+	penalty = sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), math.ZeroInt())
+
+	// Find the locked delegation and the requested entries
+	lockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return completionTime, penalty, types.ErrLockedDelegationNotFound
+	}
+	exists, entries := lockedDelegation.EntriesForIds(ids)
+	if !exists {
+		return completionTime, penalty, types.ErrLockedDelegationEntryNotFound
+	}
+
+	// Calculate the total shares to be unlocked and the penalty shares
+	// The penalty is taken from the current params rate, falling back to the entry rate
+	params := k.GetParams(ctx)
+	totalShares := math.LegacyZeroDec()
+	penaltyShares := math.LegacyZeroDec()
+	for _, entry := range entries {
+		rate, found := params.GetRateFromDuration(entry.Rate.Duration)
+		if !found {
+			rate = entry.Rate
+		}
+		totalShares = totalShares.Add(entry.Shares)
+		penaltyShares = penaltyShares.Add(entry.Shares.Mul(rate.GetEarlyUnlockPenalty()))
+	}
+
+	// Collect the rewards using the current locked delegation in store
+	_, err = k.distributionKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return completionTime, penalty, err
+	}
+
+	// Check if we can undelegate the unlocked shares
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return completionTime, penalty, types.ErrNoDelegationExists
+	}
+	if delegation.GetShares().LT(totalShares) {
+		return completionTime, penalty, types.ErrLockedSharesSmallerThanDelegation
+	}
+
+	// The locking rewards earned with the stored entries are accrued first
+	// this holds the share of the removed entries in escrow before it's forfeited
+	if err := k.checkpointLockedDelegationRewards(ctx, delAddr, valAddr); err != nil {
+		return completionTime, penalty, err
+	}
+
+	// Remove the entries, the same way it's done on expiration
+	// They leave the queue when the locked delegation is updated
+	lockedDelegation.RemoveEntries(entries)
+	for _, entry := range entries {
+		// Remove the ID from look up
+		k.DeleteLockedDelegationIndex(ctx, entry.Id)
+
+		// The entries didn't reach their unlock time, so their escrowed rewards are forfeited
+		err = k.forfeitEntryEscrow(ctx, lockedDelegation, entry.Id)
+		if err != nil {
+			return completionTime, penalty, err
+		}
+	}
+
+	// Update or delete the locked delegation depending on its entries
+	if len(lockedDelegation.Entries) == 0 {
+		err = k.DeleteLockedDelegation(ctx, lockedDelegation)
+	} else {
+		err = k.SetLockedDelegation(ctx, lockedDelegation)
+	}
+	if err != nil {
+		return completionTime, penalty, err
+	}
+
+	// Take the penalty from the delegation
+	if penaltyShares.IsPositive() {
+		penalty.Amount, err = k.takeEarlyUnlockPenalty(ctx, delAddr, valAddr, penaltyShares)
+		if err != nil {
+			return completionTime, penalty, err
+		}
+	}
+
+	// Undelegate the remaining shares
+	// We want to undelegate as the last action to avoid conflicts with the hooks
+	remainingShares := totalShares.Sub(penaltyShares)
+	if remainingShares.IsPositive() {
+		completionTime, err = k.stakingKeeper.Undelegate(ctx, delAddr, valAddr, remainingShares)
+		if err != nil {
+			return completionTime, penalty, err
+		}
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockUnlocked{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Entries:          entries,
+		Penalty:          penalty,
+		Reason:           types.UnlockReasonEarlyUnlock,
+	})
+	if err != nil {
+		return completionTime, penalty, err
+	}
+
+	return completionTime, penalty, nil
+}
+
+// takeEarlyUnlockPenalty unbonds the penalty shares and burns or sends them to the community pool
+// depending on the params penalty destination
+func (k Keeper) takeEarlyUnlockPenalty(
+	ctx sdk.Context,
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	penaltyShares math.LegacyDec,
+) (math.Int, error) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return math.ZeroInt(), stakingtypes.ErrNoValidatorFound
+	}
+
+	// Unbonded tokens stay in the pool the validator is using
+	pool := stakingtypes.NotBondedPoolName
+	if validator.IsBonded() {
+		pool = stakingtypes.BondedPoolName
+	}
+
+	amount, err := k.stakingKeeper.Unbond(ctx, delAddr, valAddr, penaltyShares)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	if amount.IsZero() {
+		return amount, nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), amount))
+
+	switch k.GetParams(ctx).PenaltyDestination {
+	case types.PenaltyDestinationCommunityPool:
+		// Move the coins through the module account to fund the community pool
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, pool, types.ModuleName, coins)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+		err = k.distributionKeeper.FundCommunityPool(ctx, coins, authtypes.NewModuleAddress(types.ModuleName))
+	default:
+		err = k.bankKeeper.BurnCoins(ctx, pool, coins)
+	}
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return amount, nil
+}
+
 // HasMaxUnbondingDelegationEntries check if unbonding delegation has maximum number of entries.
 func (k Keeper) HasMaxLockedDelegationEntries(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
 	lockedDelegation, found := k.GetLockedDelegation(ctx, delAddr, valAddr)
@@ -373,29 +865,36 @@ func (k Keeper) LockedDelegationTotalTokens(ctx sdk.Context, delAddr sdk.AccAddr
 func (k Keeper) GetDelegatorLockedShares(ctx sdk.Context, delegator sdk.AccAddress) math.LegacyDec {
 	lockedShares := math.LegacyZeroDec()
 	k.IterateDelegatorLockedDelegations(ctx, delegator, func(lockedDelegation types.LockedDelegation) bool {
-		lockedShares = lockedDelegation.TotalShares()
+		lockedShares = lockedShares.Add(lockedDelegation.TotalShares())
 		return false
 	})
 	return lockedShares
 }
 
-// DequeueExpiredLockedDelegations dequeue and unlock delegations
-func (k Keeper) DequeueExpiredLockedDelegations(ctx sdk.Context, currTime time.Time) (expiredPairs []types.LockedDelegationPair) {
+// DequeueExpiredLockedDelegations dequeues the expired locked delegation entries and returns their pairs
+// Entries are dequeued until the unique pairs reach the limit, a zero limit dequeues all the expired entries
+// The expired entries of a returned pair still on the queue leave it once the pair is completed
+func (k Keeper) DequeueExpiredLockedDelegations(ctx sdk.Context, currTime time.Time, limit uint32) (expiredPairs []types.LockedDelegationPair) {
 	store := ctx.KVStore(k.storeKey)
 
-	// gets an iterator for all timeslices from time 0 until the current time
+	// gets an iterator for all the entries from time 0 until the current time
 	iterator := k.LockedDelegationQueueIterator(ctx, currTime)
 	defer iterator.Close()
 
 	// Get all expired pairs and dequeue
+	seenPairs := make(map[types.LockedDelegationPair]bool)
 	for ; iterator.Valid(); iterator.Next() {
-		// Unmarshal the values
-		pairsAtTime := types.LockedDelegationPairs{}
-		value := iterator.Value()
-		k.cdc.MustUnmarshal(value, &pairsAtTime)
+		var pair types.LockedDelegationPair
+		k.cdc.MustUnmarshal(iterator.Value(), &pair)
 
-		// Check uniques for a single iteration
-		expiredPairs = append(expiredPairs, pairsAtTime.UniquePairs()...)
+		// Stop once the limit is reached, the next pairs stay on the queue
+		if !seenPairs[pair] {
+			if limit > 0 && len(expiredPairs) >= int(limit) {
+				break
+			}
+			seenPairs[pair] = true
+			expiredPairs = append(expiredPairs, pair)
+		}
 
 		// Delete this old key
 		store.Delete(iterator.Key())
@@ -406,6 +905,7 @@ func (k Keeper) DequeueExpiredLockedDelegations(ctx sdk.Context, currTime time.T
 
 // CompleteLockedDelegations processes locked delegations and unlocks or renews them
 // based on their configuration when their time has expired
+// The unlocked entries shares are undelegated, kept delegated or redelegated depending on their expiry action
 func (k Keeper) CompleteLockedDelegations(ctx sdk.Context, pair types.LockedDelegationPair) error {
 	delAddr := sdk.MustAccAddressFromBech32(pair.DelegatorAddress)
 	valAddr, err := sdk.ValAddressFromBech32(pair.ValidatorAddress)
@@ -419,25 +919,53 @@ func (k Keeper) CompleteLockedDelegations(ctx sdk.Context, pair types.LockedDele
 		return nil
 	}
 
+	// The locking rewards earned with the stored entries are accrued first
+	// The expired entries share isn't held in escrow, so a renewed entry doesn't hold its previous term rewards
+	if err := k.checkpointLockedDelegationRewards(ctx, delAddr, valAddr); err != nil {
+		return err
+	}
+
 	// Process the entries
 	// Remove expired entries and renew the ones needed
-	totalUndelegate, err := k.processEntries(ctx, &lockedDelegation)
+	unlocked, err := k.processEntries(ctx, &lockedDelegation)
 	if err != nil {
 		return err
 	}
 
+	// Split the unlocked shares by expiry action
+	// The redelegations are grouped by destination, keeping the entries order
+	totalUndelegate := math.LegacyZeroDec()
+	totalRedelegate := math.LegacyZeroDec()
+	redelegations := make(map[string]math.LegacyDec)
+	var redelegateTo []string
+	for _, entry := range unlocked {
+		switch entry.ExpiryAction {
+		case types.ExpiryActionStayDelegated:
+			// The shares stay delegated without the lock
+		case types.ExpiryActionRedelegate:
+			if _, found := redelegations[entry.RedelegateTo]; !found {
+				redelegations[entry.RedelegateTo] = math.LegacyZeroDec()
+				redelegateTo = append(redelegateTo, entry.RedelegateTo)
+			}
+			redelegations[entry.RedelegateTo] = redelegations[entry.RedelegateTo].Add(entry.Shares)
+			totalRedelegate = totalRedelegate.Add(entry.Shares)
+		default:
+			totalUndelegate = totalUndelegate.Add(entry.Shares)
+		}
+	}
+
 	// Before updating the delegation, we must collect the rewards using the current locked delegation in store
-	// This avoids losing the total locked delegation reward when undelegating
-	// We also only need to apply if the total to undelegation isn't zero
-	if !totalUndelegate.IsZero() {
+	// This avoids losing the total locked delegation reward when the locked shares change
+	// We also only need to apply if any entry was unlocked
+	if len(unlocked) > 0 {
 		_, err := k.distributionKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
 		if err != nil {
 			return err
 		}
 
-		// Before updating the locked delegation, let's check if we can undelegate
+		// Before updating the locked delegation, let's check if we can move the unlocked shares
 		delegation := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
-		if delegation.GetShares().LT(totalUndelegate) {
+		if delegation.GetShares().LT(totalUndelegate.Add(totalRedelegate)) {
 			return types.ErrLockedSharesSmallerThanDelegation
 		}
 	}
@@ -457,22 +985,60 @@ func (k Keeper) CompleteLockedDelegations(ctx sdk.Context, pair types.LockedDele
 	}
 
 	// Undelegate the expected amount
-	// We want to undelegate as the last action to avoid conflicts with the hooks
+	// We want to undelegate and redelegate as the last actions to avoid conflicts with the hooks
 	if !totalUndelegate.IsZero() {
 		_, err := k.stakingKeeper.Undelegate(ctx, delAddr, valAddr, totalUndelegate)
 		if err != nil {
 			return err
 		}
 	}
+	// A failed redelegation keeps the shares delegated, so a single unavailable validator doesn't hold the whole pair
+	for _, dst := range redelegateTo {
+		err := k.redelegateUnlockedShares(ctx, delAddr, valAddr, dst, redelegations[dst])
+		if err != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeExpiryRedelegationFailed,
+					sdk.NewAttribute(types.AttributeKeyDelegator, pair.DelegatorAddress),
+					sdk.NewAttribute(types.AttributeKeyValidator, pair.ValidatorAddress),
+					sdk.NewAttribute(types.AttributeKeyRedelegateTo, dst),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, redelegations[dst].String()),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+		}
+	}
+
+	return nil
+}
+
+// redelegateUnlockedShares redelegates the shares of expired entries to the entries chosen validator
+// The redelegation is done on a cached context, so nothing is changed if it fails
+func (k Keeper) redelegateUnlockedShares(
+	ctx sdk.Context,
+	delAddr sdk.AccAddress,
+	valSrcAddr sdk.ValAddress,
+	redelegateTo string,
+	shares math.LegacyDec,
+) error {
+	valDstAddr, err := sdk.ValAddressFromBech32(redelegateTo)
+	if err != nil {
+		return err
+	}
 
+	cacheCtx, write := ctx.CacheContext()
+	_, err = k.stakingKeeper.BeginRedelegation(cacheCtx, delAddr, valSrcAddr, valDstAddr, shares)
+	if err != nil {
+		return err
+	}
+	write()
 	return nil
 }
 
 // processEntries processes locked delegation entries by renewing or removing them
-// It returns the total amount to be undelegated
-func (k Keeper) processEntries(ctx sdk.Context, ld *types.LockedDelegation) (math.LegacyDec, error) {
+// It returns the expired entries that were removed without being renewed
+func (k Keeper) processEntries(ctx sdk.Context, ld *types.LockedDelegation) (unlocked []types.LockedDelegationEntry, err error) {
 	currTime := ctx.BlockTime()
-	totalUndelegate := math.LegacyZeroDec()
 
 	// Iterate over the entries
 	// here we must use indexing due to the list removal or addition
@@ -491,29 +1057,86 @@ func (k Keeper) processEntries(ctx sdk.Context, ld *types.LockedDelegation) (mat
 		// Remove the ID from look up
 		k.DeleteLockedDelegationIndex(ctx, entry.Id)
 
+		// The entry reached its unlock time, so its escrowed rewards are paid
+		if err := k.releaseEntryEscrow(ctx, *ld, entry.Id); err != nil {
+			return nil, err
+		}
+
 		// Check if we should renew
 		if entry.AutoRenew {
 			// Handle auto-renew process
 			err := k.handleAutoRenew(ctx, ld, entry)
 			if err != nil {
-				return math.LegacyZeroDec(), err
+				return nil, err
 			}
 		} else {
-			// Add the undelegate total if we don't auto renew
-			totalUndelegate = totalUndelegate.Add(entry.Shares)
+			// The entry is unlocked if we don't auto renew, its shares follow the expiry action
+			unlocked = append(unlocked, entry)
+
+			err := ctx.EventManager().EmitTypedEvent(&types.EventLockExpired{
+				DelegatorAddress: ld.DelegatorAddress,
+				ValidatorAddress: ld.ValidatorAddress,
+				Entry:            entry,
+			})
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	return totalUndelegate, nil
+	return unlocked, nil
 }
 
 // handleAutoRenew handles the auto-renewal process for a given entry
 func (k Keeper) handleAutoRenew(ctx sdk.Context, ld *types.LockedDelegation, entry types.LockedDelegationEntry) error {
+	previous := entry
 	entry.UnlockOn = entry.UnlockOn.Add(entry.Rate.Duration)
-	// Add the entry
-	ld.AddEntry(entry)
-	// Add to the queue
-	k.InsertLockedDelegationQueue(ctx, *ld, entry.UnlockOn)
+	// Add the entry, it's queued again when the locked delegation is updated
+	// It keeps its ID, so it's not merged with an entry with the same values
+	ld.AppendEntry(entry)
 	// Add to look up
-	return k.SetLockedDelegationByEntryID(ctx, *ld, entry.Id)
+	if err := k.SetLockedDelegationByEntryID(ctx, *ld, entry.Id); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventLockRenewed{
+		DelegatorAddress: ld.DelegatorAddress,
+		ValidatorAddress: ld.ValidatorAddress,
+		Previous:         previous,
+		Entry:            entry,
+	})
+}
+
+// removeLockedDelegation deletes a locked delegation with all its entries from the look up and the queue
+// The delegation itself is kept
+// The entries are released without the delegator unlocking them, so their escrowed rewards are paid
+// and no penalty is taken
+func (k Keeper) removeLockedDelegation(ctx sdk.Context, lockedDelegation types.LockedDelegation, reason types.UnlockReason) error {
+	// Accrue the locking rewards earned with the entries before releasing their escrow and removing them
+	valAddr, err := lockedDelegation.GetValidatorAddr()
+	if err != nil {
+		return err
+	}
+	if err := k.checkpointLockedDelegationRewards(ctx, lockedDelegation.GetDelegatorAddr(), valAddr); err != nil {
+		return err
+	}
+
+	for _, entry := range lockedDelegation.Entries {
+		k.DeleteLockedDelegationIndex(ctx, entry.Id)
+		if err := k.releaseEntryEscrow(ctx, lockedDelegation, entry.Id); err != nil {
+			return err
+		}
+	}
+
+	if err := k.DeleteLockedDelegation(ctx, lockedDelegation); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventLockUnlocked{
+		DelegatorAddress: lockedDelegation.DelegatorAddress,
+		ValidatorAddress: lockedDelegation.ValidatorAddress,
+		Entries:          lockedDelegation.Entries,
+		Penalty:          sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), math.ZeroInt()),
+		Reason:           reason,
+	})
 }
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/aetherevm/locking/locking/types"
 )
@@ -121,6 +122,8 @@ func (suite *KeeperTestSuite) TestCreateLockedDelegationEntry() {
 				params.Amount,
 				params.Rate,
 				params.AutoRenew,
+				types.ExpiryActionUndelegate,
+				"",
 			)
 
 			if tc.expError {
@@ -148,6 +151,32 @@ func (suite *KeeperTestSuite) TestCreateLockedDelegationEntry() {
 	}
 }
 
+// TestCreateLockedDelegationEntryMerged tests two identical entries merged into the stored one
+func (suite *KeeperTestSuite) TestCreateLockedDelegationEntryMerged() {
+	delAddr := sdk.AccAddress([]byte("address1"))
+	valAddr := sdk.ValAddress([]byte("val1"))
+	rate := types.DefaultRates[0]
+
+	first, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(10), rate, false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+
+	// The second entry is merged, so the stored entry with the added shares is returned and emitted
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	second, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(10), rate, false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+	suite.Require().Equal(first.Id, second.Id)
+	suite.Require().Equal(first.Shares.MulInt64(2), second.Shares)
+	suite.Require().Equal([]proto.Message{
+		&types.EventLockCreated{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Entry: second},
+	}, typedEvents(suite, &types.EventLockCreated{}))
+
+	lockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.LockedDelegationEntry{second}, lockedDelegation.Entries)
+	_, found = suite.k.GetLockedDelegationByEntryID(suite.ctx, second.Id)
+	suite.Require().True(found)
+}
+
 // LockedDelegationRedelegationParams is a struct use for testing the LockedDelegationRedelegation
 type LockedDelegationRedelegationParams struct {
 	DelAddr    sdk.AccAddress
@@ -475,6 +504,140 @@ func (suite *KeeperTestSuite) TestToggleLockedDelegationEntryAutoRenew() {
 	}
 }
 
+// TestSetLockedDelegationEntryExpiryAction tests SetLockedDelegationEntryExpiryAction
+func (suite *KeeperTestSuite) TestSetLockedDelegationEntryExpiryAction() {
+	delAddresses, valAddresses, _ := setupEndblockTest(suite)
+	delAddr := delAddresses[0]
+	valAddr := valAddresses[0]
+	dstValAddr := valAddresses[1]
+
+	entry, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(1000), rate, false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		valAddr      sdk.ValAddress
+		entryID      uint64
+		expiryAction types.ExpiryAction
+		redelegateTo string
+		expErr       error
+	}{
+		{"fail - no locked delegation found", dstValAddr, entry.Id, types.ExpiryActionStayDelegated, "", types.ErrLockedDelegationNotFound},
+		{"fail - entry not found", valAddr, entry.Id + 1, types.ExpiryActionStayDelegated, "", types.ErrLockedDelegationEntryNotFound},
+		{"fail - redelegate without validator", valAddr, entry.Id, types.ExpiryActionRedelegate, "", types.ErrInvalidExpiryAction},
+		{"fail - redelegate to the entry validator", valAddr, entry.Id, types.ExpiryActionRedelegate, valAddr.String(), types.ErrInvalidExpiryAction},
+		{"fail - redelegate to an unknown validator", valAddr, entry.Id, types.ExpiryActionRedelegate, sdk.ValAddress([]byte("unknown")).String(), types.ErrNoValidatorExists},
+		{"success - stay delegated", valAddr, entry.Id, types.ExpiryActionStayDelegated, "", nil},
+		{"success - redelegate", valAddr, entry.Id, types.ExpiryActionRedelegate, dstValAddr.String(), nil},
+		{"success - undelegate", valAddr, entry.Id, types.ExpiryActionUndelegate, "", nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			updated, err := suite.k.SetLockedDelegationEntryExpiryAction(
+				suite.ctx, delAddr, tc.valAddr, tc.entryID, tc.expiryAction, tc.redelegateTo,
+			)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expiryAction, updated.ExpiryAction)
+			suite.Require().Equal(tc.redelegateTo, updated.RedelegateTo)
+
+			// The stored entry is updated, keeping its unlock time
+			lockedDelegation, found := suite.k.GetLockedDelegationByEntryID(suite.ctx, entry.Id)
+			suite.Require().True(found)
+			suite.Require().Equal([]types.LockedDelegationEntry{updated}, lockedDelegation.Entries)
+			suite.Require().True(entry.UnlockOn.Equal(updated.UnlockOn))
+		})
+	}
+
+	// Entries redelegated by hand to their expiry validator stay delegated there
+	_, err = suite.k.SetLockedDelegationEntryExpiryAction(suite.ctx, delAddr, valAddr, entry.Id, types.ExpiryActionRedelegate, dstValAddr.String())
+	suite.Require().NoError(err)
+	_, _, err = suite.k.LockedDelegationRedelegation(suite.ctx, delAddr, valAddr, dstValAddr, []uint64{entry.Id})
+	suite.Require().NoError(err)
+	lockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, dstValAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(types.ExpiryActionStayDelegated, lockedDelegation.Entries[0].ExpiryAction)
+	suite.Require().Empty(lockedDelegation.Entries[0].RedelegateTo)
+}
+
+// TestCompleteLockedDelegationsExpiryActions tests the expired entries shares follow their expiry action
+func (suite *KeeperTestSuite) TestCompleteLockedDelegationsExpiryActions() {
+	delAddresses, valAddresses, _ := setupEndblockTest(suite)
+	delAddr := delAddresses[0]
+	valAddr := valAddresses[0]
+	dstValAddr := valAddresses[1]
+	pair := types.LockedDelegationPair{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String()}
+
+	// Lock part of the existing delegation with each expiry action
+	undelegateEntry, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(1000), rate, false, types.ExpiryActionUndelegate, "")
+	suite.Require().NoError(err)
+	stayEntry, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(2000), rate, false, types.ExpiryActionStayDelegated, "")
+	suite.Require().NoError(err)
+	redelegateEntry, err := suite.k.CreateLockedDelegationEntry(suite.ctx, delAddr, valAddr, math.NewInt(3000), rate, false, types.ExpiryActionRedelegate, dstValAddr.String())
+	suite.Require().NoError(err)
+	lockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Len(lockedDelegation.Entries, 3)
+
+	delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	dstDelegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, dstValAddr)
+	suite.Require().True(found)
+
+	suite.ctx = suite.ctx.WithBlockTime(undelegateEntry.UnlockOn)
+	suite.Require().NoError(suite.k.CompleteLockedDelegations(suite.ctx, pair))
+
+	// The lock is gone and only the stay delegated shares are kept on the validator
+	_, found = suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().False(found)
+	newDelegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(delegation.Shares.Sub(undelegateEntry.Shares).Sub(redelegateEntry.Shares), newDelegation.Shares)
+	suite.Require().True(newDelegation.Shares.GT(stayEntry.Shares))
+
+	// The undelegate shares are unbonding
+	_, found = suite.app.StakingKeeper.GetUnbondingDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+
+	// The redelegate shares moved to the chosen validator
+	_, found = suite.app.StakingKeeper.GetRedelegation(suite.ctx, delAddr, valAddr, dstValAddr)
+	suite.Require().True(found)
+	newDstDelegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, dstValAddr)
+	suite.Require().True(found)
+	suite.Require().True(newDstDelegation.Shares.GT(dstDelegation.Shares))
+
+	// A redelegation that can't be done keeps the shares delegated
+	// The validator received a redelegation, so redelegating from it is transitive
+	srcDelAddr := delAddresses[1]
+	_, err = suite.app.StakingKeeper.BeginRedelegation(suite.ctx, srcDelAddr, dstValAddr, valAddr, math.LegacyNewDec(1000))
+	suite.Require().NoError(err)
+	failedEntry, err := suite.k.CreateLockedDelegationEntry(suite.ctx, srcDelAddr, valAddr, math.NewInt(1000), rate, false, types.ExpiryActionRedelegate, dstValAddr.String())
+	suite.Require().NoError(err)
+	delegation, found = suite.app.StakingKeeper.GetDelegation(suite.ctx, srcDelAddr, valAddr)
+	suite.Require().True(found)
+
+	suite.ctx = suite.ctx.WithBlockTime(failedEntry.UnlockOn).WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.k.CompleteLockedDelegations(suite.ctx, types.LockedDelegationPair{
+		DelegatorAddress: srcDelAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}))
+	_, found = suite.k.GetLockedDelegation(suite.ctx, srcDelAddr, valAddr)
+	suite.Require().False(found)
+	newDelegation, found = suite.app.StakingKeeper.GetDelegation(suite.ctx, srcDelAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(delegation.Shares, newDelegation.Shares)
+
+	var failed bool
+	for _, event := range suite.ctx.EventManager().Events() {
+		failed = failed || event.Type == types.EventTypeExpiryRedelegationFailed
+	}
+	suite.Require().True(failed)
+}
+
 // TestHasMaxLockedDelegationEntries tests HasMaxLockedDelegationEntries
 func (suite *KeeperTestSuite) TestHasMaxLockedDelegationEntries() {
 	delAddr := sdk.AccAddress([]byte("address1"))
@@ -522,6 +685,8 @@ func (suite *KeeperTestSuite) TestHasMaxLockedDelegationEntries() {
 					math.OneInt(),
 					rate,
 					false,
+					types.ExpiryActionUndelegate,
+					"",
 				)
 				suite.Require().NoError(err)
 			},
@@ -813,10 +978,10 @@ func (suite *KeeperTestSuite) TestGetDelegatorLockedShares() {
 			math.LegacyZeroDec(),
 		},
 		{
-			"locked delegation found",
+			"locked delegations found - sums all the validators",
 			func() {},
 			delAddresses[0],
-			math.LegacyNewDec(1 + 2 + 3),
+			math.LegacyNewDec(3 * (1 + 2 + 3)),
 		},
 	}
 
@@ -846,7 +1011,7 @@ func (suite *KeeperTestSuite) TestDequeueExpiredLockedDelegations() {
 
 	// Do the dequeuing and get the expired pairs
 	// This is the function we want to test
-	expiredPairs := suite.k.DequeueExpiredLockedDelegations(suite.ctx, now)
+	expiredPairs := suite.k.DequeueExpiredLockedDelegations(suite.ctx, now, 0)
 	suite.Require().NotEmpty(expiredPairs)
 
 	// Check if they are really out of the queue
@@ -854,24 +1019,31 @@ func (suite *KeeperTestSuite) TestDequeueExpiredLockedDelegations() {
 	currPairs := suite.k.GetAllLockedDelegationQueuePairs(suite.ctx, now)
 	suite.Require().Empty(currPairs)
 
-	// The remaining items on the queue should be the total - len of expiredPairs
-	afterDequeueAllPairs := suite.k.GetAllLockedDelegationQueuePairs(suite.ctx, bigTime)
-	suite.Require().Equal(len(afterDequeueAllPairs), len(allPairs)-len(expiredPairs))
-
 	// Check if the expiredPairs are really what we mean them to be
+	// The remaining items on the queue should be the entries not expired
 	lds := suite.k.GetAllLockedDelegations(suite.ctx)
 	var expectedPairs []types.LockedDelegationPair
+	remaining := 0
 	for _, ld := range lds {
+		expired := false
 		for _, entry := range ld.Entries {
-			if entry.Expired(now) {
-				expectedPairs = append(expectedPairs, types.LockedDelegationPair{
-					DelegatorAddress: ld.DelegatorAddress,
-					ValidatorAddress: ld.ValidatorAddress,
-				})
+			if !entry.Expired(now) {
+				remaining++
+			} else {
+				expired = true
 			}
 		}
+		if expired {
+			expectedPairs = append(expectedPairs, types.LockedDelegationPair{
+				DelegatorAddress: ld.DelegatorAddress,
+				ValidatorAddress: ld.ValidatorAddress,
+			})
+		}
 	}
-	suite.Require().ElementsMatch(expectedPairs, expectedPairs)
+	suite.Require().ElementsMatch(expectedPairs, expiredPairs)
+
+	afterDequeueAllPairs := suite.k.GetAllLockedDelegationQueuePairs(suite.ctx, bigTime)
+	suite.Require().Len(afterDequeueAllPairs, remaining)
 }
 
 // TestDequeueExpiredLockedDelegationsEmpty tests a empty path on the DequeueExpiredLockedDelegations function
@@ -879,7 +1051,7 @@ func (suite *KeeperTestSuite) TestDequeueExpiredLockedDelegationsEmpty() {
 	now := time.Now()
 
 	// Call in a system with empty expired
-	expiredPairs := suite.k.DequeueExpiredLockedDelegations(suite.ctx, now)
+	expiredPairs := suite.k.DequeueExpiredLockedDelegations(suite.ctx, now, 0)
 	suite.Require().Empty(expiredPairs)
 }
 
@@ -896,12 +1068,12 @@ func (suite *KeeperTestSuite) TestDequeueExpiredLockedDelegationsMultiplePairs()
 		valAddr,
 		nil,
 	)
-	suite.k.InsertLockedDelegationQueue(suite.ctx, ld, now)
-	suite.k.InsertLockedDelegationQueue(suite.ctx, ld, now)
-	suite.k.InsertLockedDelegationQueue(suite.ctx, ld, now)
+	suite.k.InsertLockedDelegationQueue(suite.ctx, ld, queueEntry(1, now))
+	suite.k.InsertLockedDelegationQueue(suite.ctx, ld, queueEntry(2, now))
+	suite.k.InsertLockedDelegationQueue(suite.ctx, ld, queueEntry(3, now.Add(-time.Hour)))
 
 	// We will have a single pair
-	expiredPairs := suite.k.DequeueExpiredLockedDelegations(suite.ctx, now)
+	expiredPairs := suite.k.DequeueExpiredLockedDelegations(suite.ctx, now, 0)
 	suite.Require().NotEmpty(expiredPairs)
 	suite.Require().ElementsMatch(expiredPairs, []types.LockedDelegationPair{
 		{
@@ -1331,9 +1503,8 @@ func createLDWithEntries(delAddr sdk.AccAddress, valAddr sdk.ValAddress, nEntrie
 			suite.k.IncrementLockedDelegationEntryID(suite.ctx),
 		)
 		ld.AddEntry(newEntry)
-		// Add to queue
-		suite.k.InsertLockedDelegationQueue(suite.ctx, ld, newEntry.UnlockOn)
 	}
+	// Setting the locked delegation also queues the entries
 	err := suite.k.SetLockedDelegation(suite.ctx, ld)
 	suite.Require().NoError(err)
 }
@@ -8,68 +8,118 @@ import (
 	"github.com/aetherevm/locking/locking/types"
 )
 
-// GetLockedDelegationQueueTimeSlice returns the locked pairs at a set timestamp
+// GetLockedDelegationQueueTimeSlice returns the pairs of the entries queued at a set timestamp
+// A pair is returned once per queued entry
 func (k Keeper) GetLockedDelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (lockedDelegationPairs []types.LockedDelegationPair) {
 	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetLockedDelegationTimeKey(timestamp))
+	defer iterator.Close()
 
-	bz := store.Get(types.GetLockedDelegationTimeKey(timestamp))
-	if bz == nil {
-		return []types.LockedDelegationPair{}
+	lockedDelegationPairs = []types.LockedDelegationPair{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.LockedDelegationPair
+		k.cdc.MustUnmarshal(iterator.Value(), &pair)
+		lockedDelegationPairs = append(lockedDelegationPairs, pair)
 	}
-
-	// We need the pairs struct to Unmarshal
-	pairs := types.LockedDelegationPairs{}
-	k.cdc.MustUnmarshal(bz, &pairs)
-
-	return pairs.Pairs
-}
-
-// SetLockedDelegationQueueTimeSlice sets the locked pairs at a set timestamp
-func (k Keeper) SetLockedDelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Time, lockedDelegationPairs []types.LockedDelegationPair) {
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&types.LockedDelegationPairs{Pairs: lockedDelegationPairs})
-	store.Set(types.GetLockedDelegationTimeKey(timestamp), bz)
+	return lockedDelegationPairs
 }
 
-// InsertLockedDelegationQueue insert a locked delegation in the queue
-func (k Keeper) InsertLockedDelegationQueue(ctx sdk.Context, lockedDelegation types.LockedDelegation, unlockOn time.Time) {
+// InsertLockedDelegationQueue inserts a locked delegation entry in the queue
+// The entry is keyed by its unlock time and ID, holding the pair it belongs to
+func (k Keeper) InsertLockedDelegationQueue(ctx sdk.Context, lockedDelegation types.LockedDelegation, entry types.LockedDelegationEntry) {
 	// Build a pair
 	lockedDelegationPair := types.LockedDelegationPair{
 		DelegatorAddress: lockedDelegation.DelegatorAddress,
 		ValidatorAddress: lockedDelegation.ValidatorAddress,
 	}
 
-	// Get all the pairs at that time
-	pairsAtTime := k.GetLockedDelegationQueueTimeSlice(ctx, unlockOn)
-	if len(pairsAtTime) == 0 {
-		// If we don't have pairs create a new pair
-		k.SetLockedDelegationQueueTimeSlice(ctx, unlockOn, []types.LockedDelegationPair{lockedDelegationPair})
-	} else {
-		// If not we can append to the current pairs
-		pairsAtTime = append(pairsAtTime, lockedDelegationPair)
-		k.SetLockedDelegationQueueTimeSlice(ctx, unlockOn, pairsAtTime)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLockedDelegationQueueKey(entry.UnlockOn, entry.Id), k.cdc.MustMarshal(&lockedDelegationPair))
+}
+
+// RemoveLockedDelegationQueue removes a locked delegation entry from the queue
+func (k Keeper) RemoveLockedDelegationQueue(ctx sdk.Context, entry types.LockedDelegationEntry) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLockedDelegationQueueKey(entry.UnlockOn, entry.Id))
+}
+
+// updateLockedDelegationQueue replaces the queued entries of a locked delegation by the new ones
+// Entries with the same ID and unlock time are left untouched, so the expired entries already dequeued
+// aren't queued again
+func (k Keeper) updateLockedDelegationQueue(
+	ctx sdk.Context, lockedDelegation types.LockedDelegation, oldEntries, newEntries []types.LockedDelegationEntry,
+) {
+	type queueItem struct {
+		id       uint64
+		unlockOn int64
+	}
+	newItems := make(map[queueItem]bool, len(newEntries))
+	for _, entry := range newEntries {
+		newItems[queueItem{entry.Id, entry.UnlockOn.UnixNano()}] = true
+	}
+	oldItems := make(map[queueItem]bool, len(oldEntries))
+	for _, entry := range oldEntries {
+		item := queueItem{entry.Id, entry.UnlockOn.UnixNano()}
+		oldItems[item] = true
+		if !newItems[item] {
+			k.RemoveLockedDelegationQueue(ctx, entry)
+		}
+	}
+	for _, entry := range newEntries {
+		if !oldItems[queueItem{entry.Id, entry.UnlockOn.UnixNano()}] {
+			k.InsertLockedDelegationQueue(ctx, lockedDelegation, entry)
+		}
 	}
 }
 
-// LockedDelegationQueueIterator returns a iterator for locked delegation queue pairs from time 0 until endTime.
+// LockedDelegationQueueIterator returns a iterator for locked delegation queue entries from time 0 until endTime.
 func (k Keeper) LockedDelegationQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	return k.LockedDelegationQueueRangeIterator(ctx, types.LockedDelegationsQueueKey, endTime)
+}
+
+// LockedDelegationQueueRangeIterator returns a iterator for locked delegation queue entries from a queue key until endTime.
+func (k Keeper) LockedDelegationQueueRangeIterator(ctx sdk.Context, startKey []byte, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
-	return store.Iterator(types.LockedDelegationsQueueKey,
-		sdk.InclusiveEndBytes(types.GetLockedDelegationTimeKey(endTime)))
+	return store.Iterator(startKey,
+		sdk.PrefixEndBytes(types.GetLockedDelegationTimeKey(endTime)))
 }
 
-// GetAllLockedDelegationQueuePairs returns all the locked delegation queue pairs from time 0 until endTime.
+// GetAllLockedDelegationQueuePairs returns the pairs of all the locked delegation queue entries from time 0 until endTime.
+// A pair is returned once per queued entry
 func (k Keeper) GetAllLockedDelegationQueuePairs(ctx sdk.Context, endTime time.Time) (pairs []types.LockedDelegationPair) {
 	iterator := k.LockedDelegationQueueIterator(ctx, endTime)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		pairsAtTime := types.LockedDelegationPairs{}
-		value := iterator.Value()
-		k.cdc.MustUnmarshal(value, &pairsAtTime)
+		var pair types.LockedDelegationPair
+		k.cdc.MustUnmarshal(iterator.Value(), &pair)
 
 		// Save
-		pairs = append(pairs, pairsAtTime.Pairs...)
+		pairs = append(pairs, pair)
 	}
 	return
 }
+
+// IterateLockedDelegationQueue iterates over all the locked delegation queue entries
+// with their unlock time, entry ID and pair
+func (k Keeper) IterateLockedDelegationQueue(
+	ctx sdk.Context, cb func(unlockOn time.Time, id uint64, pair types.LockedDelegationPair) (stop bool),
+) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.LockedDelegationsQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		unlockOn, id, err := types.ParseLockedDelegationQueueKey(iterator.Key()[len(types.LockedDelegationsQueueKey):])
+		if err != nil {
+			return err
+		}
+
+		var pair types.LockedDelegationPair
+		k.cdc.MustUnmarshal(iterator.Value(), &pair)
+		if cb(unlockOn, id, pair) {
+			break
+		}
+	}
+	return nil
+}
@@ -3,24 +3,29 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/aetherevm/locking/locking/keeper"
 	"github.com/aetherevm/locking/locking/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// TestSetLockedDelegationQueueTimeSlice tests the store of queues on locked
-func (suite *KeeperTestSuite) TestSetLockedDelegationQueueTimeSlice() {
+// TestGetLockedDelegationQueueTimeSlice tests the pairs queued at a timestamp
+func (suite *KeeperTestSuite) TestGetLockedDelegationQueueTimeSlice() {
 	// Set a few testing pairs
 	addr1 := sdk.AccAddress([]byte("address1"))
 	valAddr1 := sdk.ValAddress([]byte("val1"))
 	pair1 := types.LockedDelegationPair{
 		DelegatorAddress: addr1.String(), ValidatorAddress: valAddr1.String(),
 	}
+	lockedDelegation1 := types.NewLockedDelegation(addr1, valAddr1, nil)
 
 	addr2 := sdk.AccAddress([]byte("address2"))
 	valAddr2 := sdk.ValAddress([]byte("val2"))
 	pair2 := types.LockedDelegationPair{
 		DelegatorAddress: addr2.String(), ValidatorAddress: valAddr2.String(),
 	}
+	lockedDelegation2 := types.NewLockedDelegation(addr2, valAddr2, nil)
+
+	unlockOn := time.Time{}.Add(time.Hour)
 
 	testCases := []struct {
 		name          string
@@ -30,62 +35,25 @@ func (suite *KeeperTestSuite) TestSetLockedDelegationQueueTimeSlice() {
 		{
 			"no locked delegations pairs queue",
 			func() {},
-			nil,
-		},
-		{
-			"1 locked delegation pair to queue",
-			func() {
-				suite.k.SetLockedDelegationQueueTimeSlice(
-					suite.ctx,
-					time.Now(),
-					[]types.LockedDelegationPair{pair1},
-				)
-			},
-			[]types.LockedDelegationPair{pair1},
+			[]types.LockedDelegationPair{},
 		},
 		{
-			"2 locked delegation pair to queue",
+			"2 locked delegation pairs at the timestamp",
 			func() {
-				suite.k.SetLockedDelegationQueueTimeSlice(
-					suite.ctx,
-					time.Now(),
-					[]types.LockedDelegationPair{pair1, pair2},
-				)
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation1, queueEntry(1, unlockOn))
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation2, queueEntry(2, unlockOn))
 			},
 			[]types.LockedDelegationPair{pair1, pair2},
 		},
 		{
-			"set the same pair twice",
+			"pairs at other timestamps are left out",
 			func() {
-				suite.k.SetLockedDelegationQueueTimeSlice(
-					suite.ctx,
-					time.Time{},
-					[]types.LockedDelegationPair{pair1},
-				)
-				suite.k.SetLockedDelegationQueueTimeSlice(
-					suite.ctx,
-					time.Time{},
-					[]types.LockedDelegationPair{pair1},
-				)
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation1, queueEntry(1, unlockOn))
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation2, queueEntry(2, unlockOn.Add(time.Nanosecond)))
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation2, queueEntry(3, unlockOn.Add(-time.Nanosecond)))
 			},
 			[]types.LockedDelegationPair{pair1},
 		},
-		{
-			"set the same pair different timestamp",
-			func() {
-				suite.k.SetLockedDelegationQueueTimeSlice(
-					suite.ctx,
-					time.Time{},
-					[]types.LockedDelegationPair{pair1},
-				)
-				suite.k.SetLockedDelegationQueueTimeSlice(
-					suite.ctx,
-					time.Time{}.Add(time.Nanosecond),
-					[]types.LockedDelegationPair{pair1},
-				)
-			},
-			[]types.LockedDelegationPair{pair1, pair1},
-		},
 	}
 
 	for _, tc := range testCases {
@@ -93,10 +61,7 @@ func (suite *KeeperTestSuite) TestSetLockedDelegationQueueTimeSlice() {
 			suite.SetupTest() // reset
 			tc.setter()
 
-			outcome := suite.k.GetAllLockedDelegationQueuePairs(
-				suite.ctx,
-				bigTime,
-			)
+			outcome := suite.k.GetLockedDelegationQueueTimeSlice(suite.ctx, unlockOn)
 			suite.Require().ElementsMatch(tc.expectedPairs, outcome, tc.name)
 		})
 	}
@@ -130,7 +95,7 @@ func (suite *KeeperTestSuite) TestInsertLockedDelegationQueue() {
 				suite.k.InsertLockedDelegationQueue(
 					suite.ctx,
 					lockedDelegation1,
-					time.Now(),
+					queueEntry(1, time.Now()),
 				)
 			},
 			[]types.LockedDelegationPair{pair1},
@@ -141,12 +106,12 @@ func (suite *KeeperTestSuite) TestInsertLockedDelegationQueue() {
 				suite.k.InsertLockedDelegationQueue(
 					suite.ctx,
 					lockedDelegation1,
-					time.Now(),
+					queueEntry(2, time.Now()),
 				)
 				suite.k.InsertLockedDelegationQueue(
 					suite.ctx,
 					lockedDelegation2,
-					time.Now().Add(time.Hour),
+					queueEntry(3, time.Now().Add(time.Hour)),
 				)
 			},
 			[]types.LockedDelegationPair{pair1, pair2},
@@ -157,45 +122,53 @@ func (suite *KeeperTestSuite) TestInsertLockedDelegationQueue() {
 				suite.k.InsertLockedDelegationQueue(
 					suite.ctx,
 					lockedDelegation1,
-					time.Time{}.Add(time.Hour),
+					queueEntry(4, time.Time{}.Add(time.Hour)),
 				)
 				suite.k.InsertLockedDelegationQueue(
 					suite.ctx,
 					lockedDelegation2,
-					time.Time{}.Add(time.Hour),
+					queueEntry(5, time.Time{}.Add(time.Hour)),
 				)
 			},
 			[]types.LockedDelegationPair{pair1, pair2},
 		},
 		{
-			"same pair twice at the same timestamp",
+			"same pair twice at the same timestamp with different entries",
 			func() {
 				suite.k.InsertLockedDelegationQueue(
 					suite.ctx,
 					lockedDelegation1,
-					time.Time{},
+					queueEntry(6, time.Time{}),
 				)
 				suite.k.InsertLockedDelegationQueue(
 					suite.ctx,
 					lockedDelegation1,
-					time.Time{},
+					queueEntry(7, time.Time{}),
 				)
 			},
-			// We don't care that the pair was return twice, we can do some sanitization
+			// Each entry has its own key, so the pair is returned twice
 			[]types.LockedDelegationPair{pair1, pair1},
 		},
+		{
+			"same entry twice at the same timestamp",
+			func() {
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation1, queueEntry(1, time.Time{}))
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation1, queueEntry(1, time.Time{}))
+			},
+			[]types.LockedDelegationPair{pair1},
+		},
 		{
 			"same pair twice at the different timestamp",
 			func() {
 				suite.k.InsertLockedDelegationQueue(
 					suite.ctx,
 					lockedDelegation1,
-					time.Time{},
+					queueEntry(8, time.Time{}),
 				)
 				suite.k.InsertLockedDelegationQueue(
 					suite.ctx,
 					lockedDelegation1,
-					time.Time{}.Add(time.Hour),
+					queueEntry(9, time.Time{}.Add(time.Hour)),
 				)
 			},
 			[]types.LockedDelegationPair{pair1, pair1},
@@ -217,6 +190,133 @@ func (suite *KeeperTestSuite) TestInsertLockedDelegationQueue() {
 	}
 }
 
+// TestRemoveLockedDelegationQueue tests the removal of entries from the queue
+func (suite *KeeperTestSuite) TestRemoveLockedDelegationQueue() {
+	// Set a few testing pairs
+	addr1 := sdk.AccAddress([]byte("address1"))
+	valAddr1 := sdk.ValAddress([]byte("val1"))
+	pair1 := types.LockedDelegationPair{
+		DelegatorAddress: addr1.String(), ValidatorAddress: valAddr1.String(),
+	}
+	lockedDelegation1 := types.NewLockedDelegation(addr1, valAddr1, nil)
+
+	addr2 := sdk.AccAddress([]byte("address2"))
+	valAddr2 := sdk.ValAddress([]byte("val2"))
+	pair2 := types.LockedDelegationPair{
+		DelegatorAddress: addr2.String(), ValidatorAddress: valAddr2.String(),
+	}
+	lockedDelegation2 := types.NewLockedDelegation(addr2, valAddr2, nil)
+
+	unlockOn := time.Time{}.Add(time.Hour)
+
+	testCases := []struct {
+		name          string
+		setter        func()
+		expectedPairs []types.LockedDelegationPair
+	}{
+		{
+			"remove the only entry",
+			func() {
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation1, queueEntry(1, unlockOn))
+				suite.k.RemoveLockedDelegationQueue(suite.ctx, queueEntry(1, unlockOn))
+			},
+			nil,
+		},
+		{
+			"keep the other entries of the pair",
+			func() {
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation1, queueEntry(1, unlockOn))
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation1, queueEntry(2, unlockOn))
+				suite.k.RemoveLockedDelegationQueue(suite.ctx, queueEntry(1, unlockOn))
+			},
+			[]types.LockedDelegationPair{pair1},
+		},
+		{
+			"keep other pairs at the same timestamp",
+			func() {
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation1, queueEntry(1, unlockOn))
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation2, queueEntry(2, unlockOn))
+				suite.k.RemoveLockedDelegationQueue(suite.ctx, queueEntry(1, unlockOn))
+			},
+			[]types.LockedDelegationPair{pair2},
+		},
+		{
+			"entry queued at a different timestamp",
+			func() {
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation1, queueEntry(1, unlockOn.Add(time.Hour)))
+				suite.k.RemoveLockedDelegationQueue(suite.ctx, queueEntry(1, unlockOn))
+			},
+			[]types.LockedDelegationPair{pair1},
+		},
+		{
+			"entry not in the queue",
+			func() {
+				suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation2, queueEntry(2, unlockOn))
+				suite.k.RemoveLockedDelegationQueue(suite.ctx, queueEntry(1, unlockOn))
+			},
+			[]types.LockedDelegationPair{pair2},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.setter()
+
+			// We send a giant time
+			outcome := suite.k.GetAllLockedDelegationQueuePairs(
+				suite.ctx,
+				bigTime,
+			)
+			suite.Require().ElementsMatch(tc.expectedPairs, outcome, tc.name)
+		})
+	}
+}
+
+// TestSetLockedDelegationUpdatesQueue tests that setting and deleting a locked delegation keeps the queue in sync
+func (suite *KeeperTestSuite) TestSetLockedDelegationUpdatesQueue() {
+	addr := sdk.AccAddress([]byte("address1"))
+	valAddr := sdk.ValAddress([]byte("val1"))
+	pair := types.LockedDelegationPair{
+		DelegatorAddress: addr.String(), ValidatorAddress: valAddr.String(),
+	}
+	unlockOn := suite.ctx.BlockTime().Add(time.Hour)
+
+	entry1 := types.NewLockedDelegationEntry(sdk.OneDec(), types.DefaultRates[0], unlockOn, false, 1)
+	entry2 := types.NewLockedDelegationEntry(sdk.OneDec(), types.DefaultRates[1], unlockOn, false, 2)
+	lockedDelegation := types.NewLockedDelegation(addr, valAddr, []types.LockedDelegationEntry{entry1, entry2})
+	suite.Require().NoError(suite.k.SetLockedDelegation(suite.ctx, lockedDelegation))
+	suite.Require().Equal([]types.LockedDelegationPair{pair, pair}, suite.k.GetLockedDelegationQueueTimeSlice(suite.ctx, unlockOn))
+
+	// Moving an entry moves its key only
+	lockedDelegation.Entries[1].UnlockOn = unlockOn.Add(time.Hour)
+	suite.Require().NoError(suite.k.SetLockedDelegation(suite.ctx, lockedDelegation))
+	suite.Require().Equal([]types.LockedDelegationPair{pair}, suite.k.GetLockedDelegationQueueTimeSlice(suite.ctx, unlockOn))
+	suite.Require().Equal([]types.LockedDelegationPair{pair}, suite.k.GetLockedDelegationQueueTimeSlice(suite.ctx, unlockOn.Add(time.Hour)))
+	_, broken := keeper.LockedDelegationQueueInvariant(suite.k)(suite.ctx)
+	suite.Require().False(broken)
+
+	// A dequeued entry isn't queued again while it's kept
+	suite.k.RemoveLockedDelegationQueue(suite.ctx, entry1)
+	suite.Require().NoError(suite.k.SetLockedDelegation(suite.ctx, lockedDelegation))
+	suite.Require().Empty(suite.k.GetLockedDelegationQueueTimeSlice(suite.ctx, unlockOn))
+
+	// The entries not expired yet must be queued
+	_, broken = keeper.LockedDelegationQueueInvariant(suite.k)(suite.ctx)
+	suite.Require().True(broken)
+	_, broken = keeper.LockedDelegationQueueInvariant(suite.k)(suite.ctx.WithBlockTime(unlockOn))
+	suite.Require().False(broken)
+
+	// Deleting the locked delegation removes all its entries
+	suite.Require().NoError(suite.k.DeleteLockedDelegation(suite.ctx, lockedDelegation))
+	suite.Require().Empty(suite.k.GetAllLockedDelegationQueuePairs(suite.ctx, bigTime))
+
+	// Stale entries break the invariant
+	suite.k.InsertLockedDelegationQueue(suite.ctx, lockedDelegation, entry1)
+	_, broken = keeper.LockedDelegationQueueInvariant(suite.k)(suite.ctx)
+	suite.Require().True(broken)
+}
+
 // TestLockedDelegationQueueIterator tests the LockedDelegationQueueIterator function
 func (suite *KeeperTestSuite) TestLockedDelegationQueueIterator() {
 	now := time.Now()
@@ -245,7 +345,7 @@ func (suite *KeeperTestSuite) TestLockedDelegationQueueIterator() {
 			suite.k.InsertLockedDelegationQueue(
 				suite.ctx,
 				ld,
-				timestamp,
+				queueEntry(uint64(saves), timestamp),
 			)
 			saves++
 
@@ -267,6 +367,11 @@ func (suite *KeeperTestSuite) TestLockedDelegationQueueIterator() {
 	suite.Require().Less(len(pairs), saves)
 }
 
+// queueEntry returns a locked delegation entry with the values used by the queue
+func queueEntry(id uint64, unlockOn time.Time) types.LockedDelegationEntry {
+	return types.NewLockedDelegationEntry(sdk.OneDec(), types.DefaultRates[0], unlockOn, false, id)
+}
+
 // shuffledTimestamp generates a pseudo random time using module values
 func shuffledTimestamp(i int) time.Time {
 	// Create a base time for consistency.
@@ -736,6 +736,7 @@ func (suite *KeeperTestSuite) TestStoreLockedDelegationBadMarshal() {
 		suite.app.StakingKeeper,
 		suite.app.DistrKeeper,
 		suite.app.BankKeeper,
+		suite.app.SlashingKeeper,
 		authAddr,
 	)
 
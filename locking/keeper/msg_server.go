@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"strconv"
+	"strings"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
@@ -63,6 +65,8 @@ func (ms msgServer) CreateLockedDelegation(goCtx context.Context, msg *types.Msg
 		msg.Amount.Amount,
 		msg.LockDuration,
 		msg.AutoRenew,
+		msg.ExpiryAction,
+		msg.RedelegateTo,
 	)
 	if err != nil {
 		return nil, err
@@ -84,36 +88,96 @@ func (ms msgServer) CreateLockedDelegation(goCtx context.Context, msg *types.Msg
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateLockedDelegation,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
 			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEntryID, strconv.FormatUint(entry.Id, 10)),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, entry.Shares.String()),
 			sdk.NewAttribute(types.AttributeKeyUnlockOn, entry.UnlockOn.String()),
 			sdk.NewAttribute(types.AttributeKeyAutoRenew, strconv.FormatBool(entry.AutoRenew)),
+			sdk.NewAttribute(types.AttributeKeyExpiryAction, entry.ExpiryAction.String()),
 		),
 	})
 
 	return &types.MsgCreateLockedDelegationResponse{}, nil
 }
 
+// LockExistingDelegation creates a new locked delegation on top of an existing delegation
+// No new delegation is created, the shares must already be delegated
+func (ms msgServer) LockExistingDelegation(goCtx context.Context, msg *types.MsgLockExistingDelegation) (*types.MsgLockExistingDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get the validator and delegator address
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, valErr
+	}
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the input msg denomination
+	bondDenom := ms.stakingKeeper.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(
+			sdkerrorstypes.ErrInvalidRequest, ErrInvalidDenom, msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	// Create a new locked delegation entry over the existing delegation
+	entry, err := ms.Keeper.LockExistingDelegationEntry(
+		ctx,
+		delAddr,
+		valAddr,
+		msg.Amount.Amount,
+		msg.LockDuration,
+		msg.AutoRenew,
+		msg.ExpiryAction,
+		msg.RedelegateTo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeLockExistingDelegation,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEntryID, strconv.FormatUint(entry.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, entry.Shares.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockOn, entry.UnlockOn.String()),
+			sdk.NewAttribute(types.AttributeKeyAutoRenew, strconv.FormatBool(entry.AutoRenew)),
+			sdk.NewAttribute(types.AttributeKeyExpiryAction, entry.ExpiryAction.String()),
+		),
+	})
+
+	return &types.MsgLockExistingDelegationResponse{}, nil
+}
+
 // RedelegateLockedDelegations creates a redelegates tokens
 // But before we do the normal undelegation
 // This is built on top of the staking BeginRedelegate msg server implementation
 func (ms msgServer) RedelegateLockedDelegations(goCtx context.Context, msg *types.MsgRedelegateLockedDelegations) (*types.MsgRedelegateLockedDelegationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Get the src and dst validator addresses and delegator address
+	valSrcAddr, err := sdk.ValAddressFromBech32(msg.ValidatorSrcAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the number of redelegate ids is bigger than the max entries
-	if uint32(len(msg.Ids)) > ms.Keeper.MaxEntries(ctx) {
+	// Locks on exited validators can be redelegated freely
+	if uint32(len(msg.Ids)) > ms.Keeper.MaxEntries(ctx) && !ms.Keeper.HasFreeRedelegationValidator(ctx, valSrcAddr) {
 		return nil, types.ErrRedelegationIdsBiggerThanMaxEntries
 	}
 
-	// Get the src and dst validator addresses and delegator address
 	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
-	valSrcAddr, err := sdk.ValAddressFromBech32(msg.ValidatorSrcAddress)
-	if err != nil {
-		return nil, err
-	}
 	valDstAddr, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress)
 	if err != nil {
 		return nil, err
@@ -181,7 +245,9 @@ func (ms msgServer) ToggleAutoRenew(goCtx context.Context, msg *types.MsgToggleA
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeToggleAutoRenew,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
 			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEntryID, strconv.FormatUint(entry.Id, 10)),
 			sdk.NewAttribute(types.AttributeKeyAutoRenew, strconv.FormatBool(entry.AutoRenew)),
 		),
 	})
@@ -189,6 +255,295 @@ func (ms msgServer) ToggleAutoRenew(goCtx context.Context, msg *types.MsgToggleA
 	return &types.MsgToggleAutoRenewResponse{}, nil
 }
 
+// SetExpiryAction sets the expiry action for a single locked delegation entry
+func (ms msgServer) SetExpiryAction(goCtx context.Context, msg *types.MsgSetExpiryAction) (*types.MsgSetExpiryActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate the addresses
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, valErr
+	}
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the entry
+	entry, err := ms.Keeper.SetLockedDelegationEntryExpiryAction(ctx, delAddr, valAddr, msg.Id, msg.ExpiryAction, msg.RedelegateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetExpiryAction,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEntryID, strconv.FormatUint(entry.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyExpiryAction, entry.ExpiryAction.String()),
+			sdk.NewAttribute(types.AttributeKeyRedelegateTo, entry.RedelegateTo),
+		),
+	})
+
+	return &types.MsgSetExpiryActionResponse{}, nil
+}
+
+// EarlyUnlock unlocks locked delegation entries before their unlock time
+// The entries are undelegated and a penalty is taken based on the entries rate
+func (ms msgServer) EarlyUnlock(goCtx context.Context, msg *types.MsgEarlyUnlock) (*types.MsgEarlyUnlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate the addresses
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, valErr
+	}
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// The unlocked entries are looked up before they're removed, no IDs unlock all the entries
+	lockedDelegation, _ := ms.Keeper.GetLockedDelegation(ctx, delAddr, valAddr)
+	_, unlocked := lockedDelegation.EntriesForIds(msg.Ids)
+	ids := make([]string, 0, len(unlocked))
+	for _, entry := range unlocked {
+		ids = append(ids, strconv.FormatUint(entry.Id, 10))
+	}
+
+	// Unlock the entries and undelegate
+	completionTime, penalty, err := ms.Keeper.EarlyUnlockLockedDelegationEntries(ctx, delAddr, valAddr, msg.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// Do the telemetry for the penalty
+	if penalty.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "early_unlock")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(penalty.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", penalty.Denom)},
+			)
+		}()
+	}
+
+	// Emit the events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEarlyUnlock,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEntryIDs, strings.Join(ids, ",")),
+			sdk.NewAttribute(types.AttributeKeyPenalty, penalty.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgEarlyUnlockResponse{
+		CompletionTime: completionTime,
+		Penalty:        penalty,
+	}, nil
+}
+
+// SplitLockedDelegationEntry splits a locked delegation entry in two new entries
+func (ms msgServer) SplitLockedDelegationEntry(goCtx context.Context, msg *types.MsgSplitLockedDelegationEntry) (*types.MsgSplitLockedDelegationEntryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate the addresses
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, valErr
+	}
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Split the entry
+	split, remainder, err := ms.Keeper.SplitLockedDelegationEntry(ctx, delAddr, valAddr, msg.Id, msg.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSplitLockedDelegationEntry,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEntryID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySplitID, strconv.FormatUint(split.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRemainderID, strconv.FormatUint(remainder.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, split.Shares.String()),
+		),
+	})
+
+	return &types.MsgSplitLockedDelegationEntryResponse{
+		SplitId:     split.Id,
+		RemainderId: remainder.Id,
+	}, nil
+}
+
+// ExtendLock moves a locked delegation entry to a longer duration rate
+func (ms msgServer) ExtendLock(goCtx context.Context, msg *types.MsgExtendLock) (*types.MsgExtendLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate the addresses
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, valErr
+	}
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extend the entry
+	entry, err := ms.Keeper.ExtendLockedDelegationEntry(ctx, delAddr, valAddr, msg.Id, msg.LockDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeExtendLock,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEntryID, strconv.FormatUint(entry.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDuration, entry.Rate.Duration.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockOn, entry.UnlockOn.String()),
+		),
+	})
+
+	return &types.MsgExtendLockResponse{UnlockOn: entry.UnlockOn}, nil
+}
+
+// FundRewardPool sends coins from the depositor to the locking reward pool
+func (ms msgServer) FundRewardPool(goCtx context.Context, msg *types.MsgFundRewardPool) (*types.MsgFundRewardPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.FundRewardPool(ctx, depositor, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFundRewardPool,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+
+	return &types.MsgFundRewardPoolResponse{}, nil
+}
+
+// ClaimRewardDebt pays the locking rewards owed to the delegator on a validator
+// The debt is paid as far as the funding mode allows, the rest is kept as debt
+func (ms msgServer) ClaimRewardDebt(goCtx context.Context, msg *types.MsgClaimRewardDebt) (*types.MsgClaimRewardDebtResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get the validator and delegator address
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, valErr
+	}
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	paid, debt, err := ms.Keeper.ClaimRewardDebt(ctx, delAddr, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimRewardDebt,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, paid.String()),
+			sdk.NewAttribute(types.AttributeKeyDebt, debt.String()),
+		),
+	})
+
+	return &types.MsgClaimRewardDebtResponse{Amount: paid, RemainingDebt: debt}, nil
+}
+
+// SetBonusBeneficiary sets the address receiving the locking rewards of the delegator
+// An empty beneficiary sends them to the distribution withdraw address again
+func (ms msgServer) SetBonusBeneficiary(goCtx context.Context, msg *types.MsgSetBonusBeneficiary) (*types.MsgSetBonusBeneficiaryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate the addresses
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	var beneficiary sdk.AccAddress
+	if msg.BeneficiaryAddress != "" {
+		beneficiary, err = sdk.AccAddressFromBech32(msg.BeneficiaryAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ms.Keeper.UpdateBonusBeneficiary(ctx, delAddr, beneficiary); err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetBonusBeneficiary,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyBeneficiary, msg.BeneficiaryAddress),
+		),
+	})
+
+	return &types.MsgSetBonusBeneficiaryResponse{}, nil
+}
+
+// SetAutoCompound sets if the locking rewards of a locked delegation are compounded
+func (ms msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get the validator and delegator address
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, valErr
+	}
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.SetLockedDelegationAutoCompound(ctx, delAddr, valAddr, msg.AutoCompound); err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyAutoCompound, strconv.FormatBool(msg.AutoCompound)),
+		),
+	})
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
 // UpdateParams updates params though a proposal
 func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -198,10 +553,101 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, ErrInvalidAuthority, ms.authority, msg.Authority)
 	}
 
+	// The locking rewards are accrued with the previous reward mode before changing it
+	if ms.GetParams(ctx).RewardMode != msg.Params.RewardMode {
+		if err := ms.checkpointAllLockedDelegationRewards(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update params
 	if err := ms.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
+	err := ctx.EventManager().EmitTypedEvent(&types.EventParamsUpdated{
+		Authority: msg.Authority,
+		Params:    msg.Params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RetryQuarantinedPairs completes the expired entries of quarantined locked delegation pairs
+// The message fails if any of the pairs still can't be completed
+func (ms msgServer) RetryQuarantinedPairs(goCtx context.Context, msg *types.MsgRetryQuarantinedPairs) (*types.MsgRetryQuarantinedPairsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check authority
+	if ms.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, ErrInvalidAuthority, ms.authority, msg.Authority)
+	}
+
+	for _, pair := range msg.Pairs {
+		if err := ms.RetryQuarantinedPair(ctx, pair); err != nil {
+			return nil, sdkerrors.Wrapf(err, "%s/%s", pair.DelegatorAddress, pair.ValidatorAddress)
+		}
+
+		// Emit events
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRetryQuarantinedPair,
+				sdk.NewAttribute(types.AttributeKeyDelegator, pair.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyValidator, pair.ValidatorAddress),
+			),
+		})
+	}
+
+	return &types.MsgRetryQuarantinedPairsResponse{}, nil
+}
+
+// FundFromCommunityPool moves coins from the community pool to the locking reward pool though a proposal
+func (ms msgServer) FundFromCommunityPool(goCtx context.Context, msg *types.MsgFundFromCommunityPool) (*types.MsgFundFromCommunityPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check authority
+	if ms.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, ErrInvalidAuthority, ms.authority, msg.Authority)
+	}
+
+	if err := ms.Keeper.FundRewardPoolFromCommunityPool(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFundFromCommunityPool,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+
+	return &types.MsgFundFromCommunityPoolResponse{}, nil
+}
+
+// WithdrawLockingRewards pays the locking rewards accrued by the delegator on a validator
+// Only available on the standalone reward mode
+func (ms msgServer) WithdrawLockingRewards(goCtx context.Context, msg *types.MsgWithdrawLockingRewards) (*types.MsgWithdrawLockingRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get the validator and delegator address
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, valErr
+	}
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := ms.Keeper.WithdrawLockingRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawLockingRewardsResponse{Amount: amount}, nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"strconv"
+	"strings"
 	"time"
 
 	"cosmossdk.io/math"
@@ -8,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/aetherevm/locking/locking/keeper"
 	"github.com/aetherevm/locking/locking/types"
 )
 
@@ -32,6 +35,8 @@ func (suite *KeeperTestSuite) TestCreateLockedDelegation() {
 					sdk.NewCoin(bondDenom, sdk.NewInt(20)),
 					rate.Duration,
 					false,
+					types.ExpiryActionUndelegate,
+					"",
 				)
 			},
 			true,
@@ -45,6 +50,8 @@ func (suite *KeeperTestSuite) TestCreateLockedDelegation() {
 					sdk.NewCoin(bondDenom, sdk.NewInt(20)),
 					rate.Duration,
 					false,
+					types.ExpiryActionUndelegate,
+					"",
 				)
 			},
 			true,
@@ -58,6 +65,8 @@ func (suite *KeeperTestSuite) TestCreateLockedDelegation() {
 					sdk.NewCoin(bondDenom, sdk.NewInt(20)),
 					rate.Duration,
 					false,
+					types.ExpiryActionUndelegate,
+					"",
 				)
 			},
 			true,
@@ -71,6 +80,8 @@ func (suite *KeeperTestSuite) TestCreateLockedDelegation() {
 					sdk.NewCoin("test", sdk.NewInt(20)),
 					rate.Duration,
 					false,
+					types.ExpiryActionUndelegate,
+					"",
 				)
 			},
 			true,
@@ -84,6 +95,8 @@ func (suite *KeeperTestSuite) TestCreateLockedDelegation() {
 					sdk.NewCoin(bondDenom, sdk.NewInt(20)),
 					rate.Duration+1,
 					false,
+					types.ExpiryActionUndelegate,
+					"",
 				)
 			},
 			true,
@@ -97,6 +110,8 @@ func (suite *KeeperTestSuite) TestCreateLockedDelegation() {
 					sdk.NewCoin(bondDenom, sdk.NewInt(20)),
 					rate.Duration,
 					false,
+					types.ExpiryActionUndelegate,
+					"",
 				)
 			},
 			true,
@@ -119,6 +134,8 @@ func (suite *KeeperTestSuite) TestCreateLockedDelegation() {
 					sdk.NewCoin(bondDenom, sdk.NewInt(20)),
 					rate.Duration,
 					false,
+					types.ExpiryActionUndelegate,
+					"",
 				)
 			},
 			expError: true,
@@ -137,6 +154,8 @@ func (suite *KeeperTestSuite) TestCreateLockedDelegation() {
 					sdk.NewCoin(bondDenom, sdk.NewInt(20)),
 					rate.Duration,
 					true,
+					types.ExpiryActionUndelegate,
+					"",
 				)
 			},
 			false,
@@ -164,12 +183,14 @@ func (suite *KeeperTestSuite) TestCreateLockedDelegation() {
 				valAddr,
 			)
 			suite.Require().True(found)
-			suite.Require().EqualValues(delegation.Shares, req.Amount.Amount)
+			shares, err := validator.SharesFromTokens(req.Amount.Amount)
+			suite.Require().NoError(err)
+			suite.Require().Equal(shares, delegation.Shares)
 
 			// The locked delegation must exists
 			lockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
 			suite.Require().True(found)
-			suite.Require().EqualValues(lockedDelegation.Entries[0].Shares, req.Amount.Amount)
+			suite.Require().Equal(shares, lockedDelegation.Entries[0].Shares)
 
 			// It also must exist on the queue
 			queuePairs := suite.k.GetAllLockedDelegationQueuePairs(suite.ctx, bigTime)
@@ -388,6 +409,16 @@ func (suite *KeeperTestSuite) TestRedelegateLockedDelegations() {
 				ValidatorAddress: dstValAddr.String(),
 			})
 
+			// The moved entries must leave the source pair on the queue
+			if req.Ids == nil {
+				suite.Require().NotContains(queuePairs, types.LockedDelegationPair{
+					DelegatorAddress: delAddr.String(),
+					ValidatorAddress: srcValAddr.String(),
+				})
+			}
+			_, broken := keeper.LockedDelegationQueueInvariant(suite.k)(suite.ctx)
+			suite.Require().False(broken, tc.name)
+
 			// The target delegator must have the correct amount of shares
 			delegation := suite.app.StakingKeeper.Delegation(suite.ctx, delAddr, dstValAddr)
 			suite.Require().EqualValues(lockedDelegation.TotalShares(), delegation.GetShares(), tc.name)
@@ -517,6 +548,556 @@ func (suite *KeeperTestSuite) TestToggleAutoRenew() {
 	}
 }
 
+// TestSetExpiryAction tests the msg server SetExpiryAction
+func (suite *KeeperTestSuite) TestSetExpiryAction() {
+	delAddr := sdk.AccAddress([]byte("address1"))
+	valAddr := sdk.ValAddress([]byte("val1"))
+
+	testCases := []struct {
+		name     string
+		maleate  func() types.MsgSetExpiryAction
+		expError bool
+	}{
+		{
+			"fail - bad delegator addr",
+			func() types.MsgSetExpiryAction {
+				return *types.NewMsgSetExpiryAction(sdk.AccAddress{}, valAddr, 0, types.ExpiryActionStayDelegated, "")
+			},
+			true,
+		},
+		{
+			"fail - bad validator addr",
+			func() types.MsgSetExpiryAction {
+				return *types.NewMsgSetExpiryAction(delAddr, sdk.ValAddress{}, 0, types.ExpiryActionStayDelegated, "")
+			},
+			true,
+		},
+		{
+			"fail - not locked delegation",
+			func() types.MsgSetExpiryAction {
+				return *types.NewMsgSetExpiryAction(delAddr, valAddr, 0, types.ExpiryActionStayDelegated, "")
+			},
+			true,
+		},
+		{
+			"pass",
+			func() types.MsgSetExpiryAction {
+				lockedDelegation := types.NewLockedDelegation(
+					delAddr,
+					valAddr,
+					[]types.LockedDelegationEntry{
+						types.NewLockedDelegationEntry(math.LegacyOneDec(), rate, time.Now(), false, 1),
+						types.NewLockedDelegationEntry(math.LegacyOneDec(), rate, time.Now(), true, 2),
+					},
+				)
+
+				err := suite.k.SetLockedDelegation(suite.ctx, lockedDelegation)
+				suite.Require().NoError(err)
+
+				return *types.NewMsgSetExpiryAction(delAddr, valAddr, 2, types.ExpiryActionStayDelegated, "")
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.SetupTest() // Restart the whole app each time
+
+		req := tc.maleate()
+
+		// Save the original locked delegation
+		originalLockedDelegation, _ := suite.k.GetLockedDelegation(
+			suite.ctx,
+			delAddr,
+			valAddr,
+		)
+
+		_, err := suite.msgSrvr.SetExpiryAction(suite.ctx, &req)
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+
+			lockedDelegation, found := suite.k.GetLockedDelegation(
+				suite.ctx,
+				delAddr,
+				valAddr,
+			)
+			suite.Require().True(found)
+
+			// Only the requested entry is updated
+			for i, entry := range lockedDelegation.Entries {
+				originalEntry := originalLockedDelegation.Entries[i]
+				if entry.Id == req.Id {
+					suite.Require().Equal(req.ExpiryAction, entry.ExpiryAction, tc.name)
+					continue
+				}
+				suite.Require().Equal(entry, originalEntry, tc.name)
+			}
+		}
+	}
+}
+
+// TestSplitLockedDelegationEntry tests the msg server SplitLockedDelegationEntry
+func (suite *KeeperTestSuite) TestSplitLockedDelegationEntry() {
+	delAddr := sdk.AccAddress([]byte("address1"))
+	valAddr := sdk.ValAddress([]byte("val1"))
+	unlockOn := time.Unix(100, 0).UTC()
+
+	testCases := []struct {
+		name        string
+		maleate     func() types.MsgSplitLockedDelegationEntry
+		errContains string
+	}{
+		{
+			"fail - bad delegator addr",
+			func() types.MsgSplitLockedDelegationEntry {
+				return *types.NewMsgSplitLockedDelegationEntry(sdk.AccAddress{}, valAddr, 1, math.LegacyOneDec())
+			},
+			"empty address string is not allowed",
+		},
+		{
+			"fail - bad validator addr",
+			func() types.MsgSplitLockedDelegationEntry {
+				return *types.NewMsgSplitLockedDelegationEntry(delAddr, sdk.ValAddress{}, 1, math.LegacyOneDec())
+			},
+			"empty address string is not allowed",
+		},
+		{
+			"fail - not locked delegation",
+			func() types.MsgSplitLockedDelegationEntry {
+				return *types.NewMsgSplitLockedDelegationEntry(delAddr, valAddr, 1, math.LegacyOneDec())
+			},
+			"locked delegation for delegator and validator addresses pair not found",
+		},
+		{
+			"fail - entry not found",
+			func() types.MsgSplitLockedDelegationEntry {
+				createSplitTestingLD(suite, delAddr, valAddr, unlockOn)
+				return *types.NewMsgSplitLockedDelegationEntry(delAddr, valAddr, 3, math.LegacyOneDec())
+			},
+			"locked delegation entry for specified id not found",
+		},
+		{
+			"fail - shares bigger than the entry",
+			func() types.MsgSplitLockedDelegationEntry {
+				createSplitTestingLD(suite, delAddr, valAddr, unlockOn)
+				return *types.NewMsgSplitLockedDelegationEntry(delAddr, valAddr, 1, math.LegacyNewDec(10))
+			},
+			"split shares must be smaller than the locked delegation entry shares",
+		},
+		{
+			"fail - max entries reached",
+			func() types.MsgSplitLockedDelegationEntry {
+				createSplitTestingLD(suite, delAddr, valAddr, unlockOn)
+
+				params := suite.k.GetParams(suite.ctx)
+				params.MaxEntries = 2
+				err := suite.k.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+
+				return *types.NewMsgSplitLockedDelegationEntry(delAddr, valAddr, 1, math.LegacyOneDec())
+			},
+			"the max number of entries for the current locked delegation has been reached",
+		},
+		{
+			"pass",
+			func() types.MsgSplitLockedDelegationEntry {
+				createSplitTestingLD(suite, delAddr, valAddr, unlockOn)
+				return *types.NewMsgSplitLockedDelegationEntry(delAddr, valAddr, 1, math.LegacyNewDec(4))
+			},
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		suite.SetupTest() // Restart the whole app each time
+
+		req := tc.maleate()
+
+		// Save the original locked delegation
+		originalLockedDelegation, _ := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+
+		res, err := suite.msgSrvr.SplitLockedDelegationEntry(suite.ctx, &req)
+
+		if tc.errContains != "" {
+			suite.Require().ErrorContains(err, tc.errContains, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+
+		lockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+		suite.Require().True(found, tc.name)
+		suite.Require().Len(lockedDelegation.Entries, len(originalLockedDelegation.Entries)+1, tc.name)
+		suite.Require().Equal(originalLockedDelegation.TotalShares(), lockedDelegation.TotalShares(), tc.name)
+
+		// The new entries keep the original values and are not merged
+		exists, entries := lockedDelegation.EntriesForIds([]uint64{res.SplitId, res.RemainderId})
+		suite.Require().True(exists, tc.name)
+		suite.Require().Equal(req.Shares, entries[0].Shares, tc.name)
+		suite.Require().Equal(math.LegacyNewDec(6), entries[1].Shares, tc.name)
+		for _, entry := range entries {
+			suite.Require().Equal(rate, entry.Rate, tc.name)
+			suite.Require().Equal(unlockOn, entry.UnlockOn, tc.name)
+			suite.Require().True(entry.AutoRenew, tc.name)
+
+			// The look up must point to the locked delegation
+			ld, found := suite.k.GetLockedDelegationByEntryID(suite.ctx, entry.Id)
+			suite.Require().True(found, tc.name)
+			suite.Require().Equal(lockedDelegation, ld, tc.name)
+		}
+
+		// The original entry is removed from the look up
+		_, found = suite.k.GetLockedDelegationByEntryID(suite.ctx, req.Id)
+		suite.Require().False(found, tc.name)
+	}
+}
+
+// createSplitTestingLD creates a locked delegation with two entries, ids 1 and 2, to be split
+func createSplitTestingLD(suite *KeeperTestSuite, delAddr sdk.AccAddress, valAddr sdk.ValAddress, unlockOn time.Time) {
+	for i := 0; i < 2; i++ {
+		entry := types.NewLockedDelegationEntry(
+			math.LegacyNewDec(10), rate, unlockOn.Add(time.Duration(i)*time.Hour), true,
+			suite.k.IncrementLockedDelegationEntryID(suite.ctx),
+		)
+		ld, err := suite.k.SetLockedDelegationEntry(suite.ctx, delAddr, valAddr, entry)
+		suite.Require().NoError(err)
+		err = suite.k.SetLockedDelegationByEntryID(suite.ctx, ld, entry.Id)
+		suite.Require().NoError(err)
+	}
+}
+
+// TestExtendLock tests the msg server ExtendLock
+func (suite *KeeperTestSuite) TestExtendLock() {
+	delAddr := sdk.AccAddress([]byte("address1"))
+	longerRate := types.NewRateWithPenalty(rate.Duration*2, rate.Rate.MulInt64(2), rate.EarlyUnlockPenalty)
+
+	testCases := []struct {
+		name        string
+		maleate     func(valAddr sdk.ValAddress) types.MsgExtendLock
+		errContains string
+	}{
+		{
+			"fail - bad delegator addr",
+			func(valAddr sdk.ValAddress) types.MsgExtendLock {
+				return *types.NewMsgExtendLock(sdk.AccAddress{}, valAddr, 1, longerRate.Duration)
+			},
+			"empty address string is not allowed",
+		},
+		{
+			"fail - bad validator addr",
+			func(valAddr sdk.ValAddress) types.MsgExtendLock {
+				return *types.NewMsgExtendLock(delAddr, sdk.ValAddress{}, 1, longerRate.Duration)
+			},
+			"empty address string is not allowed",
+		},
+		{
+			"fail - rate not found",
+			func(valAddr sdk.ValAddress) types.MsgExtendLock {
+				mintAndCreateLockeDelegations(suite, 2, delAddr, valAddr)
+				return *types.NewMsgExtendLock(delAddr, valAddr, 1, time.Hour)
+			},
+			"locked delegation does not have a corresponding rate duration in params",
+		},
+		{
+			"fail - not locked delegation",
+			func(valAddr sdk.ValAddress) types.MsgExtendLock {
+				return *types.NewMsgExtendLock(delAddr, valAddr, 1, longerRate.Duration)
+			},
+			"locked delegation for delegator and validator addresses pair not found",
+		},
+		{
+			"fail - entry not found",
+			func(valAddr sdk.ValAddress) types.MsgExtendLock {
+				mintAndCreateLockeDelegations(suite, 2, delAddr, valAddr)
+				return *types.NewMsgExtendLock(delAddr, valAddr, 3, longerRate.Duration)
+			},
+			"locked delegation entry for specified id not found",
+		},
+		{
+			"fail - shorter rate",
+			func(valAddr sdk.ValAddress) types.MsgExtendLock {
+				mintAndCreateLockeDelegations(suite, 2, delAddr, valAddr)
+				return *types.NewMsgExtendLock(delAddr, valAddr, 1, types.DefaultRates[0].Duration)
+			},
+			"extended lock duration must be longer than the current entry rate duration",
+		},
+		{
+			"fail - same rate",
+			func(valAddr sdk.ValAddress) types.MsgExtendLock {
+				mintAndCreateLockeDelegations(suite, 2, delAddr, valAddr)
+				return *types.NewMsgExtendLock(delAddr, valAddr, 1, rate.Duration)
+			},
+			"extended lock duration must be longer than the current entry rate duration",
+		},
+		{
+			"pass",
+			func(valAddr sdk.ValAddress) types.MsgExtendLock {
+				mintAndCreateLockeDelegations(suite, 2, delAddr, valAddr)
+				return *types.NewMsgExtendLock(delAddr, valAddr, 2, longerRate.Duration)
+			},
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		suite.SetupTest() // Restart the whole app each time
+
+		// Add the longer rate to the params
+		params := suite.k.GetParams(suite.ctx)
+		params.Rates = append(params.Rates, longerRate)
+		err := suite.k.SetParams(suite.ctx, params)
+		suite.Require().NoError(err)
+
+		validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+		valAddr := validator.GetOperator()
+
+		req := tc.maleate(valAddr)
+
+		// Save the original locked delegation
+		originalLockedDelegation, _ := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+
+		res, err := suite.msgSrvr.ExtendLock(suite.ctx, &req)
+
+		if tc.errContains != "" {
+			suite.Require().ErrorContains(err, tc.errContains, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+
+		// The entry must be moved to the new rate
+		expectedUnlockOn := suite.ctx.BlockTime().Add(longerRate.Duration)
+		suite.Require().Equal(expectedUnlockOn, res.UnlockOn, tc.name)
+
+		lockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+		suite.Require().True(found, tc.name)
+		suite.Require().Len(lockedDelegation.Entries, len(originalLockedDelegation.Entries), tc.name)
+		for i, entry := range lockedDelegation.Entries {
+			originalEntry := originalLockedDelegation.Entries[i]
+			if entry.Id != req.Id {
+				suite.Require().Equal(originalEntry, entry, tc.name)
+				continue
+			}
+			suite.Require().Equal(longerRate, entry.Rate, tc.name)
+			suite.Require().Equal(expectedUnlockOn, entry.UnlockOn, tc.name)
+			suite.Require().Equal(originalEntry.Shares, entry.Shares, tc.name)
+
+			// The entry must be moved in the queue
+			suite.Require().Empty(suite.k.GetLockedDelegationQueueTimeSlice(suite.ctx, originalEntry.UnlockOn), tc.name)
+			suite.Require().Equal(
+				[]types.LockedDelegationPair{{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String()}},
+				suite.k.GetLockedDelegationQueueTimeSlice(suite.ctx, expectedUnlockOn),
+				tc.name,
+			)
+		}
+	}
+}
+
+// TestLockExistingDelegation tests the msg server LockExistingDelegation
+func (suite *KeeperTestSuite) TestLockExistingDelegation() {
+	delAddr := sdk.AccAddress([]byte("address1"))
+
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	rate := types.DefaultRates[0]
+	amount := sdk.TokensFromConsensusPower(1_000, PowerReduction)
+
+	testCases := []struct {
+		name     string
+		maleate  func(stakingtypes.Validator) types.MsgLockExistingDelegation
+		expError bool
+	}{
+		{
+			"fail - bad validator addr",
+			func(validator stakingtypes.Validator) types.MsgLockExistingDelegation {
+				return *types.NewMsgLockExistingDelegation(
+					delAddr,
+					sdk.ValAddress{},
+					sdk.NewCoin(bondDenom, amount),
+					rate.Duration,
+					false,
+					types.ExpiryActionUndelegate,
+					"",
+				)
+			},
+			true,
+		},
+		{
+			"fail - bad delegator addr",
+			func(validator stakingtypes.Validator) types.MsgLockExistingDelegation {
+				return *types.NewMsgLockExistingDelegation(
+					sdk.AccAddress{},
+					validator.GetOperator(),
+					sdk.NewCoin(bondDenom, amount),
+					rate.Duration,
+					false,
+					types.ExpiryActionUndelegate,
+					"",
+				)
+			},
+			true,
+		},
+		{
+			"fail - denom not the bond denom",
+			func(validator stakingtypes.Validator) types.MsgLockExistingDelegation {
+				mintAndDelegate(suite, delAddr, validator)
+				return *types.NewMsgLockExistingDelegation(
+					delAddr,
+					validator.GetOperator(),
+					sdk.NewCoin("test", amount),
+					rate.Duration,
+					false,
+					types.ExpiryActionUndelegate,
+					"",
+				)
+			},
+			true,
+		},
+		{
+			"fail - rate not found",
+			func(validator stakingtypes.Validator) types.MsgLockExistingDelegation {
+				mintAndDelegate(suite, delAddr, validator)
+				return *types.NewMsgLockExistingDelegation(
+					delAddr,
+					validator.GetOperator(),
+					sdk.NewCoin(bondDenom, amount),
+					rate.Duration+1,
+					false,
+					types.ExpiryActionUndelegate,
+					"",
+				)
+			},
+			true,
+		},
+		{
+			"fail - validator not found",
+			func(validator stakingtypes.Validator) types.MsgLockExistingDelegation {
+				return *types.NewMsgLockExistingDelegation(
+					delAddr,
+					sdk.ValAddress([]byte("val1")),
+					sdk.NewCoin(bondDenom, amount),
+					rate.Duration,
+					false,
+					types.ExpiryActionUndelegate,
+					"",
+				)
+			},
+			true,
+		},
+		{
+			"fail - no delegation",
+			func(validator stakingtypes.Validator) types.MsgLockExistingDelegation {
+				return *types.NewMsgLockExistingDelegation(
+					delAddr,
+					validator.GetOperator(),
+					sdk.NewCoin(bondDenom, amount),
+					rate.Duration,
+					false,
+					types.ExpiryActionUndelegate,
+					"",
+				)
+			},
+			true,
+		},
+		{
+			"fail - amount bigger than the delegation",
+			func(validator stakingtypes.Validator) types.MsgLockExistingDelegation {
+				mintAndDelegate(suite, delAddr, validator)
+				return *types.NewMsgLockExistingDelegation(
+					delAddr,
+					validator.GetOperator(),
+					sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(2_000_000, PowerReduction)),
+					rate.Duration,
+					false,
+					types.ExpiryActionUndelegate,
+					"",
+				)
+			},
+			true,
+		},
+		{
+			"fail - delegation already locked",
+			func(validator stakingtypes.Validator) types.MsgLockExistingDelegation {
+				mintAndDelegate(suite, delAddr, validator)
+
+				// Lock the whole delegation
+				delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, validator.GetOperator())
+				suite.Require().True(found)
+				ld := types.NewLockedDelegation(delAddr, validator.GetOperator(), nil)
+				ld.AddEntry(types.NewLockedDelegationEntry(delegation.Shares, rate, suite.ctx.BlockTime(), false, 1))
+				err := suite.k.SetLockedDelegation(suite.ctx, ld)
+				suite.Require().NoError(err)
+
+				return *types.NewMsgLockExistingDelegation(
+					delAddr,
+					validator.GetOperator(),
+					sdk.NewCoin(bondDenom, amount),
+					rate.Duration,
+					false,
+					types.ExpiryActionUndelegate,
+					"",
+				)
+			},
+			true,
+		},
+		{
+			"pass",
+			func(validator stakingtypes.Validator) types.MsgLockExistingDelegation {
+				mintAndDelegate(suite, delAddr, validator)
+				return *types.NewMsgLockExistingDelegation(
+					delAddr,
+					validator.GetOperator(),
+					sdk.NewCoin(bondDenom, amount),
+					rate.Duration,
+					true,
+					types.ExpiryActionUndelegate,
+					"",
+				)
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.SetupTest() // Restart the whole app each time
+
+		validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+		valAddr := validator.GetOperator()
+
+		req := tc.maleate(validator)
+
+		// Store the delegation before the message
+		delegationBefore, _ := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, valAddr)
+
+		_, err := suite.msgSrvr.LockExistingDelegation(suite.ctx, &req)
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+
+			// The delegation must be unchanged
+			delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, valAddr)
+			suite.Require().True(found)
+			suite.Require().Equal(delegationBefore.Shares, delegation.Shares)
+
+			// The locked delegation must exists
+			lockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+			suite.Require().True(found)
+			suite.Require().Len(lockedDelegation.Entries, 1)
+			suite.Require().Equal(types.CalculateSharesFromValidator(amount, validator), lockedDelegation.Entries[0].Shares)
+			suite.Require().True(lockedDelegation.Entries[0].AutoRenew)
+
+			// The look up must point to the locked delegation
+			_, found = suite.k.GetLockedDelegationByEntryID(suite.ctx, lockedDelegation.Entries[0].Id)
+			suite.Require().True(found)
+
+			// It also must exist on the queue
+			queuePairs := suite.k.GetAllLockedDelegationQueuePairs(suite.ctx, bigTime)
+			suite.Require().Contains(queuePairs, types.LockedDelegationPair{
+				DelegatorAddress: delAddr.String(),
+				ValidatorAddress: valAddr.String(),
+			})
+		}
+	}
+}
+
 // TestUpdateParams tests the msg server UpdateParams
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	testCases := []struct {
@@ -565,6 +1146,225 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 	}
 }
 
+// TestEarlyUnlock tests the msg server EarlyUnlock
+func (suite *KeeperTestSuite) TestEarlyUnlock() {
+	delAddr := sdk.AccAddress([]byte("address1"))
+
+	testCases := []struct {
+		name        string
+		maleate     func(valAddr sdk.ValAddress) types.MsgEarlyUnlock
+		errContains string
+	}{
+		{
+			"fail - bad delegator addr",
+			func(valAddr sdk.ValAddress) types.MsgEarlyUnlock {
+				return *types.NewMsgEarlyUnlock(
+					sdk.AccAddress{},
+					valAddr,
+					[]uint64{1},
+				)
+			},
+			"empty address string is not allowed",
+		},
+		{
+			"fail - bad validator addr",
+			func(valAddr sdk.ValAddress) types.MsgEarlyUnlock {
+				return *types.NewMsgEarlyUnlock(
+					delAddr,
+					sdk.ValAddress{},
+					[]uint64{1},
+				)
+			},
+			"empty address string is not allowed",
+		},
+		{
+			"fail - not locked delegation",
+			func(valAddr sdk.ValAddress) types.MsgEarlyUnlock {
+				return *types.NewMsgEarlyUnlock(
+					delAddr,
+					valAddr,
+					[]uint64{1},
+				)
+			},
+			"locked delegation for delegator and validator addresses pair not found",
+		},
+		{
+			"fail - id not found",
+			func(valAddr sdk.ValAddress) types.MsgEarlyUnlock {
+				mintAndCreateLockeDelegations(suite, 3, delAddr, valAddr)
+
+				return *types.NewMsgEarlyUnlock(
+					delAddr,
+					valAddr,
+					[]uint64{1, 1234},
+				)
+			},
+			"locked delegation entry for specified id not found",
+		},
+		{
+			"pass - single entry, penalty burned",
+			func(valAddr sdk.ValAddress) types.MsgEarlyUnlock {
+				mintAndCreateLockeDelegations(suite, 3, delAddr, valAddr)
+
+				return *types.NewMsgEarlyUnlock(
+					delAddr,
+					valAddr,
+					[]uint64{2},
+				)
+			},
+			"",
+		},
+		{
+			"pass - all entries, penalty burned",
+			func(valAddr sdk.ValAddress) types.MsgEarlyUnlock {
+				mintAndCreateLockeDelegations(suite, 3, delAddr, valAddr)
+
+				return *types.NewMsgEarlyUnlock(
+					delAddr,
+					valAddr,
+					[]uint64{1, 2, 3, 3},
+				)
+			},
+			"",
+		},
+		{
+			"pass - penalty sent to the community pool",
+			func(valAddr sdk.ValAddress) types.MsgEarlyUnlock {
+				params := suite.k.GetParams(suite.ctx)
+				params.PenaltyDestination = types.PenaltyDestinationCommunityPool
+				err := suite.k.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+
+				mintAndCreateLockeDelegations(suite, 3, delAddr, valAddr)
+
+				return *types.NewMsgEarlyUnlock(
+					delAddr,
+					valAddr,
+					[]uint64{1, 3},
+				)
+			},
+			"",
+		},
+		{
+			"pass - zero penalty",
+			func(valAddr sdk.ValAddress) types.MsgEarlyUnlock {
+				params := suite.k.GetParams(suite.ctx)
+				for i := range params.Rates {
+					params.Rates[i].EarlyUnlockPenalty = math.LegacyZeroDec()
+				}
+				err := suite.k.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+
+				mintAndCreateLockeDelegations(suite, 3, delAddr, valAddr)
+
+				return *types.NewMsgEarlyUnlock(
+					delAddr,
+					valAddr,
+					[]uint64{1},
+				)
+			},
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		suite.SetupTest() // Restart the whole app each time
+
+		validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+		valAddr := validator.GetOperator()
+		bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+
+		req := tc.maleate(valAddr)
+
+		// Save the initial state before unlocking
+		initialLD, _ := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+		initialDelegation, _ := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, valAddr)
+		initialValidator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+		initialSupply := suite.app.BankKeeper.GetSupply(suite.ctx, bondDenom)
+		initialCommunityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+
+		res, err := suite.msgSrvr.EarlyUnlock(suite.ctx, &req)
+
+		if tc.errContains != "" {
+			suite.Require().ErrorContains(err, tc.errContains, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+
+		// Calculate the expected penalty from the unlocked entries
+		params := suite.k.GetParams(suite.ctx)
+		exists, unlockedEntries := initialLD.EntriesForIds(req.Ids)
+		suite.Require().True(exists, tc.name)
+		unlockedShares := math.LegacyZeroDec()
+		penaltyShares := math.LegacyZeroDec()
+		for _, entry := range unlockedEntries {
+			entryRate, found := params.GetRateFromDuration(entry.Rate.Duration)
+			suite.Require().True(found, tc.name)
+			unlockedShares = unlockedShares.Add(entry.Shares)
+			penaltyShares = penaltyShares.Add(entry.Shares.Mul(entryRate.EarlyUnlockPenalty))
+		}
+		expectedPenalty := initialValidator.TokensFromShares(penaltyShares).TruncateInt()
+		suite.Require().Equal(sdk.NewCoin(bondDenom, expectedPenalty), res.Penalty, tc.name)
+
+		// The event reports the delegator and the unlocked entries IDs
+		ids := make([]string, 0, len(unlockedEntries))
+		for _, entry := range unlockedEntries {
+			ids = append(ids, strconv.FormatUint(entry.Id, 10))
+		}
+		var unlockEvent sdk.Event
+		for _, event := range suite.ctx.EventManager().Events() {
+			if event.Type == types.EventTypeEarlyUnlock {
+				unlockEvent = event
+			}
+		}
+		delegatorAttr, found := unlockEvent.GetAttribute(types.AttributeKeyDelegator)
+		suite.Require().True(found, tc.name)
+		suite.Require().Equal(delAddr.String(), delegatorAttr.Value, tc.name)
+		idsAttr, found := unlockEvent.GetAttribute(types.AttributeKeyEntryIDs)
+		suite.Require().True(found, tc.name)
+		suite.Require().Equal(strings.Join(ids, ","), idsAttr.Value, tc.name)
+
+		// The unlocked entries must be removed from the locked delegation and look up
+		lockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+		suite.Require().Equal(len(initialLD.Entries)-len(unlockedEntries), len(lockedDelegation.Entries), tc.name)
+		suite.Require().Equal(len(lockedDelegation.Entries) != 0, found, tc.name)
+		for _, entry := range unlockedEntries {
+			_, found := suite.k.GetLockedDelegationByEntryID(suite.ctx, entry.Id)
+			suite.Require().False(found, tc.name)
+
+			pairs := suite.k.GetLockedDelegationQueueTimeSlice(suite.ctx, entry.UnlockOn)
+			suite.Require().Empty(pairs, tc.name)
+		}
+
+		// The delegation must be reduced by the unlocked shares
+		// a delegation with no shares left is removed
+		delegationShares := math.LegacyZeroDec()
+		if delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, valAddr); found {
+			delegationShares = delegation.Shares
+		}
+		suite.Require().True(initialDelegation.Shares.Sub(unlockedShares).Equal(delegationShares), tc.name)
+
+		// An unbonding delegation is created for the remaining shares
+		ubd, found := suite.app.StakingKeeper.GetUnbondingDelegation(suite.ctx, delAddr, valAddr)
+		suite.Require().True(found, tc.name)
+		suite.Require().Equal(res.CompletionTime, ubd.Entries[0].CompletionTime, tc.name)
+
+		// Check the penalty destination
+		supply := suite.app.BankKeeper.GetSupply(suite.ctx, bondDenom)
+		communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+		if params.PenaltyDestination == types.PenaltyDestinationCommunityPool {
+			suite.Require().Equal(initialSupply, supply, tc.name)
+			suite.Require().Equal(
+				initialCommunityPool.Add(sdk.NewDecCoinFromDec(bondDenom, math.LegacyNewDecFromInt(expectedPenalty))),
+				communityPool,
+				tc.name,
+			)
+		} else {
+			suite.Require().Equal(initialSupply.Sub(res.Penalty), supply, tc.name)
+			suite.Require().Equal(initialCommunityPool, communityPool, tc.name)
+		}
+	}
+}
+
 // mintAndCreateLockeDelegations mint new tokens and create new locked delegation
 func mintAndCreateLockeDelegations(suite *KeeperTestSuite, amountOfLD int64, delAddr sdk.AccAddress, srcValAddr sdk.ValAddress) {
 	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
@@ -587,6 +1387,8 @@ func mintAndCreateLockeDelegations(suite *KeeperTestSuite, amountOfLD int64, del
 				sdk.NewCoin(bondDenom, tokens.Quo(math.NewInt(x+5))),
 				rate.Duration,
 				false,
+				types.ExpiryActionUndelegate,
+				"",
 			),
 		)
 		suite.Require().NoError(err)
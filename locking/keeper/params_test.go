@@ -107,6 +107,7 @@ func (suite *KeeperTestSuite) TestSetParamsBadMarshal() {
 		suite.app.StakingKeeper,
 		suite.app.DistrKeeper,
 		suite.app.BankKeeper,
+		suite.app.SlashingKeeper,
 		authAddr,
 	)
 
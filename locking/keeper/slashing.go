@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// GetValidatorSlashEventsAtHeight returns the slash events of a validator at a height
+func (k Keeper) GetValidatorSlashEventsAtHeight(ctx sdk.Context, valAddr sdk.ValAddress, height int64) []types.ValidatorSlashEvent {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorSlashEventsKey(valAddr, height))
+	if bz == nil {
+		return []types.ValidatorSlashEvent{}
+	}
+
+	// We need the events struct to Unmarshal
+	events := types.ValidatorSlashEvents{}
+	k.cdc.MustUnmarshal(bz, &events)
+
+	return events.Events
+}
+
+// SetValidatorSlashEvent appends a slash event to the validator slash events at the event height
+func (k Keeper) SetValidatorSlashEvent(ctx sdk.Context, event types.ValidatorSlashEvent) error {
+	valAddr, err := sdk.ValAddressFromBech32(event.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	events := k.GetValidatorSlashEventsAtHeight(ctx, valAddr, event.Height)
+	events = append(events, event)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.ValidatorSlashEvents{Events: events})
+	store.Set(types.GetValidatorSlashEventsKey(valAddr, event.Height), bz)
+	return nil
+}
+
+// GetValidatorSlashEvents returns all the slash events of a validator ordered by height
+func (k Keeper) GetValidatorSlashEvents(ctx sdk.Context, valAddr sdk.ValAddress) (events []types.ValidatorSlashEvent) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorSlashEventsPerValidatorKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var eventsAtHeight types.ValidatorSlashEvents
+		k.cdc.MustUnmarshal(iterator.Value(), &eventsAtHeight)
+		events = append(events, eventsAtHeight.Events...)
+	}
+	return events
+}
+
+// DeleteValidatorSlashEvents removes all the slash events of a validator
+func (k Keeper) DeleteValidatorSlashEvents(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorSlashEventsPerValidatorKey(valAddr))
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	// The events are deleted after the iteration, so the store isn't written while iterated
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllValidatorSlashEvents returns all the slash events, used for testing and genesis dump
+func (k Keeper) GetAllValidatorSlashEvents(ctx sdk.Context) (events []types.ValidatorSlashEvent) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorSlashEventKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var eventsAtHeight types.ValidatorSlashEvents
+		k.cdc.MustUnmarshal(iterator.Value(), &eventsAtHeight)
+		events = append(events, eventsAtHeight.Events...)
+	}
+	return events
+}
+
+// RecordValidatorSlash stores a slash event with the validator exchange rate before and after the slash
+// If the double sign policy is active, the validator is also queued for the double sign check
+func (k Keeper) RecordValidatorSlash(ctx sdk.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+	// There's no exchange rate for a validator without shares
+	if validator.DelegatorShares.IsZero() {
+		return nil
+	}
+
+	tokensPerShareBefore := validator.TokensFromShares(math.LegacyOneDec())
+	tokensPerShareAfter := tokensPerShareBefore.Mul(math.LegacyOneDec().Sub(fraction))
+
+	err := k.SetValidatorSlashEvent(ctx, types.NewValidatorSlashEvent(
+		valAddr,
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+		fraction,
+		tokensPerShareBefore,
+		tokensPerShareAfter,
+	))
+	if err != nil {
+		return err
+	}
+
+	// The validator is only tombstoned after the slash, so the check happens at the end block
+	if k.GetParams(ctx).DoubleSignPolicy != types.DoubleSignPolicyKeep {
+		k.SetSlashedValidatorQueue(ctx, valAddr)
+	}
+	return nil
+}
+
+// SetSlashedValidatorQueue queues a slashed validator for the double sign check
+func (k Keeper) SetSlashedValidatorQueue(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSlashedValidatorQueueKey(valAddr), valAddr)
+}
+
+// DequeueSlashedValidators returns and removes all the validators waiting for the double sign check
+func (k Keeper) DequeueSlashedValidators(ctx sdk.Context) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SlashedValidatorQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(iterator.Value()))
+		store.Delete(iterator.Key())
+	}
+	return valAddrs
+}
+
+// GetAllSlashedValidators returns all the validators waiting for the double sign check, used for genesis dump
+func (k Keeper) GetAllSlashedValidators(ctx sdk.Context) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SlashedValidatorQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(iterator.Value()))
+	}
+	return valAddrs
+}
+
+// CompleteSlashedValidator applies the double sign policy to the locked delegations
+// of a validator if it was tombstoned
+func (k Keeper) CompleteSlashedValidator(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	// Only validators tombstoned for double signing are affected
+	if !k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return nil
+	}
+
+	// Collect the validator locked delegations before changing them
+	var lockedDelegations []types.LockedDelegation
+	k.IterateValidatorLockedDelegations(ctx, valAddr, func(lockedDelegation types.LockedDelegation) (stop bool) {
+		lockedDelegations = append(lockedDelegations, lockedDelegation)
+		return false
+	})
+
+	params := k.GetParams(ctx)
+	for _, lockedDelegation := range lockedDelegations {
+		switch params.DoubleSignPolicy {
+		case types.DoubleSignPolicyRelease:
+			err = k.releaseLockedDelegation(ctx, lockedDelegation)
+		case types.DoubleSignPolicyShorten:
+			shortestDuration, _ := params.ShortestRateDuration()
+			err = k.shortenLockedDelegation(ctx, lockedDelegation, ctx.BlockTime().Add(shortestDuration))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseLockedDelegation removes all the entries of a locked delegation, keeping the delegation
+func (k Keeper) releaseLockedDelegation(ctx sdk.Context, lockedDelegation types.LockedDelegation) error {
+	valAddr, err := lockedDelegation.GetValidatorAddr()
+	if err != nil {
+		return err
+	}
+
+	// Do a rewards withdraw before the locked shares are removed
+	_, err = k.distributionKeeper.WithdrawDelegationRewards(ctx, lockedDelegation.GetDelegatorAddr(), valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.removeLockedDelegation(ctx, lockedDelegation, types.UnlockReasonDoubleSign)
+}
+
+// shortenLockedDelegation caps the unlock time of the locked delegation entries
+// and disables their auto renew, so the shares are undelegated when they expire
+func (k Keeper) shortenLockedDelegation(ctx sdk.Context, lockedDelegation types.LockedDelegation, maxUnlockOn time.Time) error {
+	// The shares and rates are kept, so the entry weights don't change
+	// and the locking rewards don't need to be withdrawn nor checkpointed
+	var autoRenewDisabled []uint64
+	for i, entry := range lockedDelegation.Entries {
+		if entry.AutoRenew {
+			lockedDelegation.Entries[i].AutoRenew = false
+			autoRenewDisabled = append(autoRenewDisabled, entry.Id)
+		}
+		if !entry.UnlockOn.After(maxUnlockOn) {
+			continue
+		}
+
+		lockedDelegation.Entries[i].UnlockOn = maxUnlockOn
+	}
+
+	// Setting the locked delegation moves the shortened entries in the queue
+	if err := k.SetLockedDelegation(ctx, lockedDelegation); err != nil {
+		return err
+	}
+
+	for _, id := range autoRenewDisabled {
+		err := ctx.EventManager().EmitTypedEvent(&types.EventAutoRenewChanged{
+			DelegatorAddress: lockedDelegation.DelegatorAddress,
+			ValidatorAddress: lockedDelegation.ValidatorAddress,
+			EntryId:          id,
+			AutoRenew:        false,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CalculateEntrySlashes returns the token value of an entry before and after each of its validator slashes
+// The values are calculated using the current entry shares
+func (k Keeper) CalculateEntrySlashes(ctx sdk.Context, valAddr sdk.ValAddress, entry types.LockedDelegationEntry) []types.LockedDelegationEntrySlash {
+	events := k.GetValidatorSlashEvents(ctx, valAddr)
+
+	entrySlashes := make([]types.LockedDelegationEntrySlash, 0, len(events))
+	for _, event := range events {
+		entrySlashes = append(entrySlashes, types.LockedDelegationEntrySlash{
+			SlashEvent:   event,
+			TokensBefore: entry.Shares.Mul(event.TokensPerShareBefore),
+			TokensAfter:  entry.Shares.Mul(event.TokensPerShareAfter),
+		})
+	}
+	return entrySlashes
+}
@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// TestRecordValidatorSlash tests the slash event recording through the staking hooks
+func (suite *KeeperTestSuite) TestRecordValidatorSlash() {
+	suite.SetupTest()
+	delAddr := sdk.AccAddress([]byte("address1"))
+	fraction := math.LegacyNewDecWithPrec(1, 1)
+
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	mintAndCreateLockeDelegations(suite, 2, delAddr, valAddr)
+
+	// Slash the validator, the hook records the event
+	validator, _ = suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	tokensPerShareBefore := validator.TokensFromShares(math.LegacyOneDec())
+	slashValidator(suite, validator, fraction)
+
+	events := suite.k.GetValidatorSlashEvents(suite.ctx, valAddr)
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(valAddr.String(), events[0].ValidatorAddress)
+	suite.Require().Equal(suite.ctx.BlockHeight(), events[0].Height)
+	// The staking module uses an effective fraction based on the consensus power
+	suite.Require().True(events[0].Fraction.IsPositive())
+	suite.Require().True(events[0].Fraction.LTE(fraction))
+	suite.Require().Equal(tokensPerShareBefore, events[0].TokensPerShareBefore)
+	suite.Require().Equal(tokensPerShareBefore.Mul(math.LegacyOneDec().Sub(events[0].Fraction)), events[0].TokensPerShareAfter)
+	suite.Require().Equal(events, suite.k.GetAllValidatorSlashEvents(suite.ctx))
+
+	// With the keep policy nothing is queued
+	suite.Require().Empty(suite.k.DequeueSlashedValidators(suite.ctx))
+
+	// Query the entry value
+	ld, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	entry := ld.Entries[0]
+
+	res, err := suite.k.LockedDelegationEntrySlashes(suite.ctx, &types.QueryLockedDelegationEntrySlashesRequest{Id: entry.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(valAddr.String(), res.ValidatorAddress)
+	suite.Require().Equal(entry, res.Entry)
+	suite.Require().Len(res.Slashes, 1)
+	suite.Require().Equal(entry.Shares.Mul(tokensPerShareBefore), res.Slashes[0].TokensBefore)
+	suite.Require().Equal(entry.Shares.Mul(events[0].TokensPerShareAfter), res.Slashes[0].TokensAfter)
+	suite.Require().True(res.Tokens.LT(res.Slashes[0].TokensBefore))
+
+	// A missing entry fails
+	_, err = suite.k.LockedDelegationEntrySlashes(suite.ctx, &types.QueryLockedDelegationEntrySlashesRequest{Id: 1000})
+	suite.Require().ErrorIs(err, types.ErrLockedDelegationEntryNotFound)
+}
+
+// TestDeleteValidatorSlashEvents tests the slash events are removed with their validator
+func (suite *KeeperTestSuite) TestDeleteValidatorSlashEvents() {
+	valAddr := sdk.ValAddress([]byte("val1"))
+	otherValAddr := sdk.ValAddress([]byte("val2"))
+	fraction := math.LegacyNewDecWithPrec(1, 1)
+
+	// Store events at a few heights for both validators
+	for height := int64(1); height <= 3; height++ {
+		for _, addr := range []sdk.ValAddress{valAddr, otherValAddr} {
+			err := suite.k.SetValidatorSlashEvent(suite.ctx, types.NewValidatorSlashEvent(
+				addr, height, suite.ctx.BlockTime(), fraction, math.LegacyOneDec(), math.LegacyOneDec().Sub(fraction),
+			))
+			suite.Require().NoError(err)
+		}
+	}
+	suite.Require().Len(suite.k.GetValidatorSlashEvents(suite.ctx, valAddr), 3)
+
+	// Removing the validator through the staking hooks only deletes its events
+	err := suite.app.StakingKeeper.Hooks().AfterValidatorRemoved(suite.ctx, sdk.ConsAddress{}, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.k.GetValidatorSlashEvents(suite.ctx, valAddr))
+	suite.Require().Len(suite.k.GetValidatorSlashEvents(suite.ctx, otherValAddr), 3)
+	suite.Require().Len(suite.k.GetAllValidatorSlashEvents(suite.ctx), 3)
+}
+
+// TestCompleteSlashedValidator tests the double sign policies applied at the end block
+func (suite *KeeperTestSuite) TestCompleteSlashedValidator() {
+	delAddr := sdk.AccAddress([]byte("address1"))
+
+	testCases := []struct {
+		name       string
+		policy     types.DoubleSignPolicy
+		tombstoned bool
+		check      func(valAddr sdk.ValAddress, before types.LockedDelegation)
+	}{
+		{
+			"keep - locks untouched",
+			types.DoubleSignPolicyKeep,
+			true,
+			func(valAddr sdk.ValAddress, before types.LockedDelegation) {
+				ld, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+				suite.Require().True(found)
+				suite.Require().Equal(before.Entries, ld.Entries)
+			},
+		},
+		{
+			"release - not tombstoned",
+			types.DoubleSignPolicyRelease,
+			false,
+			func(valAddr sdk.ValAddress, before types.LockedDelegation) {
+				ld, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+				suite.Require().True(found)
+				suite.Require().Equal(before.Entries, ld.Entries)
+			},
+		},
+		{
+			"release - locks removed",
+			types.DoubleSignPolicyRelease,
+			true,
+			func(valAddr sdk.ValAddress, before types.LockedDelegation) {
+				_, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+				suite.Require().False(found)
+
+				// The delegation is kept
+				_, found = suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, valAddr)
+				suite.Require().True(found)
+
+				// The look up and the queue are cleaned
+				for _, entry := range before.Entries {
+					_, found = suite.k.GetLockedDelegationByEntryID(suite.ctx, entry.Id)
+					suite.Require().False(found)
+				}
+				suite.Require().Empty(suite.k.GetAllLockedDelegationQueuePairs(suite.ctx, bigTime))
+			},
+		},
+		{
+			"shorten - locks capped",
+			types.DoubleSignPolicyShorten,
+			true,
+			func(valAddr sdk.ValAddress, before types.LockedDelegation) {
+				ld, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+				suite.Require().True(found)
+				suite.Require().Len(ld.Entries, len(before.Entries))
+
+				shortestDuration, _ := suite.k.GetParams(suite.ctx).ShortestRateDuration()
+				maxUnlockOn := suite.ctx.BlockTime().Add(shortestDuration)
+				for i, entry := range ld.Entries {
+					suite.Require().Equal(before.Entries[i].Id, entry.Id)
+					suite.Require().Equal(before.Entries[i].Rate, entry.Rate)
+					suite.Require().False(entry.AutoRenew)
+					suite.Require().True(entry.UnlockOn.Equal(maxUnlockOn))
+
+					// The old queue slots are cleaned
+					suite.Require().Empty(suite.k.GetLockedDelegationQueueTimeSlice(suite.ctx, before.Entries[i].UnlockOn))
+				}
+				suite.Require().Contains(suite.k.GetLockedDelegationQueueTimeSlice(suite.ctx, maxUnlockOn), types.LockedDelegationPair{
+					DelegatorAddress: delAddr.String(),
+					ValidatorAddress: valAddr.String(),
+				})
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.SetupTest() // Restart the whole app each time
+
+		params := suite.k.GetParams(suite.ctx)
+		params.DoubleSignPolicy = tc.policy
+		err := suite.k.SetParams(suite.ctx, params)
+		suite.Require().NoError(err)
+
+		validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+		valAddr := validator.GetOperator()
+		mintAndCreateLockeDelegations(suite, 2, delAddr, valAddr)
+		before, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+		suite.Require().True(found)
+
+		// Slash and tombstone the validator like the evidence module does
+		validator, _ = suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+		slashValidator(suite, validator, math.LegacyNewDecWithPrec(5, 2))
+		if tc.tombstoned {
+			consAddr, err := validator.GetConsAddr()
+			suite.Require().NoError(err)
+			suite.app.SlashingKeeper.SetValidatorSigningInfo(suite.ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+				consAddr, 0, 0, time.Unix(0, 0), false, 0,
+			))
+			suite.app.SlashingKeeper.Tombstone(suite.ctx, consAddr)
+		}
+
+		suite.k.EndBlock(suite.ctx)
+
+		tc.check(valAddr, before)
+		// The slashed validators queue is always consumed
+		suite.Require().Empty(suite.k.DequeueSlashedValidators(suite.ctx), tc.name)
+	}
+}
+
+// slashValidator slashes a validator on the current height
+func slashValidator(suite *KeeperTestSuite, validator stakingtypes.Validator, fraction math.LegacyDec) {
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+
+	power := validator.GetConsensusPower(suite.app.StakingKeeper.PowerReduction(suite.ctx))
+	suite.app.StakingKeeper.Slash(suite.ctx, consAddr, suite.ctx.BlockHeight(), power, fraction)
+}
+
+// TestCompleteSlashedValidatorFailure tests that a double sign policy that fails doesn't halt the end block
+// The locked delegations of the validator are left untouched and the failure is emitted
+func (suite *KeeperTestSuite) TestCompleteSlashedValidatorFailure() {
+	delAddr := sdk.AccAddress([]byte("address1"))
+	noDelegationAddr := sdk.AccAddress([]byte("address2"))
+
+	params := suite.k.GetParams(suite.ctx)
+	params.DoubleSignPolicy = types.DoubleSignPolicyRelease
+	suite.Require().NoError(suite.k.SetParams(suite.ctx, params))
+
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	mintAndCreateLockeDelegations(suite, 2, delAddr, valAddr)
+	before, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+
+	// A locked delegation without delegation can't have its rewards withdrawn on release
+	entry := types.NewLockedDelegationEntry(math.LegacyNewDec(10), types.DefaultRates[0], suite.ctx.BlockTime().Add(types.DefaultRates[0].Duration), false, 100)
+	suite.Require().NoError(suite.k.SetLockedDelegation(suite.ctx, types.NewLockedDelegation(noDelegationAddr, valAddr, []types.LockedDelegationEntry{entry})))
+
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	suite.app.SlashingKeeper.SetValidatorSigningInfo(suite.ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+		consAddr, 0, 0, time.Unix(0, 0), false, 0,
+	))
+	suite.app.SlashingKeeper.Tombstone(suite.ctx, consAddr)
+	suite.k.SetSlashedValidatorQueue(suite.ctx, valAddr)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NotPanics(func() {
+		suite.k.EndBlock(suite.ctx)
+	})
+
+	// Nothing was released
+	ld, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(before.Entries, ld.Entries)
+	_, found = suite.k.GetLockedDelegation(suite.ctx, noDelegationAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Empty(suite.k.DequeueSlashedValidators(suite.ctx))
+
+	var failed []sdk.Event
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeValidatorPolicyFailed {
+			failed = append(failed, event)
+		}
+	}
+	suite.Require().Len(failed, 1)
+	suite.Require().Equal(types.AttributeKeyValidator, string(failed[0].Attributes[0].Key))
+	suite.Require().Equal(valAddr.String(), string(failed[0].Attributes[0].Value))
+	suite.Require().Equal(types.DoubleSignPolicyRelease.String(), string(failed[0].Attributes[1].Value))
+}
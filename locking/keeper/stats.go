@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// ErrStatsValidatorNotFound is the error of a validator with locked shares missing from the staking store
+const ErrStatsValidatorNotFound = "%w: validator %s has locked shares on the stats"
+
+// GetValidatorLockedShares returns the shares locked on a validator with a rate duration
+func (k Keeper) GetValidatorLockedShares(ctx sdk.Context, valAddr sdk.ValAddress, duration time.Duration) math.LegacyDec {
+	return k.getLockedShares(ctx, types.GetValidatorLockedSharesKey(valAddr, duration))
+}
+
+// GetTotalLockedShares returns the module wide shares locked with a rate duration
+func (k Keeper) GetTotalLockedShares(ctx sdk.Context, duration time.Duration) math.LegacyDec {
+	return k.getLockedShares(ctx, types.GetTotalLockedSharesKey(duration))
+}
+
+// IterateValidatorLockedShares iterates through the locked shares of all validators and rate durations
+func (k Keeper) IterateValidatorLockedShares(ctx sdk.Context, cb func(valAddr sdk.ValAddress, duration time.Duration, shares math.LegacyDec) (stop bool)) {
+	k.iterateLockedShares(ctx, types.ValidatorLockedSharesKey, func(key []byte, shares math.LegacyDec) bool {
+		// The stats keys are built by the keeper, so they are always valid
+		valAddr, duration, err := types.ParseValidatorLockedSharesKey(key)
+		if err != nil {
+			panic(err)
+		}
+		return cb(valAddr, duration, shares)
+	})
+}
+
+// IterateTotalLockedShares iterates through the module wide locked shares of all rate durations
+func (k Keeper) IterateTotalLockedShares(ctx sdk.Context, cb func(duration time.Duration, shares math.LegacyDec) (stop bool)) {
+	k.iterateLockedShares(ctx, types.TotalLockedSharesKey, func(key []byte, shares math.LegacyDec) bool {
+		duration, err := types.ParseTotalLockedSharesKey(key)
+		if err != nil {
+			panic(err)
+		}
+		return cb(duration, shares)
+	})
+}
+
+// GetLockingStats returns the module wide locked tokens and the totals of the requested validators
+// If no validator is informed, the totals of all the validators with locked shares are returned
+// Only shares are stored, tokens are derived with the current validators exchange rate
+func (k Keeper) GetLockingStats(ctx sdk.Context, valAddrs ...sdk.ValAddress) (total types.TotalLockingStats, validators []types.ValidatorLockingStats, err error) {
+	total = types.TotalLockingStats{Tokens: math.LegacyZeroDec(), Rates: []types.RateTotalLockingStats{}}
+
+	// When validators are requested only their stats are returned, in the requested order
+	var order []string
+	validatorsStats := make(map[string]*types.LockingStats)
+	for _, valAddr := range valAddrs {
+		stats := newLockingStats()
+		validatorsStats[valAddr.String()] = &stats
+		order = append(order, valAddr.String())
+	}
+
+	k.IterateValidatorLockedShares(ctx, func(valAddr sdk.ValAddress, duration time.Duration, shares math.LegacyDec) bool {
+		// Locks are removed with their validator, so the stats can't have a missing one
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			err = fmt.Errorf(ErrStatsValidatorNotFound, stakingtypes.ErrNoValidatorFound, valAddr)
+			return true
+		}
+		tokens := validator.TokensFromShares(shares)
+
+		// The module wide tokens depend on each validator exchange rate
+		total.Tokens = total.Tokens.Add(tokens)
+		total.Rates = addRateTotalLockingStats(total.Rates, duration, tokens)
+
+		stats, ok := validatorsStats[valAddr.String()]
+		if !ok {
+			if len(valAddrs) != 0 {
+				return false
+			}
+			newStats := newLockingStats()
+			stats = &newStats
+			validatorsStats[valAddr.String()] = stats
+			order = append(order, valAddr.String())
+		}
+		stats.Shares = stats.Shares.Add(shares)
+		stats.Tokens = stats.Tokens.Add(tokens)
+		stats.Rates = addRateLockingStats(stats.Rates, duration, shares, tokens)
+		return false
+	})
+	if err != nil {
+		return types.TotalLockingStats{}, nil, err
+	}
+	sort.Slice(total.Rates, func(i, j int) bool { return total.Rates[i].Duration < total.Rates[j].Duration })
+
+	for _, valAddr := range order {
+		validators = append(validators, types.ValidatorLockingStats{
+			ValidatorAddress: valAddr,
+			Stats:            *validatorsStats[valAddr],
+		})
+	}
+	return total, validators, nil
+}
+
+// updateLockingStats adds or removes the shares of the entries from the validator and module wide totals
+func (k Keeper) updateLockingStats(ctx sdk.Context, valAddr sdk.ValAddress, entries []types.LockedDelegationEntry, add bool) {
+	for _, entry := range entries {
+		shares := entry.Shares
+		if !add {
+			shares = shares.Neg()
+		}
+
+		validatorKey := types.GetValidatorLockedSharesKey(valAddr, entry.Rate.Duration)
+		k.setLockedShares(ctx, validatorKey, k.getLockedShares(ctx, validatorKey).Add(shares))
+
+		totalKey := types.GetTotalLockedSharesKey(entry.Rate.Duration)
+		k.setLockedShares(ctx, totalKey, k.getLockedShares(ctx, totalKey).Add(shares))
+	}
+}
+
+// getLockedShares returns the locked shares stored on a stats key
+func (k Keeper) getLockedShares(ctx sdk.Context, key []byte) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+
+	var shares sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &shares)
+	return shares.Dec
+}
+
+// setLockedShares sets the locked shares of a stats key, removing it when there's no shares left
+func (k Keeper) setLockedShares(ctx sdk.Context, key []byte, shares math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+
+	if shares.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&sdk.DecProto{Dec: shares}))
+}
+
+// iterateLockedShares iterates through the locked shares of a stats prefix
+// The keys are passed to the callback without the prefix
+func (k Keeper) iterateLockedShares(ctx sdk.Context, prefix []byte, cb func(key []byte, shares math.LegacyDec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var shares sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &shares)
+		if cb(iterator.Key()[len(prefix):], shares.Dec) {
+			break
+		}
+	}
+}
+
+// newLockingStats returns empty locking stats
+func newLockingStats() types.LockingStats {
+	return types.LockingStats{
+		Shares: math.LegacyZeroDec(),
+		Tokens: math.LegacyZeroDec(),
+		Rates:  []types.RateLockingStats{},
+	}
+}
+
+// addRateTotalLockingStats adds tokens to the module wide stats of a rate duration, creating it if needed
+func addRateTotalLockingStats(rates []types.RateTotalLockingStats, duration time.Duration, tokens math.LegacyDec) []types.RateTotalLockingStats {
+	for i := range rates {
+		if rates[i].Duration == duration {
+			rates[i].Tokens = rates[i].Tokens.Add(tokens)
+			return rates
+		}
+	}
+	return append(rates, types.RateTotalLockingStats{Duration: duration, Tokens: tokens})
+}
+
+// addRateLockingStats adds shares and tokens to the stats of a rate duration, creating it if needed
+func addRateLockingStats(rates []types.RateLockingStats, duration time.Duration, shares, tokens math.LegacyDec) []types.RateLockingStats {
+	for i := range rates {
+		if rates[i].Duration == duration {
+			rates[i].Shares = rates[i].Shares.Add(shares)
+			rates[i].Tokens = rates[i].Tokens.Add(tokens)
+			return rates
+		}
+	}
+	return append(rates, types.RateLockingStats{Duration: duration, Shares: shares, Tokens: tokens})
+}
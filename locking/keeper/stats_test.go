@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/aetherevm/locking/locking/keeper"
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// TestLockingStats tests the locking stats are kept updated with the locked delegations
+func (suite *KeeperTestSuite) TestLockingStats() {
+	rate := types.DefaultRates[0]
+	longRate := types.DefaultRates[1]
+	val1 := sdk.ValAddress([]byte("val1"))
+	val2 := sdk.ValAddress([]byte("val2"))
+
+	// Two delegators with three entries (1 + 2 + 3 shares) on each of the three validators
+	delAddresses := createMultipleLDsWithEntries(3, rate, suite)
+	suite.Require().Equal(math.LegacyNewDec(2*6), suite.k.GetValidatorLockedShares(suite.ctx, val1, rate.Duration))
+	suite.Require().Equal(math.LegacyNewDec(3*2*6), suite.k.GetTotalLockedShares(suite.ctx, rate.Duration))
+	suite.Require().True(suite.k.GetTotalLockedShares(suite.ctx, longRate.Duration).IsZero())
+
+	// Adding an entry with another rate only changes the new rate duration
+	_, err := suite.k.SetLockedDelegationEntry(suite.ctx, delAddresses[0], val1, types.NewLockedDelegationEntry(
+		math.LegacyNewDec(10), longRate, time.Now().UTC(), false, suite.k.IncrementLockedDelegationEntryID(suite.ctx),
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDec(2*6), suite.k.GetValidatorLockedShares(suite.ctx, val1, rate.Duration))
+	suite.Require().Equal(math.LegacyNewDec(10), suite.k.GetValidatorLockedShares(suite.ctx, val1, longRate.Duration))
+	suite.Require().Equal(math.LegacyNewDec(10), suite.k.GetTotalLockedShares(suite.ctx, longRate.Duration))
+
+	// The stats are only returned when the validators exist
+	_, _, err = suite.k.GetLockingStats(suite.ctx)
+	suite.Require().ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+	setStatsValidators(suite)
+
+	// The stats should be returned per validator and rate, the module wide totals only have tokens
+	total, validators, err := suite.k.GetLockingStats(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDec(2*6*(1+2+3)+10), total.Tokens)
+	suite.Require().Len(total.Rates, 2)
+	suite.Require().Equal(rate.Duration, total.Rates[0].Duration)
+	suite.Require().Equal(math.LegacyNewDec(2*6*(1+2+3)), total.Rates[0].Tokens)
+	suite.Require().Len(validators, 3)
+
+	// Requesting a validator only returns its stats, with the tokens from its exchange rate
+	_, validators, err = suite.k.GetLockingStats(suite.ctx, val2)
+	suite.Require().NoError(err)
+	suite.Require().Len(validators, 1)
+	suite.Require().Equal(val2.String(), validators[0].ValidatorAddress)
+	suite.Require().Equal(math.LegacyNewDec(2*6), validators[0].Stats.Shares)
+	suite.Require().Equal(math.LegacyNewDec(2*6*2), validators[0].Stats.Tokens)
+	suite.Require().Len(validators[0].Stats.Rates, 1)
+
+	// A slash changes the tokens but not the stored shares
+	validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, val2)
+	validator.Tokens = validator.Tokens.QuoRaw(2)
+	suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
+	_, validators, err = suite.k.GetLockingStats(suite.ctx, val2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDec(2*6), validators[0].Stats.Shares)
+	suite.Require().Equal(math.LegacyNewDec(2*6), validators[0].Stats.Tokens)
+
+	// Deleting a locked delegation removes all its entries from the stats
+	err = suite.k.DeleteLockedDelegation(suite.ctx, types.NewLockedDelegation(delAddresses[0], val1, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDec(6), suite.k.GetValidatorLockedShares(suite.ctx, val1, rate.Duration))
+	suite.Require().True(suite.k.GetValidatorLockedShares(suite.ctx, val1, longRate.Duration).IsZero())
+	suite.Require().True(suite.k.GetTotalLockedShares(suite.ctx, longRate.Duration).IsZero())
+	suite.Require().Equal(math.LegacyNewDec(2*6), suite.k.GetValidatorLockedShares(suite.ctx, val2, rate.Duration))
+
+	// The invariant should hold after all the changes
+	_, broken := keeper.LockingStatsInvariant(suite.k)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+// TestLockingStatsInvariant tests the locking stats invariant detects wrong stats
+func (suite *KeeperTestSuite) TestLockingStatsInvariant() {
+	rate := types.DefaultRates[0]
+	createMultipleLDsWithEntries(2, rate, suite)
+
+	_, broken := keeper.LockingStatsInvariant(suite.k)(suite.ctx)
+	suite.Require().False(broken)
+
+	// Changing the stored stats should break the invariant
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Set(
+		types.GetTotalLockedSharesKey(rate.Duration),
+		suite.app.AppCodec().MustMarshal(&sdk.DecProto{Dec: math.LegacyNewDec(1)}),
+	)
+	_, broken = keeper.LockingStatsInvariant(suite.k)(suite.ctx)
+	suite.Require().True(broken)
+
+	// Removing the stored stats should also break it
+	store.Delete(types.GetTotalLockedSharesKey(rate.Duration))
+	_, broken = keeper.LockingStatsInvariant(suite.k)(suite.ctx)
+	suite.Require().True(broken)
+}
+
+// setStatsValidators stores the validators used by createMultipleLDsWithEntries
+// Each validator has a different exchange rate, val1 has one token per share, val2 two and val3 three
+func setStatsValidators(suite *KeeperTestSuite) {
+	pks := simtestutil.CreateTestPubKeys(3)
+	for i := 0; i < 3; i++ {
+		valAddr := sdk.ValAddress([]byte(fmt.Sprintf("val%d", i+1)))
+		validator, err := stakingtypes.NewValidator(valAddr, pks[i], stakingtypes.Description{})
+		suite.Require().NoError(err)
+		validator.Tokens = math.NewInt(int64(1000 * (i + 1)))
+		validator.DelegatorShares = math.LegacyNewDec(1000)
+		suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
+	}
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// GetDelegatorLockingSummary returns the locking summary of a delegator on each validator delegated to and in total
+// The total ratios are the validators ratios weighted by the tokens, locked tokens for the weighted ratio
+// and delegated tokens for the effective multiplier
+func (k Keeper) GetDelegatorLockingSummary(ctx sdk.Context, delAddr sdk.AccAddress) (total types.LockingSummary, validators []types.ValidatorLockingSummary, err error) {
+	total = newLockingSummary()
+	lockedWeight := math.LegacyZeroDec()
+	delegatedWeight := math.LegacyZeroDec()
+
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		valAddr := del.GetValidatorAddr()
+
+		var summary types.LockingSummary
+		summary, err = k.getValidatorLockingSummary(ctx, delAddr, valAddr, del.GetShares())
+		if err != nil {
+			return true
+		}
+		validators = append(validators, types.ValidatorLockingSummary{
+			ValidatorAddress: valAddr.String(),
+			Summary:          summary,
+		})
+
+		total.DelegatedShares = total.DelegatedShares.Add(summary.DelegatedShares)
+		total.DelegatedTokens = total.DelegatedTokens.Add(summary.DelegatedTokens)
+		total.LockedShares = total.LockedShares.Add(summary.LockedShares)
+		total.LockedTokens = total.LockedTokens.Add(summary.LockedTokens)
+		total.FreeTokens = total.FreeTokens.Add(summary.FreeTokens)
+		total.DistributionReward = total.DistributionReward.Add(summary.DistributionReward...)
+		total.LockingReward = total.LockingReward.Add(summary.LockingReward...)
+		if summary.NextUnlock != nil && (total.NextUnlock == nil || summary.NextUnlock.Before(*total.NextUnlock)) {
+			total.NextUnlock = summary.NextUnlock
+		}
+
+		lockedWeight = lockedWeight.Add(summary.LockedTokens.Mul(summary.WeightedRatio))
+		delegatedWeight = delegatedWeight.Add(summary.DelegatedTokens.Mul(summary.EffectiveMultiplier.Sub(math.LegacyOneDec())))
+		return false
+	})
+	if err != nil {
+		return types.LockingSummary{}, nil, err
+	}
+
+	// We don't want to divide by zero
+	if !total.LockedTokens.IsZero() {
+		total.WeightedRatio = lockedWeight.Quo(total.LockedTokens)
+	}
+	if !total.DelegatedTokens.IsZero() {
+		total.EffectiveMultiplier = total.EffectiveMultiplier.Add(delegatedWeight.Quo(total.DelegatedTokens))
+	}
+	return total, validators, nil
+}
+
+// getValidatorLockingSummary returns the locking summary of a delegation
+// Tokens are calculated with the current validator exchange rate and the rewards are estimated without changing the state
+func (k Keeper) getValidatorLockingSummary(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, delegatedShares math.LegacyDec,
+) (types.LockingSummary, error) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.LockingSummary{}, sdkerrors.Wrap(types.ErrNoValidatorExists, valAddr.String())
+	}
+
+	// Without a locked delegation nothing is locked
+	lockedDelegation, _ := k.GetLockedDelegation(ctx, delAddr, valAddr)
+	lockedShares := lockedDelegation.TotalShares()
+
+	// The free shares can't go below zero
+	freeShares := delegatedShares.Sub(lockedShares)
+	if freeShares.IsNegative() {
+		freeShares = math.LegacyZeroDec()
+	}
+
+	reward, err := k.EstimateLockedRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return types.LockingSummary{}, err
+	}
+
+	summary := types.LockingSummary{
+		DelegatedShares:     delegatedShares,
+		DelegatedTokens:     validator.TokensFromShares(delegatedShares),
+		LockedShares:        lockedShares,
+		LockedTokens:        validator.TokensFromShares(lockedShares),
+		FreeTokens:          validator.TokensFromShares(freeShares),
+		WeightedRatio:       lockedDelegation.WeightedRatio(),
+		EffectiveMultiplier: math.LegacyOneDec().Add(lockedDelegation.CalculateDelegationRatio(delegatedShares)),
+		DistributionReward:  reward.DistributionReward,
+		LockingReward:       reward.LockingReward,
+	}
+	for _, entry := range lockedDelegation.Entries {
+		if summary.NextUnlock == nil || entry.UnlockOn.Before(*summary.NextUnlock) {
+			unlockOn := entry.UnlockOn
+			summary.NextUnlock = &unlockOn
+		}
+	}
+	return summary, nil
+}
+
+// newLockingSummary returns a locking summary with no amounts
+func newLockingSummary() types.LockingSummary {
+	return types.LockingSummary{
+		DelegatedShares:     math.LegacyZeroDec(),
+		DelegatedTokens:     math.LegacyZeroDec(),
+		LockedShares:        math.LegacyZeroDec(),
+		LockedTokens:        math.LegacyZeroDec(),
+		FreeTokens:          math.LegacyZeroDec(),
+		WeightedRatio:       math.LegacyZeroDec(),
+		EffectiveMultiplier: math.LegacyOneDec(),
+		DistributionReward:  sdk.DecCoins{},
+		LockingReward:       sdk.DecCoins{},
+	}
+}
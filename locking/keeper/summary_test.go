@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// TestDelegatorLockingSummary tests the delegator locking summary per validator and in total
+func (suite *KeeperTestSuite) TestDelegatorLockingSummary() {
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	delAddr := sdk.AccAddress([]byte("address1"))
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+
+	// Invalid requests
+	_, err := suite.k.DelegatorLockingSummary(suite.ctx, nil)
+	suite.Require().Error(err)
+	_, err = suite.k.DelegatorLockingSummary(suite.ctx, &types.QueryDelegatorLockingSummaryRequest{})
+	suite.Require().Error(err)
+	_, err = suite.k.DelegatorLockingSummary(suite.ctx, &types.QueryDelegatorLockingSummaryRequest{DelegatorAddress: "test"})
+	suite.Require().Error(err)
+
+	// Without delegations the summary is empty
+	res, err := suite.k.DelegatorLockingSummary(suite.ctx, &types.QueryDelegatorLockingSummaryRequest{DelegatorAddress: delAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Validators)
+	suite.Require().True(res.Total.DelegatedTokens.IsZero())
+	suite.Require().Equal(math.LegacyOneDec(), res.Total.EffectiveMultiplier)
+	suite.Require().Nil(res.Total.NextUnlock)
+
+	// Lock two entries with different rates and keep a free delegation
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(
+		suite.ctx,
+		types.ModuleName,
+		delAddr,
+		sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(3_000_000))),
+	)
+	suite.Require().NoError(err)
+	var entries []types.LockedDelegationEntry
+	for _, rate := range []types.Rate{types.DefaultRates[0], types.DefaultRates[3]} {
+		entry, err := suite.k.CreateLockedDelegationEntryAndDelegate(
+			suite.ctx, delAddr, valAddr, sdk.NewInt(1_000_000), rate.Duration, false, types.ExpiryActionUndelegate, "",
+		)
+		suite.Require().NoError(err)
+		entries = append(entries, entry)
+	}
+	validator, found := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.Require().True(found)
+	_, err = suite.app.StakingKeeper.Delegate(suite.ctx, delAddr, sdk.NewInt(1_000_000), stakingtypes.Unbonded, validator, true)
+	suite.Require().NoError(err)
+
+	// Allocate rewards to the validator and move a block forward
+	initial := sdk.TokensFromConsensusPower(10, PowerReduction)
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, sdk.DecCoins{sdk.NewDecCoin(bondDenom, initial)})
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+
+	res, err = suite.k.DelegatorLockingSummary(suite.ctx, &types.QueryDelegatorLockingSummaryRequest{DelegatorAddress: delAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Validators, 1)
+	suite.Require().Equal(valAddr.String(), res.Validators[0].ValidatorAddress)
+
+	validator, found = suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.Require().True(found)
+	delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	lockedDelegation, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	reward, err := suite.k.EstimateLockedRewards(suite.ctx, delAddr, valAddr)
+	suite.Require().NoError(err)
+
+	summary := res.Validators[0].Summary
+	suite.Require().Equal(delegation.Shares, summary.DelegatedShares)
+	suite.Require().Equal(validator.TokensFromShares(delegation.Shares), summary.DelegatedTokens)
+	suite.Require().Equal(lockedDelegation.TotalShares(), summary.LockedShares)
+	suite.Require().Equal(validator.TokensFromShares(lockedDelegation.TotalShares()), summary.LockedTokens)
+	suite.Require().Equal(validator.TokensFromShares(delegation.Shares.Sub(lockedDelegation.TotalShares())), summary.FreeTokens)
+	suite.Require().Equal(lockedDelegation.WeightedRatio(), summary.WeightedRatio)
+	suite.Require().Equal(math.LegacyOneDec().Add(lockedDelegation.CalculateDelegationRatio(delegation.Shares)), summary.EffectiveMultiplier)
+	suite.Require().True(summary.EffectiveMultiplier.GT(math.LegacyOneDec()))
+	suite.Require().Equal(reward.DistributionReward, summary.DistributionReward)
+	suite.Require().Equal(reward.LockingReward, summary.LockingReward)
+	suite.Require().NotNil(summary.NextUnlock)
+	suite.Require().True(entries[0].UnlockOn.Equal(*summary.NextUnlock))
+
+	// With a single validator the total matches it
+	suite.Require().Equal(summary.DelegatedTokens, res.Total.DelegatedTokens)
+	suite.Require().Equal(summary.LockedTokens, res.Total.LockedTokens)
+	suite.Require().Equal(summary.FreeTokens, res.Total.FreeTokens)
+	suite.Require().Equal(summary.DistributionReward, res.Total.DistributionReward)
+	suite.Require().Equal(summary.LockingReward, res.Total.LockingReward)
+	suite.Require().True(summary.WeightedRatio.Sub(res.Total.WeightedRatio).Abs().LTE(math.LegacyNewDecWithPrec(1, 15)))
+	suite.Require().True(summary.EffectiveMultiplier.Sub(res.Total.EffectiveMultiplier).Abs().LTE(math.LegacyNewDecWithPrec(1, 15)))
+	suite.Require().True(summary.NextUnlock.Equal(*res.Total.NextUnlock))
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// SetExitedValidatorQueue queues a validator that left the active set or was removed
+func (k Keeper) SetExitedValidatorQueue(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetExitedValidatorQueueKey(valAddr), valAddr)
+}
+
+// DequeueExitedValidators returns and removes all the validators waiting for the exit check
+func (k Keeper) DequeueExitedValidators(ctx sdk.Context) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ExitedValidatorQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(iterator.Value()))
+		store.Delete(iterator.Key())
+	}
+	return valAddrs
+}
+
+// GetAllExitedValidators returns all the validators waiting for the exit check, used for genesis dump
+func (k Keeper) GetAllExitedValidators(ctx sdk.Context) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ExitedValidatorQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(iterator.Value()))
+	}
+	return valAddrs
+}
+
+// SetFreeRedelegationValidator allows the locks on a validator to be redelegated without the max entries limit
+func (k Keeper) SetFreeRedelegationValidator(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFreeRedelegationValidatorKey(valAddr), valAddr)
+}
+
+// HasFreeRedelegationValidator returns if the locks on a validator can be redelegated without the max entries limit
+func (k Keeper) HasFreeRedelegationValidator(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetFreeRedelegationValidatorKey(valAddr))
+}
+
+// GetAllFreeRedelegationValidators returns all the validators with locks that can be redelegated freely,
+// used for genesis dump
+func (k Keeper) GetAllFreeRedelegationValidators(ctx sdk.Context) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.FreeRedelegationValidatorKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(iterator.Value()))
+	}
+	return valAddrs
+}
+
+// DeleteFreeRedelegationValidator removes the free redelegation mark of a validator
+func (k Keeper) DeleteFreeRedelegationValidator(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFreeRedelegationValidatorKey(valAddr))
+}
+
+// CompleteExitedValidator applies the validator exit policy to the locked delegations
+// of a validator if it was removed or tombstoned
+func (k Keeper) CompleteExitedValidator(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	// Validators that only left the active set are not affected
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if found {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		if !k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+			return nil
+		}
+	}
+
+	// Collect the validator locked delegations before changing them
+	var lockedDelegations []types.LockedDelegation
+	k.IterateValidatorLockedDelegations(ctx, valAddr, func(lockedDelegation types.LockedDelegation) (stop bool) {
+		lockedDelegations = append(lockedDelegations, lockedDelegation)
+		return false
+	})
+	if len(lockedDelegations) == 0 {
+		return nil
+	}
+
+	switch k.GetParams(ctx).ValidatorExitPolicy {
+	case types.ValidatorExitPolicyForceUnlock:
+		for _, lockedDelegation := range lockedDelegations {
+			err := k.forceUnlockLockedDelegation(ctx, lockedDelegation)
+			if err != nil {
+				return err
+			}
+		}
+	case types.ValidatorExitPolicyFreeRedelegate:
+		k.SetFreeRedelegationValidator(ctx, valAddr)
+	}
+	return nil
+}
+
+// forceUnlockLockedDelegation removes all the entries of a locked delegation and undelegates the locked shares
+func (k Keeper) forceUnlockLockedDelegation(ctx sdk.Context, lockedDelegation types.LockedDelegation) error {
+	delAddr := lockedDelegation.GetDelegatorAddr()
+	valAddr, err := lockedDelegation.GetValidatorAddr()
+	if err != nil {
+		return err
+	}
+
+	// A removed validator has no delegations left, so only the store is cleaned
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return k.removeLockedDelegation(ctx, lockedDelegation, types.UnlockReasonValidatorExit)
+	}
+
+	// Do a rewards withdraw before the locked shares are removed
+	_, err = k.distributionKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	err = k.removeLockedDelegation(ctx, lockedDelegation, types.UnlockReasonValidatorExit)
+	if err != nil {
+		return err
+	}
+
+	// Undelegate the locked shares
+	// We want to undelegate as the last action to avoid conflicts with the hooks
+	totalUndelegate := lockedDelegation.TotalShares()
+	if delegation.Shares.LT(totalUndelegate) {
+		totalUndelegate = delegation.Shares
+	}
+	_, err = k.stakingKeeper.Undelegate(ctx, delAddr, valAddr, totalUndelegate)
+	return err
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"time"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// TestCompleteExitedValidator tests the validator exit policies applied at the end block
+func (suite *KeeperTestSuite) TestCompleteExitedValidator() {
+	delAddr := sdk.AccAddress([]byte("address1"))
+
+	testCases := []struct {
+		name       string
+		policy     types.ValidatorExitPolicy
+		tombstoned bool
+		check      func(valAddr sdk.ValAddress, before types.LockedDelegation)
+	}{
+		{
+			"none - locks untouched",
+			types.ValidatorExitPolicyNone,
+			true,
+			func(valAddr sdk.ValAddress, before types.LockedDelegation) {
+				ld, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+				suite.Require().True(found)
+				suite.Require().Equal(before.Entries, ld.Entries)
+				suite.Require().False(suite.k.HasFreeRedelegationValidator(suite.ctx, valAddr))
+			},
+		},
+		{
+			"force unlock - validator only left the active set",
+			types.ValidatorExitPolicyForceUnlock,
+			false,
+			func(valAddr sdk.ValAddress, before types.LockedDelegation) {
+				ld, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+				suite.Require().True(found)
+				suite.Require().Equal(before.Entries, ld.Entries)
+			},
+		},
+		{
+			"force unlock - locks removed and undelegated",
+			types.ValidatorExitPolicyForceUnlock,
+			true,
+			func(valAddr sdk.ValAddress, before types.LockedDelegation) {
+				_, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+				suite.Require().False(found)
+
+				// The look up and the queue are cleaned
+				for _, entry := range before.Entries {
+					_, found = suite.k.GetLockedDelegationByEntryID(suite.ctx, entry.Id)
+					suite.Require().False(found)
+				}
+				suite.Require().Empty(suite.k.GetAllLockedDelegationQueuePairs(suite.ctx, bigTime))
+
+				// The whole delegation was locked, so it's fully undelegated
+				_, found = suite.app.StakingKeeper.GetDelegation(suite.ctx, delAddr, valAddr)
+				suite.Require().False(found)
+				_, found = suite.app.StakingKeeper.GetUnbondingDelegation(suite.ctx, delAddr, valAddr)
+				suite.Require().True(found)
+			},
+		},
+		{
+			"free redelegate - locks redelegated without max entries",
+			types.ValidatorExitPolicyFreeRedelegate,
+			true,
+			func(valAddr sdk.ValAddress, before types.LockedDelegation) {
+				suite.Require().True(suite.k.HasFreeRedelegationValidator(suite.ctx, valAddr))
+
+				// Reduce the max entries bellow the entries count
+				params := suite.k.GetParams(suite.ctx)
+				params.MaxEntries = 1
+				err := suite.k.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+
+				// Create a target validator
+				pks := simtestutil.CreateTestPubKeys(1)
+				dstValAddr := sdk.ValAddress([]byte("val2"))
+				dstValidator, err := stakingtypes.NewValidator(dstValAddr, pks[0], stakingtypes.Description{Moniker: "val2"})
+				suite.Require().NoError(err)
+				dstValidator.Status = stakingtypes.Bonded
+				suite.app.StakingKeeper.SetValidator(suite.ctx, dstValidator)
+				err = suite.app.StakingKeeper.Hooks().AfterValidatorCreated(suite.ctx, dstValAddr)
+				suite.Require().NoError(err)
+
+				ids := make([]uint64, 0, len(before.Entries))
+				for _, entry := range before.Entries {
+					ids = append(ids, entry.Id)
+				}
+				_, err = suite.msgSrvr.RedelegateLockedDelegations(suite.ctx, types.NewMsgRedelegateLockedDelegations(
+					delAddr, valAddr, dstValAddr, ids,
+				))
+				suite.Require().NoError(err)
+
+				ld, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, dstValAddr)
+				suite.Require().True(found)
+				suite.Require().Len(ld.Entries, len(before.Entries))
+
+				// Bonding again removes the free redelegation
+				err = suite.k.StakingHooks().AfterValidatorBonded(suite.ctx, sdk.ConsAddress{}, valAddr)
+				suite.Require().NoError(err)
+				suite.Require().False(suite.k.HasFreeRedelegationValidator(suite.ctx, valAddr))
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.SetupTest() // Restart the whole app each time
+
+		params := suite.k.GetParams(suite.ctx)
+		params.ValidatorExitPolicy = tc.policy
+		err := suite.k.SetParams(suite.ctx, params)
+		suite.Require().NoError(err)
+
+		validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+		valAddr := validator.GetOperator()
+		mintAndCreateLockeDelegations(suite, 3, delAddr, valAddr)
+		before, found := suite.k.GetLockedDelegation(suite.ctx, delAddr, valAddr)
+		suite.Require().True(found)
+
+		consAddr, err := validator.GetConsAddr()
+		suite.Require().NoError(err)
+		if tc.tombstoned {
+			suite.app.SlashingKeeper.SetValidatorSigningInfo(suite.ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+				consAddr, 0, 0, time.Unix(0, 0), false, 0,
+			))
+			suite.app.SlashingKeeper.Tombstone(suite.ctx, consAddr)
+		}
+
+		// The validator leaves the active set
+		err = suite.k.StakingHooks().AfterValidatorBeginUnbonding(suite.ctx, consAddr, valAddr)
+		suite.Require().NoError(err)
+
+		suite.k.EndBlock(suite.ctx)
+
+		// The exited validators queue is always consumed
+		suite.Require().Empty(suite.k.DequeueExitedValidators(suite.ctx), tc.name)
+		tc.check(valAddr, before)
+	}
+}
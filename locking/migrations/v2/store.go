@@ -0,0 +1,83 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2
+// The migration includes:
+// - Setting a zero early unlock penalty for the params rates
+// - Setting a zero early unlock penalty for the stored locked delegation entries rates
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateParams(store, cdc); err != nil {
+		return err
+	}
+	return migrateLockedDelegations(store, cdc)
+}
+
+// migrateParams sets the missing early unlock penalties and the default penalty destination on the params
+func migrateParams(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	bz := store.Get(types.ParamsKey)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+	for i := range params.Rates {
+		params.Rates[i] = migrateRate(params.Rates[i])
+	}
+	params.PenaltyDestination = types.DefaultPenaltyDestination
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+// migrateLockedDelegations sets the missing early unlock penalties on all the locked delegation entries
+func migrateLockedDelegations(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iterator := sdk.KVStorePrefixIterator(store, types.LockedDelegationKey)
+	keys := [][]byte{}
+	lockedDelegations := []types.LockedDelegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		var lockedDelegation types.LockedDelegation
+		if err := cdc.Unmarshal(iterator.Value(), &lockedDelegation); err != nil {
+			iterator.Close()
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		lockedDelegations = append(lockedDelegations, lockedDelegation)
+	}
+	iterator.Close()
+
+	// The locked delegations are updated after the iteration, so the store isn't written while iterated
+	for i, lockedDelegation := range lockedDelegations {
+		for j := range lockedDelegation.Entries {
+			lockedDelegation.Entries[j].Rate = migrateRate(lockedDelegation.Entries[j].Rate)
+		}
+
+		bz, err := cdc.Marshal(&lockedDelegation)
+		if err != nil {
+			return err
+		}
+		store.Set(keys[i], bz)
+	}
+	return nil
+}
+
+// migrateRate sets a zero early unlock penalty if the rate has none
+func migrateRate(rate types.Rate) types.Rate {
+	rate.EarlyUnlockPenalty = rate.GetEarlyUnlockPenalty()
+	return rate
+}
@@ -0,0 +1,73 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/aetherevm/locking/locking/migrations/v2"
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// TestMigrateStore tests the v1 to v2 store migration
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// Rates stored before the migration have no early unlock penalty
+	v1Rate := types.Rate{Duration: time.Hour, Rate: math.LegacyOneDec()}
+	params := types.Params{MaxEntries: types.DefaultMaxEntries, Rates: []types.Rate{v1Rate}}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	delAddr := sdk.AccAddress([]byte("address1"))
+	valAddr := sdk.ValAddress([]byte("val1"))
+	lockedDelegation := types.NewLockedDelegation(delAddr, valAddr, []types.LockedDelegationEntry{
+		types.NewLockedDelegationEntry(math.LegacyOneDec(), v1Rate, time.Unix(100, 0).UTC(), false, 1),
+		types.NewLockedDelegationEntry(math.LegacyOneDec(), v1Rate, time.Unix(200, 0).UTC(), true, 2),
+	})
+	key := types.GetLockedDelegationKey(delAddr, valAddr)
+	store.Set(key, cdc.MustMarshal(&lockedDelegation))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+
+	// Check the params
+	var migratedParams types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &migratedParams)
+	require.NoError(t, migratedParams.Validate())
+	expectedParams := types.NewParams(types.DefaultMaxEntries, []types.Rate{
+		types.NewRate(time.Hour, math.LegacyOneDec()),
+	})
+	// Params added after v2 keep their zero value
+	expectedParams.HybridEpochDuration = 0
+	expectedParams.BudgetWindow = 0
+	expectedParams.MaxExpiredPairsPerBlock = 0
+	require.Equal(t, expectedParams, migratedParams)
+
+	// Check the locked delegation entries
+	var migratedLockedDelegation types.LockedDelegation
+	cdc.MustUnmarshal(store.Get(key), &migratedLockedDelegation)
+	require.Len(t, migratedLockedDelegation.Entries, 2)
+	for _, entry := range migratedLockedDelegation.Entries {
+		require.False(t, entry.Rate.EarlyUnlockPenalty.IsNil())
+		require.True(t, entry.Rate.EarlyUnlockPenalty.IsZero())
+	}
+}
+
+// TestMigrateStoreEmpty tests the v1 to v2 store migration with no state
+func TestMigrateStoreEmpty(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+	require.Nil(t, ctx.KVStore(storeKey).Get(types.ParamsKey))
+}
@@ -0,0 +1,42 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3
+// The migration includes:
+// - Populating the locked delegations validator index
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	return migrateValidatorIndex(store, cdc)
+}
+
+// migrateValidatorIndex sets the validator index for all the stored locked delegations
+func migrateValidatorIndex(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iterator := sdk.KVStorePrefixIterator(store, types.LockedDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var lockedDelegation types.LockedDelegation
+		if err := cdc.Unmarshal(iterator.Value(), &lockedDelegation); err != nil {
+			return err
+		}
+
+		delAddr, err := sdk.AccAddressFromBech32(lockedDelegation.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		valAddr, err := lockedDelegation.GetValidatorAddr()
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetLockedDelegationByValidatorIndexKey(delAddr, valAddr), []byte{})
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package v4
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4
+// The migration includes:
+// - Populating the locking stats from the stored locked delegations
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	return migrateLockingStats(store, cdc)
+}
+
+// migrateLockingStats sums the locked delegation entries shares per validator and rate duration
+func migrateLockingStats(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iterator := sdk.KVStorePrefixIterator(store, types.LockedDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var lockedDelegation types.LockedDelegation
+		if err := cdc.Unmarshal(iterator.Value(), &lockedDelegation); err != nil {
+			return err
+		}
+
+		valAddr, err := lockedDelegation.GetValidatorAddr()
+		if err != nil {
+			return err
+		}
+		for _, entry := range lockedDelegation.Entries {
+			addLockedShares(store, cdc, types.GetValidatorLockedSharesKey(valAddr, entry.Rate.Duration), entry.Shares)
+			addLockedShares(store, cdc, types.GetTotalLockedSharesKey(entry.Rate.Duration), entry.Shares)
+		}
+	}
+	return nil
+}
+
+// addLockedShares adds shares to a stats key
+func addLockedShares(store sdk.KVStore, cdc codec.BinaryCodec, key []byte, shares math.LegacyDec) {
+	stored := sdk.DecProto{Dec: math.LegacyZeroDec()}
+	if bz := store.Get(key); bz != nil {
+		cdc.MustUnmarshal(bz, &stored)
+	}
+	stored.Dec = stored.Dec.Add(shares)
+	store.Set(key, cdc.MustMarshal(&stored))
+}
@@ -0,0 +1,64 @@
+package v4_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	v4 "github.com/aetherevm/locking/locking/migrations/v4"
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// TestMigrateStore tests the v3 to v4 store migration
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	rate := types.NewRate(time.Hour, math.LegacyOneDec())
+	longRate := types.NewRate(2*time.Hour, math.LegacyOneDec())
+	valAddrs := []sdk.ValAddress{sdk.ValAddress([]byte("val1")), sdk.ValAddress([]byte("val2"))}
+	delAddr := sdk.AccAddress([]byte("address1"))
+	for _, valAddr := range valAddrs {
+		lockedDelegation := types.NewLockedDelegation(delAddr, valAddr, []types.LockedDelegationEntry{
+			types.NewLockedDelegationEntry(math.LegacyNewDec(1), rate, time.Unix(100, 0).UTC(), false, 1),
+			types.NewLockedDelegationEntry(math.LegacyNewDec(2), rate, time.Unix(200, 0).UTC(), false, 2),
+			types.NewLockedDelegationEntry(math.LegacyNewDec(5), longRate, time.Unix(300, 0).UTC(), false, 3),
+		})
+		store.Set(types.GetLockedDelegationKey(delAddr, valAddr), cdc.MustMarshal(&lockedDelegation))
+	}
+
+	require.NoError(t, v4.MigrateStore(ctx, storeKey, cdc))
+
+	getShares := func(key []byte) math.LegacyDec {
+		var shares sdk.DecProto
+		cdc.MustUnmarshal(store.Get(key), &shares)
+		return shares.Dec
+	}
+
+	// Check the stats per validator and module wide
+	for _, valAddr := range valAddrs {
+		require.Equal(t, math.LegacyNewDec(3), getShares(types.GetValidatorLockedSharesKey(valAddr, rate.Duration)))
+		require.Equal(t, math.LegacyNewDec(5), getShares(types.GetValidatorLockedSharesKey(valAddr, longRate.Duration)))
+	}
+	require.Equal(t, math.LegacyNewDec(6), getShares(types.GetTotalLockedSharesKey(rate.Duration)))
+	require.Equal(t, math.LegacyNewDec(10), getShares(types.GetTotalLockedSharesKey(longRate.Duration)))
+}
+
+// TestMigrateStoreEmpty tests the v3 to v4 store migration with no state
+func TestMigrateStoreEmpty(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	require.NoError(t, v4.MigrateStore(ctx, storeKey, cdc))
+	require.Nil(t, ctx.KVStore(storeKey).Get(types.GetTotalLockedSharesKey(time.Hour)))
+}
@@ -0,0 +1,66 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// PendingExpiredPairsKey is the v4 key for the expired pairs carried over to the next blocks
+// The pairs that don't fit on a block now stay on the queue, so the key is removed
+var PendingExpiredPairsKey = []byte{0x24}
+
+// MigrateStore performs in-place store migrations from v4 to v5
+// The migration includes:
+// - Replacing the locked delegations queue time slices by one queue key per entry
+// - Removing the pending expired pairs, their entries are queued again with all the other entries
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	deleteTimeSlices(store)
+	store.Delete(PendingExpiredPairsKey)
+
+	return migrateQueueEntries(store, cdc)
+}
+
+// deleteTimeSlices removes all the v4 queue time slices
+func deleteTimeSlices(store sdk.KVStore) {
+	iterator := sdk.KVStorePrefixIterator(store, types.LockedDelegationsQueueKey)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// migrateQueueEntries queues all the stored locked delegation entries by their unlock time and ID
+func migrateQueueEntries(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iterator := sdk.KVStorePrefixIterator(store, types.LockedDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var lockedDelegation types.LockedDelegation
+		if err := cdc.Unmarshal(iterator.Value(), &lockedDelegation); err != nil {
+			return err
+		}
+
+		pair := types.LockedDelegationPair{
+			DelegatorAddress: lockedDelegation.DelegatorAddress,
+			ValidatorAddress: lockedDelegation.ValidatorAddress,
+		}
+		bz, err := cdc.Marshal(&pair)
+		if err != nil {
+			return err
+		}
+		for _, entry := range lockedDelegation.Entries {
+			store.Set(types.GetLockedDelegationQueueKey(entry.UnlockOn, entry.Id), bz)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package v5_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	v5 "github.com/aetherevm/locking/locking/migrations/v5"
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// TestMigrateStore tests the v4 to v5 store migration
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	rate := types.NewRate(time.Hour, math.LegacyOneDec())
+	delAddr := sdk.AccAddress([]byte("address1"))
+	valAddr := sdk.ValAddress([]byte("val1"))
+	lockedDelegation := types.NewLockedDelegation(delAddr, valAddr, []types.LockedDelegationEntry{
+		types.NewLockedDelegationEntry(math.LegacyNewDec(1), rate, time.Unix(100, 0).UTC(), false, 1),
+		types.NewLockedDelegationEntry(math.LegacyNewDec(2), rate, time.Unix(100, 0).UTC(), true, 2),
+		types.NewLockedDelegationEntry(math.LegacyNewDec(5), rate, time.Unix(300, 0).UTC(), false, 3),
+	})
+	store.Set(types.GetLockedDelegationKey(delAddr, valAddr), cdc.MustMarshal(&lockedDelegation))
+
+	// The v4 layout keeps a slice of pairs per time, with duplicates and stale pairs
+	pair := types.LockedDelegationPair{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String()}
+	stalePair := types.LockedDelegationPair{DelegatorAddress: delAddr.String(), ValidatorAddress: sdk.ValAddress([]byte("val2")).String()}
+	store.Set(types.GetLockedDelegationTimeKey(time.Unix(100, 0)), cdc.MustMarshal(&types.LockedDelegationPairs{
+		Pairs: []types.LockedDelegationPair{pair, pair, stalePair},
+	}))
+	store.Set(types.GetLockedDelegationTimeKey(time.Unix(300, 0)), cdc.MustMarshal(&types.LockedDelegationPairs{
+		Pairs: []types.LockedDelegationPair{pair},
+	}))
+	store.Set(v5.PendingExpiredPairsKey, cdc.MustMarshal(&types.LockedDelegationPairs{
+		Pairs: []types.LockedDelegationPair{stalePair},
+	}))
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+	// The time slices and the pending pairs are gone
+	require.Nil(t, store.Get(types.GetLockedDelegationTimeKey(time.Unix(100, 0))))
+	require.Nil(t, store.Get(types.GetLockedDelegationTimeKey(time.Unix(300, 0))))
+	require.Nil(t, store.Get(v5.PendingExpiredPairsKey))
+
+	// Each entry has its own queue key
+	iterator := sdk.KVStorePrefixIterator(store, types.LockedDelegationsQueueKey)
+	defer iterator.Close()
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		unlockOn, id, err := types.ParseLockedDelegationQueueKey(iterator.Key()[len(types.LockedDelegationsQueueKey):])
+		require.NoError(t, err)
+
+		exists, entries := lockedDelegation.EntriesForIds([]uint64{id})
+		require.True(t, exists)
+		require.True(t, entries[0].UnlockOn.Equal(unlockOn))
+
+		var queuedPair types.LockedDelegationPair
+		cdc.MustUnmarshal(iterator.Value(), &queuedPair)
+		require.Equal(t, pair, queuedPair)
+		ids = append(ids, id)
+	}
+	require.Equal(t, []uint64{1, 2, 3}, ids)
+}
+
+// TestMigrateStoreEmpty tests the v4 to v5 store migration with no state
+func TestMigrateStoreEmpty(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(storeKey), types.LockedDelegationsQueueKey)
+	defer iterator.Close()
+	require.False(t, iterator.Valid())
+}
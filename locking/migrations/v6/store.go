@@ -0,0 +1,50 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6
+// The migration includes:
+// - Setting the undelegate expiry action on all the stored locked delegation entries,
+// the v5 entries without auto renew were always undelegated on expiry
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	return migrateExpiryActions(store, cdc)
+}
+
+// migrateExpiryActions sets the undelegate expiry action with no redelegation validator on all the entries
+func migrateExpiryActions(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iterator := sdk.KVStorePrefixIterator(store, types.LockedDelegationKey)
+	keys := [][]byte{}
+	lockedDelegations := []types.LockedDelegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		var lockedDelegation types.LockedDelegation
+		if err := cdc.Unmarshal(iterator.Value(), &lockedDelegation); err != nil {
+			iterator.Close()
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		lockedDelegations = append(lockedDelegations, lockedDelegation)
+	}
+	iterator.Close()
+
+	// The locked delegations are updated after the iteration, so the store isn't written while iterated
+	for i, lockedDelegation := range lockedDelegations {
+		for j := range lockedDelegation.Entries {
+			lockedDelegation.Entries[j].ExpiryAction = types.ExpiryActionUndelegate
+			lockedDelegation.Entries[j].RedelegateTo = ""
+		}
+		bz, err := cdc.Marshal(&lockedDelegation)
+		if err != nil {
+			return err
+		}
+		store.Set(keys[i], bz)
+	}
+	return nil
+}
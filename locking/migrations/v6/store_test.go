@@ -0,0 +1,66 @@
+package v6_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	v6 "github.com/aetherevm/locking/locking/migrations/v6"
+	"github.com/aetherevm/locking/locking/types"
+)
+
+// TestMigrateStore tests the v5 to v6 store migration
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	rate := types.NewRate(time.Hour, math.LegacyOneDec())
+	delAddr := sdk.AccAddress([]byte("address1"))
+	valAddrs := []sdk.ValAddress{sdk.ValAddress([]byte("val1")), sdk.ValAddress([]byte("val2"))}
+	for i, valAddr := range valAddrs {
+		id := uint64(i * 2)
+		lockedDelegation := types.NewLockedDelegation(delAddr, valAddr, []types.LockedDelegationEntry{
+			types.NewLockedDelegationEntry(math.LegacyNewDec(1), rate, time.Unix(100, 0).UTC(), false, id+1),
+			types.NewLockedDelegationEntry(math.LegacyNewDec(2), rate, time.Unix(200, 0).UTC(), true, id+2),
+		})
+		store.Set(types.GetLockedDelegationKey(delAddr, valAddr), cdc.MustMarshal(&lockedDelegation))
+	}
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	// All the entries are undelegated on expiry, keeping the other values
+	for i, valAddr := range valAddrs {
+		var lockedDelegation types.LockedDelegation
+		cdc.MustUnmarshal(store.Get(types.GetLockedDelegationKey(delAddr, valAddr)), &lockedDelegation)
+		require.Len(t, lockedDelegation.Entries, 2)
+		for j, entry := range lockedDelegation.Entries {
+			require.Equal(t, types.ExpiryActionUndelegate, entry.ExpiryAction)
+			require.Empty(t, entry.RedelegateTo)
+			require.Equal(t, uint64(i*2+j+1), entry.Id)
+			require.Equal(t, j == 1, entry.AutoRenew)
+			require.NoError(t, entry.Validate())
+		}
+	}
+}
+
+// TestMigrateStoreEmpty tests the v5 to v6 store migration with no state
+func TestMigrateStoreEmpty(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(storeKey), types.LockedDelegationKey)
+	defer iterator.Close()
+	require.False(t, iterator.Valid())
+}
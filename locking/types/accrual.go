@@ -0,0 +1,76 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ErrAccrualCheckpointRewardsInvalid = "%s accrual checkpoint rewards are invalid: %s"
+	ErrAccrualCheckpointAccruedInvalid = "%s accrual checkpoint accrued amount is invalid: %s"
+	ErrAccrualCheckpointIndexInvalid   = "%s accrual checkpoint reward index is invalid: %s"
+	ErrAccrualCheckpointNotUnique      = "%s accrual checkpoint not unique: %s"
+)
+
+// NewAccrualCheckpoint returns a new AccrualCheckpoint
+func NewAccrualCheckpoint(delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins, accrued sdk.DecCoins, index sdk.DecCoins) AccrualCheckpoint {
+	return AccrualCheckpoint{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Rewards:          rewards,
+		Accrued:          accrued,
+		Index:            index,
+	}
+}
+
+// Validate validates an AccrualCheckpoint
+func (c AccrualCheckpoint) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.DelegatorAddress); err != nil {
+		return fmt.Errorf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(c.ValidatorAddress); err != nil {
+		return fmt.Errorf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	if err := c.Rewards.Validate(); err != nil {
+		return fmt.Errorf(ErrAccrualCheckpointRewardsInvalid, ModuleName, err)
+	}
+	if err := c.Accrued.Validate(); err != nil {
+		return fmt.Errorf(ErrAccrualCheckpointAccruedInvalid, ModuleName, err)
+	}
+	if err := c.Index.Validate(); err != nil {
+		return fmt.Errorf(ErrAccrualCheckpointIndexInvalid, ModuleName, err)
+	}
+	return nil
+}
+
+// IsEmpty returns true if there are no pending rewards, accrued locking rewards nor reward index on the checkpoint
+func (c AccrualCheckpoint) IsEmpty() bool {
+	return c.Rewards.IsZero() && c.Accrued.IsZero() && c.Index.IsZero()
+}
+
+// EarnedSince returns the rewards earned since the checkpoint given the current pending rewards
+// denoms that didn't go up are left out
+func (c AccrualCheckpoint) EarnedSince(rewards sdk.Coins) sdk.Coins {
+	earned := sdk.NewCoins()
+	for _, coin := range rewards {
+		amount := coin.Amount.Sub(c.Rewards.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			earned = earned.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return earned
+}
+
+// EarnedSinceIndex returns the rewards earned since the checkpoint by a stake given the current validator reward index
+// denoms that didn't go up are left out
+func (c AccrualCheckpoint) EarnedSinceIndex(index sdk.DecCoins, stake sdk.Dec) sdk.DecCoins {
+	earned := sdk.NewDecCoins()
+	for _, coin := range index {
+		ratio := coin.Amount.Sub(c.Index.AmountOf(coin.Denom))
+		if ratio.IsPositive() {
+			earned = earned.Add(sdk.NewDecCoinFromDec(coin.Denom, ratio.MulTruncate(stake)))
+		}
+	}
+	return earned
+}
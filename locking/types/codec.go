@@ -28,6 +28,18 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCreateLockedDelegation{},
 		&MsgRedelegateLockedDelegations{},
 		&MsgToggleAutoRenew{},
+		&MsgSetExpiryAction{},
+		&MsgEarlyUnlock{},
+		&MsgSplitLockedDelegationEntry{},
+		&MsgExtendLock{},
+		&MsgLockExistingDelegation{},
+		&MsgFundRewardPool{},
+		&MsgClaimRewardDebt{},
+		&MsgSetBonusBeneficiary{},
+		&MsgSetAutoCompound{},
+		&MsgWithdrawLockingRewards{},
+		&MsgRetryQuarantinedPairs{},
+		&MsgFundFromCommunityPool{},
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -38,5 +50,17 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCreateLockedDelegation{}, "aether/MsgCreateLockedDelegation")
 	legacy.RegisterAminoMsg(cdc, &MsgRedelegateLockedDelegations{}, "aether/MsgRedelegateLockedDelegations")
 	legacy.RegisterAminoMsg(cdc, &MsgToggleAutoRenew{}, "aether/MsgToggleAutoRenew")
+	legacy.RegisterAminoMsg(cdc, &MsgSetExpiryAction{}, "aether/MsgSetExpiryAction")
+	legacy.RegisterAminoMsg(cdc, &MsgEarlyUnlock{}, "aether/MsgEarlyUnlock")
+	legacy.RegisterAminoMsg(cdc, &MsgSplitLockedDelegationEntry{}, "aether/MsgSplitLockedDelegationEntry")
+	legacy.RegisterAminoMsg(cdc, &MsgExtendLock{}, "aether/MsgExtendLock")
+	legacy.RegisterAminoMsg(cdc, &MsgLockExistingDelegation{}, "aether/MsgLockExistingDelegation")
+	legacy.RegisterAminoMsg(cdc, &MsgFundRewardPool{}, "aether/MsgFundRewardPool")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimRewardDebt{}, "aether/MsgClaimRewardDebt")
+	legacy.RegisterAminoMsg(cdc, &MsgSetBonusBeneficiary{}, "aether/MsgSetBonusBeneficiary")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoCompound{}, "aether/MsgSetAutoCompound")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawLockingRewards{}, "aether/MsgWithdrawLockingRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgRetryQuarantinedPairs{}, "aether/MsgRetryQuarantinedPairs")
+	legacy.RegisterAminoMsg(cdc, &MsgFundFromCommunityPool{}, "aether/MsgFundFromCommunityPool")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "aether/x/locking/MsgUpdateParams")
 }
@@ -0,0 +1,36 @@
+package types
+
+import (
+	fmt "fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ErrCompoundAmountInvalid = "%s locking reward compound amount is invalid: %s"
+	ErrCompoundNotUnique     = "%s locking reward compound not unique: %s"
+)
+
+// NewLockingRewardCompound returns a new LockingRewardCompound
+func NewLockingRewardCompound(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) LockingRewardCompound {
+	return LockingRewardCompound{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// Validate validates a LockingRewardCompound
+func (c LockingRewardCompound) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.DelegatorAddress); err != nil {
+		return fmt.Errorf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(c.ValidatorAddress); err != nil {
+		return fmt.Errorf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	if c.Amount.IsNil() || !c.Amount.IsPositive() {
+		return fmt.Errorf(ErrCompoundAmountInvalid, ModuleName, c.Amount)
+	}
+	return nil
+}
@@ -18,4 +18,12 @@ var (
 	ErrRedelegationIdsBiggerThanMaxEntries    = errorsmod.Register(ModuleName, 11, "requested redelegation ids list length is bigger than max entries")
 	ErrNoValidatorExists                      = errorsmod.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists                     = errorsmod.Register(ModuleName, 13, "delegation does not exist")
+	ErrInvalidSplitShares                     = errorsmod.Register(ModuleName, 14, "split shares must be smaller than the locked delegation entry shares")
+	ErrExtendLockDurationNotLonger            = errorsmod.Register(ModuleName, 15, "extended lock duration must be longer than the current entry rate duration")
+	ErrInsufficientUnlockedShares             = errorsmod.Register(ModuleName, 16, "delegation unlocked shares are smaller than the requested amount")
+	ErrNoRewardDebt                           = errorsmod.Register(ModuleName, 17, "no locking rewards are owed for delegator and validator addresses pair")
+	ErrRewardModeNotStandalone                = errorsmod.Register(ModuleName, 18, "locking rewards can only be withdrawn directly on the standalone reward mode")
+	ErrPairNotQuarantined                     = errorsmod.Register(ModuleName, 19, "locked delegation pair is not quarantined")
+	ErrInvalidExpiryAction                    = errorsmod.Register(ModuleName, 20, "invalid locked delegation entry expiry action")
+	ErrBlockedBeneficiary                     = errorsmod.Register(ModuleName, 21, "bonus beneficiary is not allowed to receive funds")
 )
@@ -6,9 +6,43 @@ const (
 	EventTypeLockedDelegationRedelegate      = "locked_delegation_redelegate"
 	EventTypeWithdrawLockedDelegationRewards = "withdraw_Locked_delegation_rewards"
 	EventTypeToggleAutoRenew                 = "toggle_auto_renew"
+	EventTypeEarlyUnlock                     = "early_unlock"
+	EventTypeSplitLockedDelegationEntry      = "split_locked_delegation_entry"
+	EventTypeExtendLock                      = "extend_lock"
+	EventTypeLockExistingDelegation          = "lock_existing_delegation"
+	EventTypeFundRewardPool                  = "fund_reward_pool"
+	EventTypeFundFromCommunityPool           = "fund_from_community_pool"
+	EventTypeClaimRewardDebt                 = "claim_reward_debt"
+	EventTypeLockingBudgetExceeded           = "locking_budget_exceeded"
+	EventTypeLockedDelegationQuarantined     = "locked_delegation_quarantined"
+	EventTypeRetryQuarantinedPair            = "retry_quarantined_pair"
+	EventTypeSetExpiryAction                 = "set_expiry_action"
+	EventTypeExpiryRedelegationFailed        = "expiry_redelegation_failed"
+	EventTypeSetBonusBeneficiary             = "set_bonus_beneficiary"
+	EventTypeSetAutoCompound                 = "set_auto_compound"
+	EventTypeLockingRewardCompoundFailed     = "locking_reward_compound_failed"
+	EventTypeValidatorPolicyFailed           = "validator_policy_failed"
 
-	AttributeKeyAutoRenew = "auto_renew"
-	AttributeKeyUnlockOn  = "unlock_on"
-	AttributeKeyValidator = "validator"
-	AttributeKeyDelegator = "delegator"
+	AttributeKeyAutoRenew    = "auto_renew"
+	AttributeKeyUnlockOn     = "unlock_on"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyDelegator    = "delegator"
+	AttributeKeyPenalty      = "penalty"
+	AttributeKeyEntryID      = "entry_id"
+	AttributeKeyEntryIDs     = "entry_ids"
+	AttributeKeySplitID      = "split_id"
+	AttributeKeyRemainderID  = "remainder_id"
+	AttributeKeyDuration     = "duration"
+	AttributeKeyDepositor    = "depositor"
+	AttributeKeyAuthority    = "authority"
+	AttributeKeyDebt         = "debt"
+	AttributeKeyConsumed     = "consumed"
+	AttributeKeyAction       = "action"
+	AttributeKeyError        = "error"
+	AttributeKeyExpiryAction = "expiry_action"
+	AttributeKeyRedelegateTo = "redelegate_to"
+	AttributeKeyBeneficiary  = "beneficiary"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeyAutoCompound = "auto_compound"
+	AttributeKeyStoreError   = "store_error"
 )
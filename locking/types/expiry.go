@@ -0,0 +1,45 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ErrQuarantinedPairErrorEmpty = "%s quarantined pair error cannot be empty"
+	ErrQuarantinedPairNotUnique  = "%s quarantined pair not unique: %s"
+	ErrPairsEmpty                = "%s pairs cannot be empty"
+)
+
+// NewQuarantinedPair returns a new QuarantinedPair
+func NewQuarantinedPair(delAddr sdk.AccAddress, valAddr sdk.ValAddress, err string, height int64) QuarantinedPair {
+	return QuarantinedPair{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Error:            err,
+		Height:           height,
+	}
+}
+
+// Validate validates a QuarantinedPair
+func (q QuarantinedPair) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(q.DelegatorAddress); err != nil {
+		return fmt.Errorf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(q.ValidatorAddress); err != nil {
+		return fmt.Errorf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	if q.Error == "" {
+		return fmt.Errorf(ErrQuarantinedPairErrorEmpty, ModuleName)
+	}
+	return nil
+}
+
+// Pair returns the locked delegation pair of the quarantined pair
+func (q QuarantinedPair) Pair() LockedDelegationPair {
+	return LockedDelegationPair{
+		DelegatorAddress: q.DelegatorAddress,
+		ValidatorAddress: q.ValidatorAddress,
+	}
+}
@@ -0,0 +1,156 @@
+package types
+
+import (
+	fmt "fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ErrRewardDebtAmountInvalid = "%s reward debt amount is invalid: %s"
+	ErrRewardDebtNotUnique     = "%s reward debt not unique: %s"
+	ErrMintEpochMintedInvalid  = "%s mint epoch minted amount is invalid: %s"
+	ErrDepositorAddressInvalid = "%s invalid depositor address: %s"
+	ErrFundAmountInvalid       = "%s invalid fund amount: %s"
+	ErrBudgetConsumedInvalid   = "%s budget window consumed amount is invalid: %s"
+	ErrBeneficiaryInvalid      = "%s invalid bonus beneficiary address: %s"
+	ErrRemainderAmountInvalid  = "%s reward remainder amount is invalid: %s"
+	ErrRemainderNotUnique      = "%s reward remainder not unique: %s"
+	ErrBeneficiaryNotUnique    = "%s bonus beneficiary not unique: %s"
+	ErrEscrowAmountInvalid     = "%s entry escrow amount is invalid: %s"
+	ErrEscrowNotUnique         = "%s entry escrow not unique: %d"
+	ErrEscrowEntryNotFound     = "%s entry escrow locked delegation entry not found: %d"
+)
+
+// NewRewardDebt returns a new RewardDebt
+func NewRewardDebt(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coins) RewardDebt {
+	return RewardDebt{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// Validate validates a RewardDebt
+func (d RewardDebt) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(d.DelegatorAddress); err != nil {
+		return fmt.Errorf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(d.ValidatorAddress); err != nil {
+		return fmt.Errorf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	if err := d.Amount.Validate(); err != nil || d.Amount.IsZero() {
+		return fmt.Errorf(ErrRewardDebtAmountInvalid, ModuleName, d.Amount)
+	}
+	return nil
+}
+
+// NewRewardRemainder returns a new RewardRemainder
+func NewRewardRemainder(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.DecCoins) RewardRemainder {
+	return RewardRemainder{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// Validate validates a RewardRemainder
+// A remainder is what is left from a truncation, so it is always below one on every denom
+func (r RewardRemainder) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.DelegatorAddress); err != nil {
+		return fmt.Errorf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+		return fmt.Errorf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	if err := r.Amount.Validate(); err != nil || r.Amount.IsZero() {
+		return fmt.Errorf(ErrRemainderAmountInvalid, ModuleName, r.Amount)
+	}
+	for _, coin := range r.Amount {
+		if coin.Amount.GTE(sdk.OneDec()) {
+			return fmt.Errorf(ErrRemainderAmountInvalid, ModuleName, r.Amount)
+		}
+	}
+	return nil
+}
+
+// NewEntryEscrow returns a new EntryEscrow
+func NewEntryEscrow(entryID uint64, amount sdk.DecCoins) EntryEscrow {
+	return EntryEscrow{
+		EntryId: entryID,
+		Amount:  amount,
+	}
+}
+
+// Validate validates an EntryEscrow
+func (e EntryEscrow) Validate() error {
+	if err := e.Amount.Validate(); err != nil || e.Amount.IsZero() {
+		return fmt.Errorf(ErrEscrowAmountInvalid, ModuleName, e.Amount)
+	}
+	return nil
+}
+
+// NewBonusBeneficiary returns a new BonusBeneficiary
+func NewBonusBeneficiary(delAddr sdk.AccAddress, beneficiary sdk.AccAddress) BonusBeneficiary {
+	return BonusBeneficiary{
+		DelegatorAddress:   delAddr.String(),
+		BeneficiaryAddress: beneficiary.String(),
+	}
+}
+
+// Validate validates a BonusBeneficiary
+func (b BonusBeneficiary) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.DelegatorAddress); err != nil {
+		return fmt.Errorf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(b.BeneficiaryAddress); err != nil {
+		return fmt.Errorf(ErrBeneficiaryInvalid, ModuleName, err)
+	}
+	return nil
+}
+
+// NewMintEpoch returns a new MintEpoch
+func NewMintEpoch(start time.Time, minted sdk.Coins) MintEpoch {
+	return MintEpoch{
+		Start:  start,
+		Minted: minted,
+	}
+}
+
+// Validate validates a MintEpoch
+func (e MintEpoch) Validate() error {
+	if err := e.Minted.Validate(); err != nil {
+		return fmt.Errorf(ErrMintEpochMintedInvalid, ModuleName, err)
+	}
+	return nil
+}
+
+// NewBudgetWindow returns a new BudgetWindow
+func NewBudgetWindow(start time.Time, consumed sdk.Coins) BudgetWindow {
+	return BudgetWindow{
+		Start:    start,
+		Consumed: consumed,
+	}
+}
+
+// Validate validates a BudgetWindow
+func (w BudgetWindow) Validate() error {
+	if err := w.Consumed.Validate(); err != nil {
+		return fmt.Errorf(ErrBudgetConsumedInvalid, ModuleName, err)
+	}
+	return nil
+}
+
+// Remaining returns what can still be minted on the window for a budget cap
+// denoms already over the cap are left out
+func (w BudgetWindow) Remaining(budgetCap sdk.Coins) sdk.Coins {
+	remaining := sdk.NewCoins()
+	for _, coin := range budgetCap {
+		available := coin.Amount.Sub(w.Consumed.AmountOf(coin.Denom))
+		if available.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(coin.Denom, available))
+		}
+	}
+	return remaining
+}
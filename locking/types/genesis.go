@@ -5,11 +5,13 @@ import (
 	fmt "fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
-	ErrLDNotUnique        = "%s locked delegation not unique: %s"
-	ErrLDEntryIDNotUnique = "%s locked delegation entry ID not unique: %d"
+	ErrLDNotUnique               = "%s locked delegation not unique: %s"
+	ErrLDEntryIDNotUnique        = "%s locked delegation entry ID not unique: %d"
+	ErrValidatorAddressNotUnique = "%s validator address not unique: %s"
 )
 
 // NewGenesisState creates a new genesis state.
@@ -59,9 +61,136 @@ func (gs GenesisState) Validate() error {
 			seeingLDEntryID[entry.Id] = true
 		}
 	}
+
+	for _, slashEvent := range gs.ValidatorSlashEvents {
+		if err := slashEvent.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// We should not have duplicated reward debts for a pair
+	seeingDebt := make(map[string]bool)
+	for _, debt := range gs.RewardDebts {
+		if err := debt.Validate(); err != nil {
+			return err
+		}
+		pair := debt.DelegatorAddress + "/" + debt.ValidatorAddress
+		if seeingDebt[pair] {
+			return fmt.Errorf(ErrRewardDebtNotUnique, ModuleName, pair)
+		}
+		seeingDebt[pair] = true
+	}
+
+	// We should not have duplicated accrual checkpoints for a pair
+	seeingCheckpoint := make(map[string]bool)
+	for _, checkpoint := range gs.AccrualCheckpoints {
+		if err := checkpoint.Validate(); err != nil {
+			return err
+		}
+		pair := checkpoint.DelegatorAddress + "/" + checkpoint.ValidatorAddress
+		if seeingCheckpoint[pair] {
+			return fmt.Errorf(ErrAccrualCheckpointNotUnique, ModuleName, pair)
+		}
+		seeingCheckpoint[pair] = true
+	}
+
+	// We should not have duplicated quarantined pairs
+	seeingQuarantined := make(map[string]bool)
+	for _, quarantined := range gs.QuarantinedPairs {
+		if err := quarantined.Validate(); err != nil {
+			return err
+		}
+		pair := quarantined.DelegatorAddress + "/" + quarantined.ValidatorAddress
+		if seeingQuarantined[pair] {
+			return fmt.Errorf(ErrQuarantinedPairNotUnique, ModuleName, pair)
+		}
+		seeingQuarantined[pair] = true
+	}
+
+	// We should not have duplicated bonus beneficiaries for a delegator
+	seeingBeneficiary := make(map[string]bool)
+	for _, beneficiary := range gs.BonusBeneficiaries {
+		if err := beneficiary.Validate(); err != nil {
+			return err
+		}
+		if seeingBeneficiary[beneficiary.DelegatorAddress] {
+			return fmt.Errorf(ErrBeneficiaryNotUnique, ModuleName, beneficiary.DelegatorAddress)
+		}
+		seeingBeneficiary[beneficiary.DelegatorAddress] = true
+	}
+
+	// We should not have duplicated reward remainders for a pair
+	seeingRemainder := make(map[string]bool)
+	for _, remainder := range gs.RewardRemainders {
+		if err := remainder.Validate(); err != nil {
+			return err
+		}
+		pair := remainder.DelegatorAddress + "/" + remainder.ValidatorAddress
+		if seeingRemainder[pair] {
+			return fmt.Errorf(ErrRemainderNotUnique, ModuleName, pair)
+		}
+		seeingRemainder[pair] = true
+	}
+
+	// We should not have duplicated escrows and they must belong to an entry
+	seeingEscrow := make(map[uint64]bool)
+	for _, escrow := range gs.EntryEscrows {
+		if err := escrow.Validate(); err != nil {
+			return err
+		}
+		if seeingEscrow[escrow.EntryId] {
+			return fmt.Errorf(ErrEscrowNotUnique, ModuleName, escrow.EntryId)
+		}
+		if !seeingLDEntryID[escrow.EntryId] {
+			return fmt.Errorf(ErrEscrowEntryNotFound, ModuleName, escrow.EntryId)
+		}
+		seeingEscrow[escrow.EntryId] = true
+	}
+
+	// We should not have duplicated locking reward compounds for a pair
+	seeingCompound := make(map[string]bool)
+	for _, compound := range gs.CompoundQueue {
+		if err := compound.Validate(); err != nil {
+			return err
+		}
+		pair := compound.DelegatorAddress + "/" + compound.ValidatorAddress
+		if seeingCompound[pair] {
+			return fmt.Errorf(ErrCompoundNotUnique, ModuleName, pair)
+		}
+		seeingCompound[pair] = true
+	}
+
+	// The free redelegation validators and the pending validator queues hold unique validators
+	for _, valAddrs := range [][]string{gs.FreeRedelegationValidators, gs.SlashedValidatorQueue, gs.ExitedValidatorQueue} {
+		if err := validateValidatorAddresses(valAddrs); err != nil {
+			return err
+		}
+	}
+
+	if err := gs.MintEpoch.Validate(); err != nil {
+		return err
+	}
+	if err := gs.BudgetWindow.Validate(); err != nil {
+		return err
+	}
 	return gs.Params.Validate()
 }
 
+// validateValidatorAddresses validates a set of validator addresses, they must be valid and unique
+func validateValidatorAddresses(valAddrs []string) error {
+	seeingValidator := make(map[string]bool)
+	for _, valAddr := range valAddrs {
+		if _, err := sdk.ValAddressFromBech32(valAddr); err != nil {
+			return fmt.Errorf(ErrValidatorAddressInvalid, ModuleName, err)
+		}
+		if seeingValidator[valAddr] {
+			return fmt.Errorf(ErrValidatorAddressNotUnique, ModuleName, valAddr)
+		}
+		seeingValidator[valAddr] = true
+	}
+	return nil
+}
+
 // GetGenesisStateFromAppState return GenesisState
 func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) *GenesisState {
 	var genesisState GenesisState
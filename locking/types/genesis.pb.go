@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +31,40 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
 	// LockedDelegation defines all the locked delegations on the system
 	LockedDelegations []LockedDelegation `protobuf:"bytes,2,rep,name=locked_delegations,json=lockedDelegations,proto3" json:"locked_delegations"`
+	// validator_slash_events defines all the recorded validator slash events
+	ValidatorSlashEvents []ValidatorSlashEvent `protobuf:"bytes,3,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// reward_debts defines all the locking rewards owed to delegators
+	RewardDebts []RewardDebt `protobuf:"bytes,4,rep,name=reward_debts,json=rewardDebts,proto3" json:"reward_debts"`
+	// mint_epoch defines the current hybrid funding mint epoch
+	MintEpoch MintEpoch `protobuf:"bytes,5,opt,name=mint_epoch,json=mintEpoch,proto3" json:"mint_epoch"`
+	// budget_window defines the current locking rewards budget window
+	BudgetWindow BudgetWindow `protobuf:"bytes,6,opt,name=budget_window,json=budgetWindow,proto3" json:"budget_window"`
+	// accrual_checkpoints defines the locking rewards accrued since the last
+	// withdraw of each pair
+	AccrualCheckpoints []AccrualCheckpoint `protobuf:"bytes,7,rep,name=accrual_checkpoints,json=accrualCheckpoints,proto3" json:"accrual_checkpoints"`
+	// quarantined_pairs defines the locked delegation pairs whose expired
+	// entries couldn't be completed
+	QuarantinedPairs []QuarantinedPair `protobuf:"bytes,8,rep,name=quarantined_pairs,json=quarantinedPairs,proto3" json:"quarantined_pairs"`
+	// bonus_beneficiaries defines the addresses receiving the locking rewards
+	// of delegators
+	BonusBeneficiaries []BonusBeneficiary `protobuf:"bytes,9,rep,name=bonus_beneficiaries,json=bonusBeneficiaries,proto3" json:"bonus_beneficiaries"`
+	// reward_remainders defines the truncated locking rewards carried over to
+	// the next payout of each pair
+	RewardRemainders []RewardRemainder `protobuf:"bytes,10,rep,name=reward_remainders,json=rewardRemainders,proto3" json:"reward_remainders"`
+	// entry_escrows defines the locking rewards held for the entries with the
+	// at maturity payout mode
+	EntryEscrows []EntryEscrow `protobuf:"bytes,11,rep,name=entry_escrows,json=entryEscrows,proto3" json:"entry_escrows"`
+	// free_redelegation_validators defines the validators whose locks can be
+	// redelegated without the max entries limit
+	FreeRedelegationValidators []string `protobuf:"bytes,12,rep,name=free_redelegation_validators,json=freeRedelegationValidators,proto3" json:"free_redelegation_validators,omitempty"`
+	// slashed_validator_queue defines the slashed validators waiting for the
+	// double sign check
+	SlashedValidatorQueue []string `protobuf:"bytes,13,rep,name=slashed_validator_queue,json=slashedValidatorQueue,proto3" json:"slashed_validator_queue,omitempty"`
+	// exited_validator_queue defines the validators waiting for the exit check
+	ExitedValidatorQueue []string `protobuf:"bytes,14,rep,name=exited_validator_queue,json=exitedValidatorQueue,proto3" json:"exited_validator_queue,omitempty"`
+	// compound_queue defines the locking rewards waiting to be compounded at
+	// the end of the block
+	CompoundQueue []LockingRewardCompound `protobuf:"bytes,15,rep,name=compound_queue,json=compoundQueue,proto3" json:"compound_queue"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +114,97 @@ func (m *GenesisState) GetLockedDelegations() []LockedDelegation {
 	return nil
 }
 
+func (m *GenesisState) GetValidatorSlashEvents() []ValidatorSlashEvent {
+	if m != nil {
+		return m.ValidatorSlashEvents
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardDebts() []RewardDebt {
+	if m != nil {
+		return m.RewardDebts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintEpoch() MintEpoch {
+	if m != nil {
+		return m.MintEpoch
+	}
+	return MintEpoch{}
+}
+
+func (m *GenesisState) GetBudgetWindow() BudgetWindow {
+	if m != nil {
+		return m.BudgetWindow
+	}
+	return BudgetWindow{}
+}
+
+func (m *GenesisState) GetAccrualCheckpoints() []AccrualCheckpoint {
+	if m != nil {
+		return m.AccrualCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQuarantinedPairs() []QuarantinedPair {
+	if m != nil {
+		return m.QuarantinedPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBonusBeneficiaries() []BonusBeneficiary {
+	if m != nil {
+		return m.BonusBeneficiaries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardRemainders() []RewardRemainder {
+	if m != nil {
+		return m.RewardRemainders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEntryEscrows() []EntryEscrow {
+	if m != nil {
+		return m.EntryEscrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFreeRedelegationValidators() []string {
+	if m != nil {
+		return m.FreeRedelegationValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashedValidatorQueue() []string {
+	if m != nil {
+		return m.SlashedValidatorQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExitedValidatorQueue() []string {
+	if m != nil {
+		return m.ExitedValidatorQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCompoundQueue() []LockingRewardCompound {
+	if m != nil {
+		return m.CompoundQueue
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "aether.locking.v1beta1.GenesisState")
 }
@@ -88,25 +214,52 @@ func init() {
 }
 
 var fileDescriptor_1191f3fce5690abb = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x4c, 0x2d, 0xc9,
-	0x48, 0x2d, 0xd2, 0xcf, 0xc9, 0x4f, 0xce, 0xce, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xa8, 0xd2, 0x83, 0xaa, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0xe4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5,
-	0x93, 0x12, 0x8b, 0x53, 0xe1, 0x06, 0x26, 0xe7, 0x67, 0xe6, 0x41, 0xe5, 0x95, 0x71, 0xd8, 0x59,
-	0x90, 0x58, 0x94, 0x98, 0x0b, 0xb5, 0x52, 0x0a, 0x97, 0xc3, 0x60, 0x4e, 0x00, 0xab, 0x52, 0xda,
-	0xc3, 0xc8, 0xc5, 0xe3, 0x0e, 0x71, 0x6a, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x2f, 0x17, 0x1b,
-	0xc4, 0x18, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x39, 0x3d, 0xec, 0x4e, 0xd7, 0x0b, 0x00,
-	0xab, 0x72, 0x12, 0x3d, 0x71, 0x4f, 0x9e, 0xe1, 0xd3, 0x3d, 0x79, 0xde, 0xca, 0xc4, 0xdc, 0x1c,
-	0x2b, 0x25, 0x88, 0x5e, 0xa5, 0x20, 0xa8, 0x21, 0x42, 0xb1, 0x5c, 0x42, 0x20, 0x8d, 0xa9, 0x29,
-	0xf1, 0x29, 0xa9, 0x39, 0xa9, 0xe9, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x12, 0x4c, 0x0a, 0xcc,
-	0x1a, 0xdc, 0x46, 0x1a, 0xb8, 0x8c, 0xf6, 0x01, 0xeb, 0x70, 0x81, 0x6b, 0x70, 0x62, 0x01, 0x59,
-	0x12, 0x24, 0x98, 0x83, 0x26, 0x5e, 0xec, 0x64, 0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x4a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x10,
-	0x6b, 0x52, 0xcb, 0x72, 0xe1, 0x81, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x03,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc9, 0xcc, 0x99, 0xc5, 0xc4, 0x01, 0x00, 0x00,
+	// 713 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdd, 0x4e, 0xdb, 0x48,
+	0x14, 0xc7, 0x93, 0x85, 0x65, 0x97, 0x49, 0xc2, 0x2e, 0xc3, 0xc7, 0x7a, 0x51, 0x6b, 0x20, 0x20,
+	0x35, 0x55, 0x45, 0x22, 0xe8, 0x5d, 0xd5, 0x1b, 0x02, 0x69, 0x2f, 0x5a, 0x5a, 0x08, 0x52, 0x51,
+	0x91, 0x2a, 0x77, 0x6c, 0x1f, 0x9c, 0x11, 0xf1, 0x4c, 0x98, 0x19, 0x27, 0xcd, 0x5b, 0xf4, 0x61,
+	0xfa, 0x10, 0x48, 0xbd, 0x41, 0xbd, 0xea, 0x15, 0xaa, 0xe0, 0x0d, 0xfa, 0x04, 0x95, 0xc7, 0x63,
+	0x07, 0x28, 0x46, 0xdc, 0xd9, 0xe7, 0xfc, 0xff, 0xbf, 0x73, 0xe6, 0xeb, 0xa0, 0x55, 0x02, 0xaa,
+	0x03, 0xa2, 0xd1, 0xe5, 0xde, 0x31, 0x65, 0x41, 0xa3, 0xbf, 0xee, 0x82, 0x22, 0xeb, 0x8d, 0x00,
+	0x18, 0x48, 0x2a, 0xeb, 0x3d, 0xc1, 0x15, 0xc7, 0xf3, 0x89, 0xaa, 0x6e, 0x54, 0x75, 0xa3, 0x5a,
+	0x98, 0x0d, 0x78, 0xc0, 0xb5, 0xa4, 0x11, 0x7f, 0x25, 0xea, 0x85, 0xff, 0x3d, 0x2e, 0x43, 0x2e,
+	0x9d, 0x24, 0x91, 0xfc, 0x98, 0x94, 0x9d, 0xfc, 0x35, 0x5c, 0x22, 0x21, 0xab, 0xe5, 0x71, 0xca,
+	0x4c, 0x7e, 0x25, 0xa7, 0x9d, 0x1e, 0x11, 0x24, 0x4c, 0x21, 0x79, 0x3d, 0xa7, 0xdd, 0x69, 0x55,
+	0xf5, 0x2b, 0x42, 0xe5, 0x97, 0xc9, 0x2a, 0xf6, 0x15, 0x51, 0x80, 0x77, 0xd0, 0x44, 0x82, 0xb1,
+	0x8a, 0x4b, 0xc5, 0x5a, 0x69, 0xc3, 0xae, 0xdf, 0xbe, 0xaa, 0xfa, 0xae, 0x56, 0x35, 0xe7, 0x4e,
+	0xcf, 0x17, 0x0b, 0x3f, 0xcf, 0x17, 0x2b, 0x43, 0x12, 0x76, 0x9f, 0x55, 0x13, 0x6f, 0xb5, 0x6d,
+	0x20, 0xf8, 0x03, 0xc2, 0xb1, 0x11, 0x7c, 0xc7, 0x87, 0x2e, 0x04, 0x44, 0x51, 0xce, 0xa4, 0xf5,
+	0xc7, 0xd2, 0x58, 0xad, 0xb4, 0x51, 0xcb, 0x43, 0xbf, 0xd6, 0x8e, 0xed, 0xcc, 0xd0, 0x1c, 0x8f,
+	0x8b, 0xb4, 0xa7, 0xbb, 0x37, 0xe2, 0x12, 0x07, 0x68, 0xbe, 0x4f, 0xba, 0xd4, 0x27, 0x8a, 0x0b,
+	0x47, 0x76, 0x89, 0xec, 0x38, 0xd0, 0x07, 0xa6, 0xa4, 0x35, 0xa6, 0x4b, 0x3c, 0xc9, 0x2b, 0xf1,
+	0x2e, 0x75, 0xed, 0xc7, 0xa6, 0x56, 0xec, 0x31, 0x55, 0x66, 0xfb, 0xbf, 0xa7, 0x24, 0x7e, 0x85,
+	0xca, 0x02, 0x06, 0x44, 0xc4, 0xeb, 0x70, 0x95, 0xb4, 0xc6, 0x35, 0xbe, 0x9a, 0x87, 0x6f, 0x6b,
+	0xed, 0x36, 0xb8, 0x29, 0xb5, 0x24, 0xb2, 0x88, 0xc4, 0x2f, 0x10, 0x0a, 0x29, 0x53, 0x0e, 0xf4,
+	0xb8, 0xd7, 0xb1, 0xfe, 0xd4, 0xfb, 0xbc, 0x9c, 0x87, 0xda, 0xa1, 0x4c, 0xb5, 0x62, 0xa1, 0x21,
+	0x4d, 0x86, 0x69, 0x00, 0xbf, 0x45, 0x15, 0x37, 0xf2, 0x03, 0x50, 0xce, 0x80, 0x32, 0x9f, 0x0f,
+	0xac, 0x09, 0x8d, 0x5a, 0xcd, 0x43, 0x35, 0xb5, 0xf8, 0x40, 0x6b, 0x0d, 0xad, 0xec, 0x5e, 0x89,
+	0xe1, 0x8f, 0x68, 0x86, 0x78, 0x9e, 0x88, 0x48, 0xd7, 0xf1, 0x3a, 0xe0, 0x1d, 0xf7, 0x38, 0x8d,
+	0xf7, 0xf2, 0x2f, 0xbd, 0xd8, 0xc7, 0x79, 0xd8, 0xcd, 0xc4, 0xb2, 0x95, 0x39, 0x0c, 0x1b, 0x93,
+	0x9b, 0x09, 0x89, 0x0f, 0xd1, 0xf4, 0x49, 0x44, 0x04, 0x61, 0x8a, 0x32, 0xf0, 0x9d, 0x1e, 0xa1,
+	0x42, 0x5a, 0x7f, 0x6b, 0xfe, 0xa3, 0x3c, 0xfe, 0xde, 0xc8, 0xb0, 0x4b, 0xa8, 0x30, 0xf4, 0x7f,
+	0x4f, 0xae, 0x87, 0x25, 0x76, 0xd0, 0x8c, 0xcb, 0x59, 0x24, 0x1d, 0x17, 0x18, 0x1c, 0x51, 0x8f,
+	0x12, 0x41, 0x41, 0x5a, 0x93, 0x77, 0x5f, 0xb6, 0x66, 0x6c, 0x69, 0x66, 0x8e, 0x61, 0xda, 0xbc,
+	0x7b, 0x3d, 0x4e, 0x41, 0x37, 0x6f, 0x2e, 0x81, 0x80, 0x90, 0x50, 0xe6, 0x83, 0x90, 0x16, 0xba,
+	0xbb, 0xf9, 0xe4, 0x26, 0xb4, 0x53, 0x7d, 0xda, 0xbc, 0xb8, 0x1e, 0x96, 0xf8, 0x0d, 0xaa, 0x00,
+	0x53, 0x62, 0xe8, 0x80, 0xf4, 0x04, 0x1f, 0x48, 0xab, 0xa4, 0xb9, 0x2b, 0x79, 0xdc, 0x56, 0x2c,
+	0x6e, 0x69, 0x6d, 0x7a, 0x94, 0x30, 0x0a, 0x49, 0xec, 0xa1, 0x07, 0x47, 0x02, 0xc0, 0x11, 0x30,
+	0x7a, 0x78, 0x4e, 0x76, 0xb5, 0xa5, 0x55, 0x5e, 0x1a, 0xab, 0x4d, 0x36, 0x97, 0xbf, 0x7d, 0x59,
+	0x7b, 0x68, 0x66, 0x4f, 0xf6, 0x24, 0x36, 0x7d, 0x5f, 0x80, 0x94, 0xfb, 0x4a, 0x50, 0x16, 0xb4,
+	0x17, 0x62, 0x4c, 0xfb, 0x0a, 0x25, 0xd3, 0x49, 0xfc, 0x1e, 0xfd, 0xa7, 0x1f, 0x1d, 0xf8, 0x23,
+	0xb4, 0x73, 0x12, 0x41, 0x04, 0x56, 0xe5, 0xbe, 0xfc, 0x39, 0x43, 0xc8, 0xd2, 0x7b, 0xb1, 0x1f,
+	0x1f, 0xa0, 0x79, 0xf8, 0x44, 0xd5, 0x2d, 0xe4, 0xa9, 0xfb, 0x92, 0x67, 0x13, 0xc0, 0x0d, 0xf0,
+	0x21, 0x9a, 0xf2, 0x78, 0xd8, 0xe3, 0x11, 0xf3, 0x0d, 0xf0, 0x1f, 0xbd, 0xd3, 0x6b, 0x77, 0x4d,
+	0xa3, 0x18, 0xa7, 0x4f, 0x6c, 0xcb, 0x58, 0xcd, 0x9e, 0x57, 0x52, 0x94, 0x66, 0x37, 0x9f, 0x9f,
+	0x5e, 0xd8, 0xc5, 0xb3, 0x0b, 0xbb, 0xf8, 0xe3, 0xc2, 0x2e, 0x7e, 0xbe, 0xb4, 0x0b, 0x67, 0x97,
+	0x76, 0xe1, 0xfb, 0xa5, 0x5d, 0x38, 0xac, 0x06, 0x54, 0x75, 0x22, 0xb7, 0xee, 0xf1, 0xb0, 0x91,
+	0xd4, 0x81, 0x7e, 0x98, 0xcd, 0x66, 0x35, 0xec, 0x81, 0x74, 0x27, 0xf4, 0x48, 0x7e, 0xfa, 0x6b,
+	0x00, 0xa9, 0x9b, 0x1d, 0x32, 0x6e, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +282,165 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CompoundQueue) > 0 {
+		for iNdEx := len(m.CompoundQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompoundQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ExitedValidatorQueue) > 0 {
+		for iNdEx := len(m.ExitedValidatorQueue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExitedValidatorQueue[iNdEx])
+			copy(dAtA[i:], m.ExitedValidatorQueue[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExitedValidatorQueue[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.SlashedValidatorQueue) > 0 {
+		for iNdEx := len(m.SlashedValidatorQueue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashedValidatorQueue[iNdEx])
+			copy(dAtA[i:], m.SlashedValidatorQueue[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SlashedValidatorQueue[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.FreeRedelegationValidators) > 0 {
+		for iNdEx := len(m.FreeRedelegationValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FreeRedelegationValidators[iNdEx])
+			copy(dAtA[i:], m.FreeRedelegationValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FreeRedelegationValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.EntryEscrows) > 0 {
+		for iNdEx := len(m.EntryEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntryEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RewardRemainders) > 0 {
+		for iNdEx := len(m.RewardRemainders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardRemainders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BonusBeneficiaries) > 0 {
+		for iNdEx := len(m.BonusBeneficiaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BonusBeneficiaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.QuarantinedPairs) > 0 {
+		for iNdEx := len(m.QuarantinedPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuarantinedPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AccrualCheckpoints) > 0 {
+		for iNdEx := len(m.AccrualCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccrualCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.BudgetWindow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.MintEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.RewardDebts) > 0 {
+		for iNdEx := len(m.RewardDebts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValidatorSlashEvents) > 0 {
+		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSlashEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.LockedDelegations) > 0 {
 		for iNdEx := len(m.LockedDelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -181,6 +493,76 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ValidatorSlashEvents) > 0 {
+		for _, e := range m.ValidatorSlashEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardDebts) > 0 {
+		for _, e := range m.RewardDebts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.MintEpoch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BudgetWindow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AccrualCheckpoints) > 0 {
+		for _, e := range m.AccrualCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QuarantinedPairs) > 0 {
+		for _, e := range m.QuarantinedPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BonusBeneficiaries) > 0 {
+		for _, e := range m.BonusBeneficiaries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardRemainders) > 0 {
+		for _, e := range m.RewardRemainders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EntryEscrows) > 0 {
+		for _, e := range m.EntryEscrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FreeRedelegationValidators) > 0 {
+		for _, s := range m.FreeRedelegationValidators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashedValidatorQueue) > 0 {
+		for _, s := range m.SlashedValidatorQueue {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExitedValidatorQueue) > 0 {
+		for _, s := range m.ExitedValidatorQueue {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CompoundQueue) > 0 {
+		for _, e := range m.CompoundQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -286,6 +668,440 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
+			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDebts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDebts = append(m.RewardDebts, RewardDebt{})
+			if err := m.RewardDebts[len(m.RewardDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BudgetWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccrualCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccrualCheckpoints = append(m.AccrualCheckpoints, AccrualCheckpoint{})
+			if err := m.AccrualCheckpoints[len(m.AccrualCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuarantinedPairs = append(m.QuarantinedPairs, QuarantinedPair{})
+			if err := m.QuarantinedPairs[len(m.QuarantinedPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusBeneficiaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BonusBeneficiaries = append(m.BonusBeneficiaries, BonusBeneficiary{})
+			if err := m.BonusBeneficiaries[len(m.BonusBeneficiaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardRemainders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardRemainders = append(m.RewardRemainders, RewardRemainder{})
+			if err := m.RewardRemainders[len(m.RewardRemainders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryEscrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryEscrows = append(m.EntryEscrows, EntryEscrow{})
+			if err := m.EntryEscrows[len(m.EntryEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeRedelegationValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreeRedelegationValidators = append(m.FreeRedelegationValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedValidatorQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedValidatorQueue = append(m.SlashedValidatorQueue, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitedValidatorQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitedValidatorQueue = append(m.ExitedValidatorQueue, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompoundQueue = append(m.CompoundQueue, LockingRewardCompound{})
+			if err := m.CompoundQueue[len(m.CompoundQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -61,6 +61,7 @@ func TestGenesisStateValidate(t *testing.T) {
 						"",
 						"",
 						nil,
+						false,
 					},
 				},
 			),
@@ -112,6 +113,315 @@ func TestGenesisStateValidate(t *testing.T) {
 			),
 			valid: false,
 		},
+		{
+			desc: "invalid - bad validator slash event",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				ValidatorSlashEvents: []types.ValidatorSlashEvent{
+					types.NewValidatorSlashEvent(valAddr, 1, time.Now(), math.LegacyNewDec(2), math.LegacyOneDec(), math.LegacyOneDec()),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid - reward debts",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				RewardDebts: []types.RewardDebt{
+					types.NewRewardDebt(addr, valAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+					types.NewRewardDebt(addr, valAddr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid - duplicated reward debt",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				RewardDebts: []types.RewardDebt{
+					types.NewRewardDebt(addr, valAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+					types.NewRewardDebt(addr, valAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 5))),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid - empty reward debt",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				RewardDebts: []types.RewardDebt{
+					types.NewRewardDebt(addr, valAddr, sdk.NewCoins()),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid - accrual checkpoints",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				AccrualCheckpoints: []types.AccrualCheckpoint{
+					types.NewAccrualCheckpoint(addr, valAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), sdk.NewDecCoins(), sdk.NewDecCoins()),
+					types.NewAccrualCheckpoint(addr, valAddr2, sdk.NewCoins(), sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)), sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 2))),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid - duplicated accrual checkpoint",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				AccrualCheckpoints: []types.AccrualCheckpoint{
+					types.NewAccrualCheckpoint(addr, valAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), sdk.NewDecCoins(), sdk.NewDecCoins()),
+					types.NewAccrualCheckpoint(addr, valAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), sdk.NewDecCoins(), sdk.NewDecCoins()),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid - quarantined pairs",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				QuarantinedPairs: []types.QuarantinedPair{
+					types.NewQuarantinedPair(addr, valAddr, "error", 1),
+					types.NewQuarantinedPair(addr, valAddr2, "error", 1),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid - duplicated quarantined pair",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				QuarantinedPairs: []types.QuarantinedPair{
+					types.NewQuarantinedPair(addr, valAddr, "error", 1),
+					types.NewQuarantinedPair(addr, valAddr, "other error", 2),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid - quarantined pair without error",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				QuarantinedPairs: []types.QuarantinedPair{
+					types.NewQuarantinedPair(addr, valAddr, "", 1),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid - bonus beneficiaries",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				BonusBeneficiaries: []types.BonusBeneficiary{
+					types.NewBonusBeneficiary(addr, sdk.AccAddress([]byte("beneficiary"))),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid - duplicated bonus beneficiary",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				BonusBeneficiaries: []types.BonusBeneficiary{
+					types.NewBonusBeneficiary(addr, sdk.AccAddress([]byte("beneficiary"))),
+					types.NewBonusBeneficiary(addr, sdk.AccAddress([]byte("other"))),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid - bad bonus beneficiary address",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				BonusBeneficiaries: []types.BonusBeneficiary{
+					{DelegatorAddress: addr.String(), BeneficiaryAddress: "test"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid - reward remainders",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				RewardRemainders: []types.RewardRemainder{
+					types.NewRewardRemainder(addr, valAddr, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1)))),
+					types.NewRewardRemainder(addr, valAddr2, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1)))),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid - duplicated reward remainder",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				RewardRemainders: []types.RewardRemainder{
+					types.NewRewardRemainder(addr, valAddr, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1)))),
+					types.NewRewardRemainder(addr, valAddr, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(2, 1)))),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid - reward remainder of a whole coin",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				RewardRemainders: []types.RewardRemainder{
+					types.NewRewardRemainder(addr, valAddr, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1))),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid - empty reward remainder",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				RewardRemainders: []types.RewardRemainder{
+					types.NewRewardRemainder(addr, valAddr, sdk.NewDecCoins()),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid - entry escrows",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				LockedDelegations: []types.LockedDelegation{
+					types.NewLockedDelegation(addr, valAddr, []types.LockedDelegationEntry{
+						types.NewLockedDelegationEntry(math.LegacyOneDec(), types.DefaultRates[0], time.Now(), false, 1),
+						types.NewLockedDelegationEntry(math.LegacyOneDec(), types.DefaultRates[1], time.Now(), false, 2),
+					}),
+				},
+				EntryEscrows: []types.EntryEscrow{
+					types.NewEntryEscrow(1, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 10))),
+					types.NewEntryEscrow(2, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1)))),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid - duplicated entry escrow",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				LockedDelegations: []types.LockedDelegation{
+					types.NewLockedDelegation(addr, valAddr, []types.LockedDelegationEntry{
+						types.NewLockedDelegationEntry(math.LegacyOneDec(), types.DefaultRates[0], time.Now(), false, 1),
+					}),
+				},
+				EntryEscrows: []types.EntryEscrow{
+					types.NewEntryEscrow(1, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 10))),
+					types.NewEntryEscrow(1, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 5))),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid - entry escrow without entry",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				EntryEscrows: []types.EntryEscrow{
+					types.NewEntryEscrow(1, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 10))),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid - empty entry escrow",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				LockedDelegations: []types.LockedDelegation{
+					types.NewLockedDelegation(addr, valAddr, []types.LockedDelegationEntry{
+						types.NewLockedDelegationEntry(math.LegacyOneDec(), types.DefaultRates[0], time.Now(), false, 1),
+					}),
+				},
+				EntryEscrows: []types.EntryEscrow{
+					types.NewEntryEscrow(1, sdk.NewDecCoins()),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid - free redelegation validators and validator queues",
+			genState: types.GenesisState{
+				Params:                     types.DefaultParams(),
+				FreeRedelegationValidators: []string{valAddr.String(), valAddr2.String()},
+				SlashedValidatorQueue:      []string{valAddr.String()},
+				ExitedValidatorQueue:       []string{valAddr.String()},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid - duplicated free redelegation validator",
+			genState: types.GenesisState{
+				Params:                     types.DefaultParams(),
+				FreeRedelegationValidators: []string{valAddr.String(), valAddr.String()},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid - bad slashed validator queue address",
+			genState: types.GenesisState{
+				Params:                types.DefaultParams(),
+				SlashedValidatorQueue: []string{addr.String()},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid - duplicated exited validator queue address",
+			genState: types.GenesisState{
+				Params:               types.DefaultParams(),
+				ExitedValidatorQueue: []string{valAddr2.String(), valAddr2.String()},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid - compound queue",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				CompoundQueue: []types.LockingRewardCompound{
+					types.NewLockingRewardCompound(addr, valAddr, math.NewInt(10)),
+					types.NewLockingRewardCompound(addr, valAddr2, math.NewInt(5)),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid - duplicated compound queue pair",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				CompoundQueue: []types.LockingRewardCompound{
+					types.NewLockingRewardCompound(addr, valAddr, math.NewInt(10)),
+					types.NewLockingRewardCompound(addr, valAddr, math.NewInt(5)),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid - empty compound queue amount",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				CompoundQueue: []types.LockingRewardCompound{
+					types.NewLockingRewardCompound(addr, valAddr, math.ZeroInt()),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid - bad mint epoch",
+			genState: types.GenesisState{
+				Params:    types.DefaultParams(),
+				MintEpoch: types.NewMintEpoch(time.Now(), sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid - bad budget window",
+			genState: types.GenesisState{
+				Params:       types.DefaultParams(),
+				BudgetWindow: types.NewBudgetWindow(time.Now(), sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}),
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -2,12 +2,19 @@ package types
 
 import (
 	"encoding/binary"
+	fmt "fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
+const (
+	ErrInvalidIndexKey = "%s invalid index key: %x"
+	ErrInvalidStatsKey = "%s invalid stats key: %x"
+	ErrInvalidQueueKey = "%s invalid queue key: %x"
+)
+
 const (
 	// ModuleName is the name of the staking helper module
 	ModuleName = "locking"
@@ -17,6 +24,9 @@ const (
 
 	// RouterKey is the msg router key for the staking helper module
 	RouterKey = ModuleName
+
+	// RewardPoolName is the module account holding the locking reward pool
+	RewardPoolName = "locking_reward_pool"
 )
 
 // Parameter store keys
@@ -24,14 +34,48 @@ var (
 	ParamsKey = []byte("Params")
 
 	// Keys for store prefixes
-	LockedDelegationKey = []byte{0x11} // key for a locked delegation
+	LockedDelegationKey                 = []byte{0x11} // key for a locked delegation
+	LockedDelegationByValidatorIndexKey = []byte{0x12} // prefix for an index for looking up locked delegations by validator
 
 	// Queues
-	LockedDelegationsQueueKey = []byte{0x21} // The queue for unlocking locked delegations
+	LockedDelegationsQueueKey = []byte{0x21} // The queue for unlocking locked delegation entries
+	SlashedValidatorQueueKey  = []byte{0x22} // The queue for slashed validators waiting for the double sign check
+	ExitedValidatorQueueKey   = []byte{0x23} // The queue for validators that left the active set or were removed
+	CompoundQueueKey          = []byte{0x24} // The queue for locking rewards waiting to be compounded
 
 	// Counters
 	LockedDelegationEntryIDKey = []byte{0x31} // key for the incrementing counter id for locked delegation entry id
 	LockedDelegationIndexKey   = []byte{0x38} // prefix for an index for looking up locked delegation by their ID
+
+	// Validators
+	ValidatorSlashEventKey       = []byte{0x41} // key for the validator slash events
+	FreeRedelegationValidatorKey = []byte{0x42} // key for the validators with locks that can be redelegated freely
+
+	// Stats
+	ValidatorLockedSharesKey = []byte{0x51} // prefix for the locked shares per validator and rate duration
+	TotalLockedSharesKey     = []byte{0x52} // prefix for the module wide locked shares per rate duration
+
+	// Reward funding
+	RewardDebtKey = []byte{0x61} // prefix for the locking rewards owed to a delegator on a validator
+	MintEpochKey  = []byte{0x62} // key for the current hybrid funding mint epoch
+
+	// Bonus beneficiaries
+	BonusBeneficiaryKey = []byte{0x63} // prefix for the address receiving the locking rewards of a delegator
+
+	// Reward remainders
+	RewardRemainderKey = []byte{0x64} // prefix for the truncated locking rewards of a delegator on a validator
+
+	// Entry escrows
+	EntryEscrowKey = []byte{0x65} // prefix for the locking rewards held for an entry until it reaches its unlock time
+
+	// Budget
+	BudgetWindowKey = []byte{0x71} // key for the current locking rewards budget window
+
+	// Rewards accrual
+	AccrualCheckpointKey = []byte{0x81} // prefix for the locking rewards accrued by a delegator on a validator
+
+	// Expiry
+	QuarantinedPairKey = []byte{0x91} // prefix for the locked delegation pairs that couldn't be completed
 )
 
 // GetLockedDelegationsKey creates the prefix for a locked delegation for one validators
@@ -44,16 +88,180 @@ func GetLockedDelegationPerDelegatorKey(delAddr sdk.AccAddress) []byte {
 	return append(LockedDelegationKey, address.MustLengthPrefix(delAddr)...)
 }
 
-// GetLockedDelegationTimeKey returns a timed key for a locked delegation
-// used for queuing unlocking delegations
+// GetLockedDelegationByValidatorIndexKey returns a key for the index for looking up a locked delegation by its validator
+func GetLockedDelegationByValidatorIndexKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetLockedDelegationsByValidatorIndexKey(valAddr), address.MustLengthPrefix(delAddr)...)
+}
+
+// GetLockedDelegationsByValidatorIndexKey creates the prefix of the index for all locked delegations on a validator
+func GetLockedDelegationsByValidatorIndexKey(valAddr sdk.ValAddress) []byte {
+	return append(LockedDelegationByValidatorIndexKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// ParseLockedDelegationByValidatorIndexKey returns the delegator address from a
+// validator index key without the validator prefix
+func ParseLockedDelegationByValidatorIndexKey(key []byte) (sdk.AccAddress, error) {
+	if len(key) == 0 || len(key) != int(key[0])+1 {
+		return nil, fmt.Errorf(ErrInvalidIndexKey, ModuleName, key)
+	}
+	return sdk.AccAddress(key[1:]), nil
+}
+
+// GetLockedDelegationTimeKey returns the prefix for the queued locked delegation entries unlocking at a timestamp
 func GetLockedDelegationTimeKey(timestamp time.Time) []byte {
 	bz := sdk.FormatTimeBytes(timestamp)
 	return append(LockedDelegationsQueueKey, bz...)
 }
 
+// GetLockedDelegationQueueKey returns a key for a locked delegation entry on the unlocking queue
+func GetLockedDelegationQueueKey(timestamp time.Time, id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(GetLockedDelegationTimeKey(timestamp), bz...)
+}
+
+// ParseLockedDelegationQueueKey returns the unlock time and the entry id from a queue key without the prefix
+func ParseLockedDelegationQueueKey(key []byte) (time.Time, uint64, error) {
+	if len(key) <= 8 {
+		return time.Time{}, 0, fmt.Errorf(ErrInvalidQueueKey, ModuleName, key)
+	}
+	timestamp, err := sdk.ParseTimeBytes(key[:len(key)-8])
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf(ErrInvalidQueueKey, ModuleName, key)
+	}
+	return timestamp, binary.BigEndian.Uint64(key[len(key)-8:]), nil
+}
+
 // GetLockedDelegationIndexKey returns a key for the index for looking up a locked delegation by the entries it contain
 func GetLockedDelegationIndexKey(id uint64) []byte {
 	bz := make([]byte, 8)
 	binary.BigEndian.PutUint64(bz, id)
 	return append(LockedDelegationIndexKey, bz...)
 }
+
+// GetSlashedValidatorQueueKey returns a key for a slashed validator on the double sign check queue
+func GetSlashedValidatorQueueKey(valAddr sdk.ValAddress) []byte {
+	return append(SlashedValidatorQueueKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorSlashEventsPerValidatorKey creates the prefix for all the slash events of a validator
+func GetValidatorSlashEventsPerValidatorKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorSlashEventKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorSlashEventsKey returns a key for the slash events of a validator at a height
+func GetValidatorSlashEventsKey(valAddr sdk.ValAddress, height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(GetValidatorSlashEventsPerValidatorKey(valAddr), bz...)
+}
+
+// GetExitedValidatorQueueKey returns a key for a validator on the exited validators queue
+func GetExitedValidatorQueueKey(valAddr sdk.ValAddress) []byte {
+	return append(ExitedValidatorQueueKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetCompoundQueueKey returns a key for the locking rewards of a delegator on a validator waiting to be compounded
+func GetCompoundQueueKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(CompoundQueueKey, address.MustLengthPrefix(delAddr)...), address.MustLengthPrefix(valAddr)...)
+}
+
+// ParseCompoundQueueKey returns the delegator and validator addresses from a compound queue key without the prefix
+func ParseCompoundQueueKey(key []byte) (sdk.AccAddress, sdk.ValAddress, error) {
+	if len(key) == 0 || len(key) < int(key[0])+2 {
+		return nil, nil, fmt.Errorf(ErrInvalidQueueKey, ModuleName, key)
+	}
+	delAddr := sdk.AccAddress(key[1 : key[0]+1])
+	valKey := key[key[0]+1:]
+	if len(valKey) != int(valKey[0])+1 {
+		return nil, nil, fmt.Errorf(ErrInvalidQueueKey, ModuleName, key)
+	}
+	return delAddr, sdk.ValAddress(valKey[1:]), nil
+}
+
+// GetFreeRedelegationValidatorKey returns a key for a validator with locks that can be redelegated freely
+func GetFreeRedelegationValidatorKey(valAddr sdk.ValAddress) []byte {
+	return append(FreeRedelegationValidatorKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorLockedSharesPerValidatorKey creates the prefix for all the locked shares of a validator
+func GetValidatorLockedSharesPerValidatorKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLockedSharesKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorLockedSharesKey returns a key for the locked shares of a validator with a rate duration
+func GetValidatorLockedSharesKey(valAddr sdk.ValAddress, duration time.Duration) []byte {
+	return append(GetValidatorLockedSharesPerValidatorKey(valAddr), durationBytes(duration)...)
+}
+
+// ParseValidatorLockedSharesKey returns the validator address and the rate duration
+// from a validator locked shares key without the prefix
+func ParseValidatorLockedSharesKey(key []byte) (sdk.ValAddress, time.Duration, error) {
+	if len(key) == 0 || len(key) != int(key[0])+1+8 {
+		return nil, 0, fmt.Errorf(ErrInvalidStatsKey, ModuleName, key)
+	}
+	valAddrLen := int(key[0])
+	return sdk.ValAddress(key[1 : valAddrLen+1]), parseDurationBytes(key[valAddrLen+1:]), nil
+}
+
+// GetTotalLockedSharesKey returns a key for the module wide locked shares with a rate duration
+func GetTotalLockedSharesKey(duration time.Duration) []byte {
+	return append(TotalLockedSharesKey, durationBytes(duration)...)
+}
+
+// ParseTotalLockedSharesKey returns the rate duration from a total locked shares key without the prefix
+func ParseTotalLockedSharesKey(key []byte) (time.Duration, error) {
+	if len(key) != 8 {
+		return 0, fmt.Errorf(ErrInvalidStatsKey, ModuleName, key)
+	}
+	return parseDurationBytes(key), nil
+}
+
+// durationBytes returns the big endian representation of a duration, rate durations are always positive
+func durationBytes(duration time.Duration) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(duration))
+	return bz
+}
+
+// parseDurationBytes returns a duration from its big endian representation
+func parseDurationBytes(bz []byte) time.Duration {
+	return time.Duration(binary.BigEndian.Uint64(bz))
+}
+
+// GetRewardDebtsPerDelegatorKey creates the prefix for all the reward debts of a delegator
+func GetRewardDebtsPerDelegatorKey(delAddr sdk.AccAddress) []byte {
+	return append(RewardDebtKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetRewardDebtKey returns a key for the reward debt of a delegator on a validator
+func GetRewardDebtKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetRewardDebtsPerDelegatorKey(delAddr), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetBonusBeneficiaryKey returns a key for the bonus beneficiary of a delegator
+func GetBonusBeneficiaryKey(delAddr sdk.AccAddress) []byte {
+	return append(BonusBeneficiaryKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetRewardRemainderKey returns a key for the reward remainder of a delegator on a validator
+func GetRewardRemainderKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(RewardRemainderKey, address.MustLengthPrefix(delAddr)...), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetEntryEscrowKey returns a key for the escrow of a locked delegation entry
+func GetEntryEscrowKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(EntryEscrowKey, bz...)
+}
+
+// GetAccrualCheckpointKey returns a key for the accrual checkpoint of a delegator on a validator
+func GetAccrualCheckpointKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(AccrualCheckpointKey, address.MustLengthPrefix(delAddr)...), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetQuarantinedPairKey returns a key for a quarantined locked delegation pair
+func GetQuarantinedPairKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(QuarantinedPairKey, address.MustLengthPrefix(delAddr)...), address.MustLengthPrefix(valAddr)...)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/aetherevm/locking/locking/types"
@@ -126,6 +127,27 @@ func (suite *KeysTestSuite) TestGetLockedDelegationTimeKey() {
 	}
 }
 
+// TestLockedDelegationQueueKey tests the locked delegations queue entry key generation and parsing
+func (suite *KeysTestSuite) TestLockedDelegationQueueKey() {
+	timestamp := time.Time{}.Add(time.Nanosecond)
+
+	keyBytes := types.GetLockedDelegationQueueKey(timestamp, 1)
+	suite.Require().Equal(
+		"21303030312d30312d30315430303a30303a30302e3030303030303030310000000000000001",
+		hex.EncodeToString(keyBytes),
+	)
+
+	// Parsing removes the prefix
+	parsedTime, id, err := types.ParseLockedDelegationQueueKey(keyBytes[len(types.LockedDelegationsQueueKey):])
+	suite.Require().NoError(err)
+	suite.Require().True(timestamp.Equal(parsedTime))
+	suite.Require().Equal(uint64(1), id)
+
+	// A key without the entry id can't be parsed
+	_, _, err = types.ParseLockedDelegationQueueKey(types.GetLockedDelegationTimeKey(timestamp)[len(types.LockedDelegationsQueueKey):])
+	suite.Require().Error(err)
+}
+
 // TestGetLockedDelegationIndexKey tests the locked delegations index key generation
 func (suite *KeysTestSuite) TestGetLockedDelegationIndexKey() {
 	testCases := []struct {
@@ -157,3 +179,55 @@ func (suite *KeysTestSuite) TestGetLockedDelegationIndexKey() {
 		suite.Require().Equal(tc.expHexKey, key, tc.name)
 	}
 }
+
+// TestValidatorLockedSharesKey tests the validator locked shares key generation and parsing
+func (suite *KeysTestSuite) TestValidatorLockedSharesKey() {
+	valAddr, err := sdk.ValAddressFromBech32("cosmosvaloper1qql8ag4cluz6r4dz28p3w00dnc9w8ueu6u7aht")
+	suite.Require().NoError(err)
+
+	keyBytes := types.GetValidatorLockedSharesKey(valAddr, time.Hour)
+	suite.Require().Equal(
+		"5114003e7ea2b8ff05a1d5a251c3173ded9e0ae3f33c0000034630b8a000",
+		hex.EncodeToString(keyBytes),
+	)
+
+	// Parsing removes the prefix
+	parsedValAddr, duration, err := types.ParseValidatorLockedSharesKey(keyBytes[len(types.ValidatorLockedSharesKey):])
+	suite.Require().NoError(err)
+	suite.Require().Equal(valAddr, parsedValAddr)
+	suite.Require().Equal(time.Hour, duration)
+
+	// A key with a wrong size can't be parsed
+	_, _, err = types.ParseValidatorLockedSharesKey(keyBytes[len(types.ValidatorLockedSharesKey) : len(keyBytes)-1])
+	suite.Require().Error(err)
+
+	// The total key only carries the duration
+	keyBytes = types.GetTotalLockedSharesKey(time.Hour)
+	suite.Require().Equal("520000034630b8a000", hex.EncodeToString(keyBytes))
+	duration, err = types.ParseTotalLockedSharesKey(keyBytes[len(types.TotalLockedSharesKey):])
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Hour, duration)
+}
+
+// TestCompoundQueueKey tests the compound queue key generation and parsing
+func (suite *KeysTestSuite) TestCompoundQueueKey() {
+	delAddr := sdk.AccAddress([]byte("address"))
+	valAddr := sdk.ValAddress([]byte("validator"))
+
+	keyBytes := types.GetCompoundQueueKey(delAddr, valAddr)
+	suite.Require().Equal("2407616464726573730976616c696461746f72", hex.EncodeToString(keyBytes))
+
+	// Parsing removes the prefix
+	parsedDelAddr, parsedValAddr, err := types.ParseCompoundQueueKey(keyBytes[len(types.CompoundQueueKey):])
+	suite.Require().NoError(err)
+	suite.Require().Equal(delAddr, parsedDelAddr)
+	suite.Require().Equal(valAddr, parsedValAddr)
+
+	// Keys with a wrong size can't be parsed
+	_, _, err = types.ParseCompoundQueueKey(keyBytes[len(types.CompoundQueueKey) : len(keyBytes)-1])
+	suite.Require().Error(err)
+	_, _, err = types.ParseCompoundQueueKey(keyBytes[len(types.CompoundQueueKey) : len(delAddr)+2])
+	suite.Require().Error(err)
+	_, _, err = types.ParseCompoundQueueKey(nil)
+	suite.Require().Error(err)
+}
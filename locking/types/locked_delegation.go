@@ -18,6 +18,10 @@ const (
 	ErrLockDurationInvalid     = "%s invalid lock duration: %s"
 	ErrUnlockOnInvalid         = "%s invalid unlock time: %s"
 	ErrAuthorityInvalid        = "%s invalid authority address: %s"
+	ErrEntryIdsEmpty           = "%s entry ids cannot be empty"
+	ErrExpiryActionInvalid     = "%s invalid expiry action: %s"
+	ErrRedelegateToInvalid     = "%s invalid redelegate to address: %s"
+	ErrRedelegateToNotEmpty    = "%s redelegate to address must be empty for the %s expiry action"
 
 	ErrEntryNotUnique = "%s locked delegation entry not unique: %s"
 )
@@ -80,18 +84,11 @@ func (ld LockedDelegation) GetValidatorAddr() (sdk.ValAddress, error) {
 }
 
 // AddEntry - append entry to the locked delegation
-func (ld *LockedDelegation) AddEntry(entry LockedDelegationEntry) {
-	index := -1
-
+// It returns the stored entry, which has the shares of an entry with the same values when it's merged
+func (ld *LockedDelegation) AddEntry(entry LockedDelegationEntry) LockedDelegationEntry {
 	// Let's say that we have one entry with the same values
 	// First find the index
-	for i, currentEntry := range ld.Entries {
-		if currentEntry.Rate.Equal(&entry.Rate) &&
-			currentEntry.AutoRenew == entry.AutoRenew &&
-			currentEntry.UnlockOn == entry.UnlockOn {
-			index = i
-		}
-	}
+	index := ld.matchingEntryIndex(entry)
 
 	// Now based on the index we update the values of the old entry or just add it
 	if index != -1 {
@@ -100,10 +97,56 @@ func (ld *LockedDelegation) AddEntry(entry LockedDelegationEntry) {
 
 		// Update the entry
 		ld.Entries[index] = ldEntry
-	} else {
-		// If we don't find we just append
-		ld.Entries = append(ld.Entries, entry)
+		return ldEntry
+	}
+
+	// If we don't find we just append
+	ld.Entries = append(ld.Entries, entry)
+	return entry
+}
+
+// AppendEntry appends an entry to the locked delegation without merging it
+// It's used for the entries that keep their ID, like the renewed, redelegated or split ones
+func (ld *LockedDelegation) AppendEntry(entry LockedDelegationEntry) {
+	ld.Entries = append(ld.Entries, entry)
+}
+
+// MatchingEntry returns the entry an entry with the same values is merged into by AddEntry
+func (ld LockedDelegation) MatchingEntry(entry LockedDelegationEntry) (LockedDelegationEntry, bool) {
+	index := ld.matchingEntryIndex(entry)
+	if index == -1 {
+		return LockedDelegationEntry{}, false
+	}
+	return ld.Entries[index], true
+}
+
+// matchingEntryIndex returns the index of the last entry with the same values as an entry, -1 if there's none
+func (ld LockedDelegation) matchingEntryIndex(entry LockedDelegationEntry) int {
+	index := -1
+	for i, currentEntry := range ld.Entries {
+		if currentEntry.Rate.Equal(&entry.Rate) &&
+			currentEntry.AutoRenew == entry.AutoRenew &&
+			currentEntry.UnlockOn == entry.UnlockOn &&
+			currentEntry.ExpiryAction == entry.ExpiryAction &&
+			currentEntry.RedelegateTo == entry.RedelegateTo {
+			index = i
+		}
 	}
+	return index
+}
+
+// LatestUnlockingEntry returns the entry with the latest unlock time among the ones still locked after a time
+func (ld LockedDelegation) LatestUnlockingEntry(after time.Time) (entry LockedDelegationEntry, found bool) {
+	for _, currentEntry := range ld.Entries {
+		if !currentEntry.UnlockOn.After(after) {
+			continue
+		}
+		if !found || currentEntry.UnlockOn.After(entry.UnlockOn) {
+			entry = currentEntry
+			found = true
+		}
+	}
+	return entry, found
 }
 
 // RemoveEntryForIndex removes a single locked delegation entry from the entries list based on the index
@@ -188,6 +231,39 @@ func (ld LockedDelegation) CalculateDelegationRatio(shares math.LegacyDec) (rati
 	return delegationRatio.Mul(weightedRatio)
 }
 
+// EntryRewardShare returns the share of the locked delegation rewards earned by an entry
+// Each entry contributes to the rewards by its shares multiplied by its rate
+func (ld LockedDelegation) EntryRewardShare(id uint64) math.LegacyDec {
+	entryWeight := math.LegacyZeroDec()
+	weight := math.LegacyZeroDec()
+	for _, entry := range ld.Entries {
+		entryRate := entry.Shares.Mul(entry.Rate.Rate)
+		if entry.Id == id {
+			entryWeight = entryWeight.Add(entryRate)
+		}
+		weight = weight.Add(entryRate)
+	}
+
+	// We don't want to divide by zero
+	if weight.IsZero() {
+		return math.LegacyZeroDec()
+	}
+
+	return entryWeight.Quo(weight)
+}
+
+// StreamingRewardShare returns the share of the locking rewards paid right away earned by an entry
+// The entries holding their rewards in escrow don't take part of them
+func (ld LockedDelegation) StreamingRewardShare(id uint64, currentTime time.Time) math.LegacyDec {
+	streaming := LockedDelegation{}
+	for _, entry := range ld.Entries {
+		if !entry.EscrowsRewards(currentTime) {
+			streaming.Entries = append(streaming.Entries, entry)
+		}
+	}
+	return streaming.EntryRewardShare(id)
+}
+
 // ToggleAutoRenewForID - toggle a entry auto renew based on it's id
 func (ld *LockedDelegation) ToggleAutoRenewForID(id uint64) (entry LockedDelegationEntry, found bool) {
 	// Find the entry and update the locked delegation auto renew
@@ -201,6 +277,45 @@ func (ld *LockedDelegation) ToggleAutoRenewForID(id uint64) (entry LockedDelegat
 	return LockedDelegationEntry{}, false
 }
 
+// SetExpiryActionForID - set a entry expiry action based on it's id
+func (ld *LockedDelegation) SetExpiryActionForID(
+	id uint64, expiryAction ExpiryAction, redelegateTo string,
+) (entry LockedDelegationEntry, found bool) {
+	for i, currentEntry := range ld.Entries {
+		if currentEntry.Id == id {
+			ld.Entries[i].ExpiryAction = expiryAction
+			ld.Entries[i].RedelegateTo = redelegateTo
+			return ld.Entries[i], true
+		}
+	}
+
+	return LockedDelegationEntry{}, false
+}
+
+// ExtendEntryForID moves a entry to a new rate based on it's id
+// The new unlock time is the current time plus the rate duration, but never earlier than the previous unlock time
+// It returns the entry before and after the update
+func (ld *LockedDelegation) ExtendEntryForID(
+	id uint64, rate Rate, currTime time.Time,
+) (previous, entry LockedDelegationEntry, found bool) {
+	for i, currentEntry := range ld.Entries {
+		if currentEntry.Id != id {
+			continue
+		}
+
+		unlockOn := currTime.Add(rate.Duration)
+		if unlockOn.Before(currentEntry.UnlockOn) {
+			unlockOn = currentEntry.UnlockOn
+		}
+
+		ld.Entries[i].Rate = rate
+		ld.Entries[i].UnlockOn = unlockOn
+		return currentEntry, ld.Entries[i], true
+	}
+
+	return LockedDelegationEntry{}, LockedDelegationEntry{}, false
+}
+
 // EntriesForIds checks if the list of Ids exists as locked delegation entries
 // returns false if a the locked delegation if empty of a single entry doesn't exists
 // returns the entries if all ids exists
@@ -246,6 +361,41 @@ func (ld LockedDelegation) EntriesForIds(ids []uint64) (exists bool, entries []L
 	return true, entriesFound
 }
 
+// SplitEntry splits a locked delegation entry in two new entries with the given ids
+// the first entry holds the given shares and the second the remaining ones
+// The new entries replace the original entry in place, so they are not merged by AddEntry
+// Existing entries are moved with AppendEntry, so the halves are never merged back
+func (ld *LockedDelegation) SplitEntry(
+	id uint64, shares math.LegacyDec, splitID, remainderID uint64,
+) (split, remainder LockedDelegationEntry, err error) {
+	for i, entry := range ld.Entries {
+		if entry.Id != id {
+			continue
+		}
+
+		// The shares must leave something in both entries
+		if !shares.IsPositive() || shares.GTE(entry.Shares) {
+			return split, remainder, ErrInvalidSplitShares
+		}
+
+		split = NewLockedDelegationEntry(shares, entry.Rate, entry.UnlockOn, entry.AutoRenew, splitID)
+		split.ExpiryAction, split.RedelegateTo = entry.ExpiryAction, entry.RedelegateTo
+		remainder = NewLockedDelegationEntry(entry.Shares.Sub(shares), entry.Rate, entry.UnlockOn, entry.AutoRenew, remainderID)
+		remainder.ExpiryAction, remainder.RedelegateTo = entry.ExpiryAction, entry.RedelegateTo
+
+		// Replace the original entry and insert the remainder right after it
+		entries := make([]LockedDelegationEntry, 0, len(ld.Entries)+1)
+		entries = append(entries, ld.Entries[:i]...)
+		entries = append(entries, split, remainder)
+		entries = append(entries, ld.Entries[i+1:]...)
+		ld.Entries = entries
+
+		return split, remainder, nil
+	}
+
+	return split, remainder, ErrLockedDelegationEntryNotFound
+}
+
 // NewLockedDelegationEntry returns a new locked delegation entry
 func NewLockedDelegationEntry(
 	shares math.LegacyDec,
@@ -274,6 +424,24 @@ func (lde LockedDelegationEntry) Validate() error {
 	if err := ValidateNonZeroTime(lde.UnlockOn); err != nil {
 		return fmt.Errorf(ErrUnlockOnInvalid, ModuleName, err)
 	}
+	return ValidateExpiryAction(lde.ExpiryAction, lde.RedelegateTo)
+}
+
+// ValidateExpiryAction validates an expiry action with its redelegation validator
+// The validator is required for the redelegate action and must be empty for the others
+func ValidateExpiryAction(expiryAction ExpiryAction, redelegateTo string) error {
+	if _, exists := ExpiryAction_name[int32(expiryAction)]; !exists {
+		return ErrInvalidExpiryAction.Wrapf(ErrExpiryActionInvalid, ModuleName, expiryAction)
+	}
+	if expiryAction != ExpiryActionRedelegate {
+		if redelegateTo != "" {
+			return ErrInvalidExpiryAction.Wrapf(ErrRedelegateToNotEmpty, ModuleName, expiryAction)
+		}
+		return nil
+	}
+	if _, err := sdk.ValAddressFromBech32(redelegateTo); err != nil {
+		return ErrInvalidExpiryAction.Wrapf(ErrRedelegateToInvalid, ModuleName, err)
+	}
 	return nil
 }
 
@@ -287,28 +455,34 @@ func (lde LockedDelegationEntry) Expired(currentTime time.Time) bool {
 	return !currentTime.Before(lde.UnlockOn)
 }
 
+// EscrowsRewards returns true if the entry locking rewards are held in escrow until it reaches its unlock time
+func (lde LockedDelegationEntry) EscrowsRewards(currentTime time.Time) bool {
+	return lde.Rate.PayoutMode == PayoutModeAtMaturity && !lde.Expired(currentTime)
+}
+
+// TimeRemaining returns the time left until the entry unlocks, zero if it already expired
+func (lde LockedDelegationEntry) TimeRemaining(currentTime time.Time) time.Duration {
+	if lde.Expired(currentTime) {
+		return 0
+	}
+	return lde.UnlockOn.Sub(currentTime)
+}
+
 // String implements the Stringer interface for a LockedDelegationPair object
 func (dv LockedDelegationPair) String() string {
 	out, _ := yaml.Marshal(dv)
 	return string(out)
 }
 
-// String implements the Stringer interface for a LockedDelegationPair object
-func (dv LockedDelegationPairs) UniquePairs() []LockedDelegationPair {
-	uniquePairsMap := make(map[string]LockedDelegationPair)
-	for _, pair := range dv.Pairs {
-		// Save unique pairs
-		key := pair.String()
-		uniquePairsMap[key] = pair
+// Validate validates a LockedDelegationPair
+func (dv LockedDelegationPair) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(dv.DelegatorAddress); err != nil {
+		return fmt.Errorf(ErrDelegatorAddressInvalid, ModuleName, err)
 	}
-
-	// Convert map back to slice
-	uniquePairs := make([]LockedDelegationPair, 0, len(dv.Pairs))
-	for _, pair := range uniquePairsMap {
-		uniquePairs = append(uniquePairs, pair)
+	if _, err := sdk.ValAddressFromBech32(dv.ValidatorAddress); err != nil {
+		return fmt.Errorf(ErrValidatorAddressInvalid, ModuleName, err)
 	}
-
-	return uniquePairs
+	return nil
 }
 
 // CalculateSharesForLDEntry calculates the shares for a locked delegation entry based on a validator
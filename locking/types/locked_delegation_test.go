@@ -213,8 +213,9 @@ func (suite *LockedDelegationTestSuite) TestLockedDelegationAddRemoveEntry() {
 	suite.Require().ElementsMatch(lockedDelegation.Entries, []types.LockedDelegationEntry{entry})
 
 	// Add the same entry again, only the shares should be updated
-	lockedDelegation.AddEntry(entryDiffShares)
+	merged := lockedDelegation.AddEntry(entryDiffShares)
 	suite.Require().Equal(len(lockedDelegation.Entries), 1)
+	suite.Require().Equal(lockedDelegation.Entries[0], merged)
 
 	// Shares also should match
 	newShares := entry.Shares.Add(entryDiffShares.Shares)
@@ -229,6 +230,13 @@ func (suite *LockedDelegationTestSuite) TestLockedDelegationAddRemoveEntry() {
 	lockedDelegation.AddEntry(differentValuesEntry)
 	suite.Require().Equal(len(lockedDelegation.Entries), 2)
 
+	// Entries with a different expiry action aren't merged
+	stayDelegatedEntry := entry
+	stayDelegatedEntry.ExpiryAction = types.ExpiryActionStayDelegated
+	lockedDelegation.AddEntry(stayDelegatedEntry)
+	suite.Require().Equal(len(lockedDelegation.Entries), 3)
+	lockedDelegation.RemoveEntryForIndex(2)
+
 	// Shares also should match
 	newShares = newShares.Add(differentValuesEntry.Shares.Add(differentValuesEntry.Shares))
 	suite.Require().Equal(lockedDelegation.TotalShares(), newShares)
@@ -771,6 +779,39 @@ func (suite *LockedDelegationTestSuite) TestLockedDelegationEntryExpired() {
 	}
 }
 
+// TestLockedDelegationEntryTimeRemaining tests the TimeRemaining function
+func (suite *LockedDelegationTestSuite) TestLockedDelegationEntryTimeRemaining() {
+	entry := types.LockedDelegationEntry{
+		UnlockOn: time.Time{}.Add(time.Hour),
+		Shares:   math.LegacyNewDec(5),
+	}
+
+	suite.Require().Equal(time.Hour, entry.TimeRemaining(time.Time{}))
+	suite.Require().Equal(time.Millisecond, entry.TimeRemaining(time.Time{}.Add(time.Hour-time.Millisecond)))
+	suite.Require().Equal(time.Duration(0), entry.TimeRemaining(time.Time{}.Add(time.Hour)))
+	suite.Require().Equal(time.Duration(0), entry.TimeRemaining(time.Time{}.Add(2*time.Hour)))
+}
+
+// TestLockedDelegationEntryRewardShare tests the EntryRewardShare function
+func (suite *LockedDelegationTestSuite) TestLockedDelegationEntryRewardShare() {
+	addr := sdk.AccAddress([]byte("address"))
+	valAddr := sdk.ValAddress([]byte("val"))
+	lockedDelegation := types.NewLockedDelegation(addr, valAddr, []types.LockedDelegationEntry{
+		{Id: 1, Shares: math.LegacyNewDec(50), Rate: types.Rate{Rate: math.LegacyNewDec(5)}},
+		{Id: 2, Shares: math.LegacyNewDec(50), Rate: types.Rate{Rate: math.LegacyNewDec(15)}},
+		{Id: 3, Shares: math.LegacyNewDec(100), Rate: types.Rate{Rate: math.LegacyZeroDec()}},
+	})
+
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("0.25"), lockedDelegation.EntryRewardShare(1))
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("0.75"), lockedDelegation.EntryRewardShare(2))
+	suite.Require().True(lockedDelegation.EntryRewardShare(3).IsZero())
+	suite.Require().True(lockedDelegation.EntryRewardShare(4).IsZero())
+
+	// No weight at all
+	empty := types.NewLockedDelegation(addr, valAddr, nil)
+	suite.Require().True(empty.EntryRewardShare(1).IsZero())
+}
+
 // TestLockedDelegationToggleAutoRenewForId tests the ToggleAutoRenewForId function
 func (suite *LockedDelegationTestSuite) TestLockedDelegationToggleAutoRenewForId() {
 	addr := sdk.AccAddress([]byte("address"))
@@ -880,6 +921,52 @@ func (suite *LockedDelegationTestSuite) TestLockedDelegationToggleAutoRenewForId
 	}
 }
 
+// TestLockedDelegationSetExpiryActionForID tests the expiry action update of a locked delegation entry
+func (suite *LockedDelegationTestSuite) TestLockedDelegationSetExpiryActionForID() {
+	addr := sdk.AccAddress([]byte("address"))
+	valAddr := sdk.ValAddress([]byte("val"))
+	dstValAddr := sdk.ValAddress([]byte("val2"))
+	rate := types.DefaultRates[0]
+
+	lockedDelegation := types.NewLockedDelegation(addr, valAddr, []types.LockedDelegationEntry{
+		types.NewLockedDelegationEntry(math.LegacyNewDec(5), rate, time.Unix(100, 0), false, 1),
+		types.NewLockedDelegationEntry(math.LegacyNewDec(7), rate, time.Unix(200, 0), false, 2),
+	})
+	suite.Require().Equal(types.ExpiryActionUndelegate, lockedDelegation.Entries[0].ExpiryAction)
+
+	// Only the requested entry is updated
+	entry, found := lockedDelegation.SetExpiryActionForID(2, types.ExpiryActionRedelegate, dstValAddr.String())
+	suite.Require().True(found)
+	suite.Require().Equal(types.ExpiryActionRedelegate, entry.ExpiryAction)
+	suite.Require().Equal(dstValAddr.String(), entry.RedelegateTo)
+	suite.Require().Equal(entry, lockedDelegation.Entries[1])
+	suite.Require().Equal(types.ExpiryActionUndelegate, lockedDelegation.Entries[0].ExpiryAction)
+
+	// The split entries keep the expiry action
+	split, remainder, err := lockedDelegation.SplitEntry(2, math.LegacyNewDec(3), 3, 4)
+	suite.Require().NoError(err)
+	suite.Require().Equal(entry.ExpiryAction, split.ExpiryAction)
+	suite.Require().Equal(entry.RedelegateTo, remainder.RedelegateTo)
+
+	// Unknown IDs aren't found
+	_, found = lockedDelegation.SetExpiryActionForID(10, types.ExpiryActionStayDelegated, "")
+	suite.Require().False(found)
+}
+
+// TestValidateExpiryAction tests the expiry action validation with its redelegation validator
+func (suite *LockedDelegationTestSuite) TestValidateExpiryAction() {
+	valAddr := sdk.ValAddress([]byte("val"))
+
+	suite.Require().NoError(types.ValidateExpiryAction(types.ExpiryActionUndelegate, ""))
+	suite.Require().NoError(types.ValidateExpiryAction(types.ExpiryActionStayDelegated, ""))
+	suite.Require().NoError(types.ValidateExpiryAction(types.ExpiryActionRedelegate, valAddr.String()))
+
+	suite.Require().ErrorIs(types.ValidateExpiryAction(types.ExpiryAction(3), ""), types.ErrInvalidExpiryAction)
+	suite.Require().ErrorIs(types.ValidateExpiryAction(types.ExpiryActionRedelegate, ""), types.ErrInvalidExpiryAction)
+	suite.Require().ErrorIs(types.ValidateExpiryAction(types.ExpiryActionRedelegate, "test"), types.ErrInvalidExpiryAction)
+	suite.Require().ErrorIs(types.ValidateExpiryAction(types.ExpiryActionStayDelegated, valAddr.String()), types.ErrInvalidExpiryAction)
+}
+
 // TestGetValidatorAddrBadPath tests a specific bad path were LockedDelegation can't get validatorAddress
 func (suite *LockedDelegationTestSuite) TestGetValidatorAddrBadPath() {
 	// Build a lockedDelegation where the validator is empty
@@ -1264,3 +1351,240 @@ func (suite *LockedDelegationTestSuite) TestERemoveEntries() {
 		suite.Require().NotContains(removedEntries, tc.lockedDelegation.Entries, tc.name)
 	}
 }
+
+// TestSplitEntry tests the locked delegation entry split
+func (suite *LockedDelegationTestSuite) TestSplitEntry() {
+	defaultRate := types.DefaultRates[0]
+	unlockOn := time.Unix(100, 0).UTC()
+
+	testCases := []struct {
+		name     string
+		id       uint64
+		shares   math.LegacyDec
+		expError error
+	}{
+		{
+			"pass - split the first entry",
+			3,
+			math.LegacyNewDec(4),
+			nil,
+		},
+		{
+			"pass - split the last entry",
+			15,
+			math.LegacyNewDecWithPrec(5, 1),
+			nil,
+		},
+		{
+			"fail - entry not found",
+			4,
+			math.LegacyNewDec(1),
+			types.ErrLockedDelegationEntryNotFound,
+		},
+		{
+			"fail - zero shares",
+			3,
+			math.LegacyZeroDec(),
+			types.ErrInvalidSplitShares,
+		},
+		{
+			"fail - all the entry shares",
+			3,
+			math.LegacyNewDec(10),
+			types.ErrInvalidSplitShares,
+		},
+	}
+
+	for _, tc := range testCases {
+		lockedDelegation := types.LockedDelegation{
+			Entries: []types.LockedDelegationEntry{
+				types.NewLockedDelegationEntry(math.LegacyNewDec(10), defaultRate, unlockOn, true, 3),
+				types.NewLockedDelegationEntry(math.LegacyNewDec(10), defaultRate, unlockOn, true, 7),
+				types.NewLockedDelegationEntry(math.LegacyOneDec(), defaultRate, unlockOn, false, 15),
+			},
+		}
+		original := copyLockedDelegation(lockedDelegation)
+
+		split, remainder, err := lockedDelegation.SplitEntry(tc.id, tc.shares, 20, 21)
+
+		if tc.expError != nil {
+			suite.Require().ErrorIs(err, tc.expError, tc.name)
+			suite.Require().Equal(original, lockedDelegation, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+
+		// Both new entries keep the original values
+		exists, originalEntries := original.EntriesForIds([]uint64{tc.id})
+		suite.Require().True(exists, tc.name)
+		originalEntry := originalEntries[0]
+		suite.Require().Equal(
+			types.NewLockedDelegationEntry(tc.shares, originalEntry.Rate, originalEntry.UnlockOn, originalEntry.AutoRenew, 20),
+			split,
+			tc.name,
+		)
+		suite.Require().Equal(
+			types.NewLockedDelegationEntry(originalEntry.Shares.Sub(tc.shares), originalEntry.Rate, originalEntry.UnlockOn, originalEntry.AutoRenew, 21),
+			remainder,
+			tc.name,
+		)
+
+		// The halves are not merged and the original entry is gone
+		suite.Require().Len(lockedDelegation.Entries, len(original.Entries)+1, tc.name)
+		exists, _ = lockedDelegation.EntriesForIds([]uint64{tc.id})
+		suite.Require().False(exists, tc.name)
+		exists, _ = lockedDelegation.EntriesForIds([]uint64{20, 21})
+		suite.Require().True(exists, tc.name)
+		suite.Require().Equal(original.TotalShares(), lockedDelegation.TotalShares(), tc.name)
+	}
+}
+
+// TestExtendEntryForID tests the locked delegation entry extension
+func (suite *LockedDelegationTestSuite) TestExtendEntryForID() {
+	oldRate := types.DefaultRates[0]
+	newRate := types.DefaultRates[2]
+	currTime := time.Unix(1000, 0).UTC()
+
+	testCases := []struct {
+		name             string
+		id               uint64
+		unlockOn         time.Time
+		expectedUnlockOn time.Time
+		found            bool
+	}{
+		{
+			"found - unlock on from the new rate",
+			3,
+			currTime.Add(oldRate.Duration),
+			currTime.Add(newRate.Duration),
+			true,
+		},
+		{
+			"found - unlock on never earlier than the current one",
+			3,
+			currTime.Add(newRate.Duration).Add(time.Hour),
+			currTime.Add(newRate.Duration).Add(time.Hour),
+			true,
+		},
+		{
+			"not found",
+			4,
+			currTime,
+			time.Time{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		lockedDelegation := types.LockedDelegation{
+			Entries: []types.LockedDelegationEntry{
+				types.NewLockedDelegationEntry(math.LegacyOneDec(), oldRate, currTime, false, 1),
+				types.NewLockedDelegationEntry(math.LegacyOneDec(), oldRate, tc.unlockOn, true, 3),
+			},
+		}
+		original := copyLockedDelegation(lockedDelegation)
+
+		previous, entry, found := lockedDelegation.ExtendEntryForID(tc.id, newRate, currTime)
+		suite.Require().Equal(tc.found, found, tc.name)
+		if !tc.found {
+			suite.Require().Equal(original, lockedDelegation, tc.name)
+			continue
+		}
+
+		suite.Require().Equal(original.Entries[1], previous, tc.name)
+		suite.Require().Equal(
+			types.NewLockedDelegationEntry(previous.Shares, newRate, tc.expectedUnlockOn, previous.AutoRenew, previous.Id),
+			entry,
+			tc.name,
+		)
+		suite.Require().Equal(entry, lockedDelegation.Entries[1], tc.name)
+		suite.Require().Equal(original.Entries[0], lockedDelegation.Entries[0], tc.name)
+	}
+}
+
+// TestLatestUnlockingEntry tests the entry with the latest unlock time still locked
+func (suite *LockedDelegationTestSuite) TestLatestUnlockingEntry() {
+	rate := types.DefaultRates[0]
+	currTime := time.Unix(1000, 0).UTC()
+
+	lockedDelegation := types.LockedDelegation{
+		Entries: []types.LockedDelegationEntry{
+			types.NewLockedDelegationEntry(math.LegacyOneDec(), rate, currTime.Add(time.Hour), false, 1),
+			types.NewLockedDelegationEntry(math.LegacyOneDec(), rate, currTime.Add(2*time.Hour), false, 2),
+			types.NewLockedDelegationEntry(math.LegacyOneDec(), rate, currTime.Add(time.Minute), false, 3),
+		},
+	}
+
+	// The latest entry is found
+	entry, found := lockedDelegation.LatestUnlockingEntry(currTime)
+	suite.Require().True(found)
+	suite.Require().Equal(lockedDelegation.Entries[1], entry)
+
+	// Entries unlocking at the time are expired
+	_, found = lockedDelegation.LatestUnlockingEntry(currTime.Add(2 * time.Hour))
+	suite.Require().False(found)
+
+	// Without entries nothing is found
+	_, found = types.LockedDelegation{}.LatestUnlockingEntry(currTime)
+	suite.Require().False(found)
+}
+
+// TestMatchingEntry tests the entry an entry with the same values is merged into
+func (suite *LockedDelegationTestSuite) TestMatchingEntry() {
+	rate := types.DefaultRates[0]
+	atMaturity := rate
+	atMaturity.PayoutMode = types.PayoutModeAtMaturity
+	currTime := time.Unix(1000, 0).UTC()
+
+	lockedDelegation := types.LockedDelegation{
+		Entries: []types.LockedDelegationEntry{
+			types.NewLockedDelegationEntry(math.LegacyOneDec(), rate, currTime, false, 1),
+			types.NewLockedDelegationEntry(math.LegacyOneDec(), rate, currTime.Add(time.Hour), false, 2),
+		},
+	}
+
+	// The shares and ID don't matter
+	entry, found := lockedDelegation.MatchingEntry(types.NewLockedDelegationEntry(math.LegacyNewDec(5), rate, currTime.Add(time.Hour), false, 3))
+	suite.Require().True(found)
+	suite.Require().Equal(lockedDelegation.Entries[1], entry)
+
+	// The merged entry is the one returned by AddEntry
+	added := lockedDelegation.AddEntry(types.NewLockedDelegationEntry(math.LegacyNewDec(5), rate, currTime, false, 4))
+	entry, found = lockedDelegation.MatchingEntry(added)
+	suite.Require().True(found)
+	suite.Require().Equal(added, entry)
+	suite.Require().Equal(uint64(1), entry.Id)
+
+	// A different payout mode is a different rate
+	_, found = lockedDelegation.MatchingEntry(types.NewLockedDelegationEntry(math.LegacyOneDec(), atMaturity, currTime, false, 5))
+	suite.Require().False(found)
+}
+
+// TestStreamingRewardShare tests the share of the locking rewards paid right away earned by an entry
+func (suite *LockedDelegationTestSuite) TestStreamingRewardShare() {
+	rate := types.DefaultRates[0]
+	atMaturity := rate
+	atMaturity.PayoutMode = types.PayoutModeAtMaturity
+	currTime := time.Unix(1000, 0).UTC()
+
+	lockedDelegation := types.LockedDelegation{
+		Entries: []types.LockedDelegationEntry{
+			types.NewLockedDelegationEntry(math.LegacyOneDec(), rate, currTime.Add(time.Hour), false, 1),
+			types.NewLockedDelegationEntry(math.LegacyNewDec(3), rate, currTime.Add(time.Hour), false, 2),
+			types.NewLockedDelegationEntry(math.LegacyNewDec(4), atMaturity, currTime.Add(time.Hour), false, 3),
+		},
+	}
+
+	// Only the locked at maturity entries hold their rewards in escrow
+	suite.Require().False(lockedDelegation.Entries[0].EscrowsRewards(currTime))
+	suite.Require().True(lockedDelegation.Entries[2].EscrowsRewards(currTime))
+	suite.Require().False(lockedDelegation.Entries[2].EscrowsRewards(currTime.Add(time.Hour)))
+
+	// The at maturity entry doesn't take part of the streaming rewards while it's locked
+	suite.Require().Equal(math.LegacyNewDecWithPrec(25, 2), lockedDelegation.StreamingRewardShare(1, currTime))
+	suite.Require().Equal(math.LegacyNewDecWithPrec(75, 2), lockedDelegation.StreamingRewardShare(2, currTime))
+	suite.Require().True(lockedDelegation.StreamingRewardShare(3, currTime).IsZero())
+
+	// Once expired its rewards are paid right away
+	suite.Require().Equal(math.LegacyNewDecWithPrec(5, 1), lockedDelegation.StreamingRewardShare(3, currTime.Add(time.Hour)))
+}
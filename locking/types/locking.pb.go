@@ -32,6 +32,70 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ExpiryAction defines the possible actions taken on the shares of an entry
+// that expires without auto renew
+type ExpiryAction int32
+
+const (
+	// EXPIRY_ACTION_UNDELEGATE undelegates the shares
+	ExpiryActionUndelegate ExpiryAction = 0
+	// EXPIRY_ACTION_STAY_DELEGATED only removes the lock, the shares stay
+	// delegated earning the distribution rewards
+	ExpiryActionStayDelegated ExpiryAction = 1
+	// EXPIRY_ACTION_REDELEGATE removes the lock and redelegates the shares to the
+	// entry redelegate_to validator
+	ExpiryActionRedelegate ExpiryAction = 2
+)
+
+var ExpiryAction_name = map[int32]string{
+	0: "EXPIRY_ACTION_UNDELEGATE",
+	1: "EXPIRY_ACTION_STAY_DELEGATED",
+	2: "EXPIRY_ACTION_REDELEGATE",
+}
+
+var ExpiryAction_value = map[string]int32{
+	"EXPIRY_ACTION_UNDELEGATE":     0,
+	"EXPIRY_ACTION_STAY_DELEGATED": 1,
+	"EXPIRY_ACTION_REDELEGATE":     2,
+}
+
+func (x ExpiryAction) String() string {
+	return proto.EnumName(ExpiryAction_name, int32(x))
+}
+
+func (ExpiryAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{0}
+}
+
+// PayoutMode defines when the locking rewards earned by an entry are paid
+type PayoutMode int32
+
+const (
+	// PAYOUT_MODE_STREAMING pays the rewards on every rewards withdraw
+	PayoutModeStreaming PayoutMode = 0
+	// PAYOUT_MODE_AT_MATURITY keeps the rewards in escrow for the entry until it
+	// reaches its unlock time, they are forfeited if the entry is unlocked early
+	PayoutModeAtMaturity PayoutMode = 1
+)
+
+var PayoutMode_name = map[int32]string{
+	0: "PAYOUT_MODE_STREAMING",
+	1: "PAYOUT_MODE_AT_MATURITY",
+}
+
+var PayoutMode_value = map[string]int32{
+	"PAYOUT_MODE_STREAMING":   0,
+	"PAYOUT_MODE_AT_MATURITY": 1,
+}
+
+func (x PayoutMode) String() string {
+	return proto.EnumName(PayoutMode_name, int32(x))
+}
+
+func (PayoutMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{1}
+}
+
 // LockedDelegation defines the locking locked delegations
 type LockedDelegation struct {
 	// delegator_address is the bech32-encoded address of the delegator
@@ -40,6 +104,9 @@ type LockedDelegation struct {
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	// entries are all the lockings made on top of the pair
 	Entries []LockedDelegationEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries"`
+	// auto_compound defines if the locking rewards of the pair are delegated
+	// and added to its entry with the latest unlock time
+	AutoCompound bool `protobuf:"varint,4,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty"`
 }
 
 func (m *LockedDelegation) Reset()      { *m = LockedDelegation{} }
@@ -81,10 +148,17 @@ type LockedDelegationEntry struct {
 	Rate Rate `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate"`
 	// unlock_on defines when the delegation will be unlocked
 	UnlockOn time.Time `protobuf:"bytes,3,opt,name=unlock_on,json=unlockOn,proto3,stdtime" json:"unlock_on"`
-	// auto_renew defines if the delegator wants to auto renew the locking after expiration
+	// auto_renew defines if the delegator wants to auto renew the locking after
+	// expiration
 	AutoRenew bool `protobuf:"varint,4,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty" yaml:"undelegate"`
 	// Incrementing id that uniquely identifies this entry
 	Id uint64 `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+	// expiry_action defines what happens to the entry shares when it expires
+	// without auto renew
+	ExpiryAction ExpiryAction `protobuf:"varint,6,opt,name=expiry_action,json=expiryAction,proto3,enum=aether.locking.v1beta1.ExpiryAction" json:"expiry_action,omitempty"`
+	// redelegate_to is the validator the shares are redelegated to when the
+	// expiry action is EXPIRY_ACTION_REDELEGATE
+	RedelegateTo string `protobuf:"bytes,7,opt,name=redelegate_to,json=redelegateTo,proto3" json:"redelegate_to,omitempty"`
 }
 
 func (m *LockedDelegationEntry) Reset()      { *m = LockedDelegationEntry{} }
@@ -147,12 +221,31 @@ func (m *LockedDelegationEntry) GetId() uint64 {
 	return 0
 }
 
+func (m *LockedDelegationEntry) GetExpiryAction() ExpiryAction {
+	if m != nil {
+		return m.ExpiryAction
+	}
+	return ExpiryActionUndelegate
+}
+
+func (m *LockedDelegationEntry) GetRedelegateTo() string {
+	if m != nil {
+		return m.RedelegateTo
+	}
+	return ""
+}
+
 // Rate are the rate of rewards for the locked delegations
 type Rate struct {
 	// Duration is the lock duration
 	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
 	// The rate used on calculation
 	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// early_unlock_penalty is the fraction of the locked shares forfeited when an
+	// entry is unlocked before its unlock time
+	EarlyUnlockPenalty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=early_unlock_penalty,json=earlyUnlockPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"early_unlock_penalty"`
+	// payout_mode defines when the locking rewards of the entries are paid
+	PayoutMode PayoutMode `protobuf:"varint,4,opt,name=payout_mode,json=payoutMode,proto3,enum=aether.locking.v1beta1.PayoutMode" json:"payout_mode,omitempty"`
 }
 
 func (m *Rate) Reset()         { *m = Rate{} }
@@ -195,6 +288,13 @@ func (m *Rate) GetDuration() time.Duration {
 	return 0
 }
 
+func (m *Rate) GetPayoutMode() PayoutMode {
+	if m != nil {
+		return m.PayoutMode
+	}
+	return PayoutModeStreaming
+}
+
 // LockedDelegationPair define a del and val pair
 type LockedDelegationPair struct {
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
@@ -289,6 +389,9 @@ type LockedDelegationDelegatorReward struct {
 	LockingReward github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=locking_reward,json=lockingReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"locking_reward"`
 	// total is the sum between the distribution_reward and the locking_reward
 	Total github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total"`
+	// locking_reward_remainder is the decimal remainder carried over from the
+	// previous locking rewards payout, included in the locking_reward
+	LockingRewardRemainder github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=locking_reward_remainder,json=lockingRewardRemainder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"locking_reward_remainder"`
 }
 
 func (m *LockedDelegationDelegatorReward) Reset()         { *m = LockedDelegationDelegatorReward{} }
@@ -324,7 +427,8 @@ func (m *LockedDelegationDelegatorReward) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LockedDelegationDelegatorReward proto.InternalMessageInfo
 
-// LockedDelegationWithTotalShares defines an locked delegation carrying the total shares
+// LockedDelegationWithTotalShares defines an locked delegation carrying the
+// total shares
 type LockedDelegationWithTotalShares struct {
 	LockedDelegation LockedDelegation `protobuf:"bytes,1,opt,name=locked_delegation,json=lockedDelegation,proto3" json:"locked_delegation"`
 	// total_locked is the total shares locked for the delegation
@@ -371,634 +475,6239 @@ func (m *LockedDelegationWithTotalShares) GetLockedDelegation() LockedDelegation
 	return LockedDelegation{}
 }
 
-func init() {
-	proto.RegisterType((*LockedDelegation)(nil), "aether.locking.v1beta1.LockedDelegation")
-	proto.RegisterType((*LockedDelegationEntry)(nil), "aether.locking.v1beta1.LockedDelegationEntry")
-	proto.RegisterType((*Rate)(nil), "aether.locking.v1beta1.Rate")
-	proto.RegisterType((*LockedDelegationPair)(nil), "aether.locking.v1beta1.LockedDelegationPair")
-	proto.RegisterType((*LockedDelegationPairs)(nil), "aether.locking.v1beta1.LockedDelegationPairs")
-	proto.RegisterType((*LockedDelegationDelegatorReward)(nil), "aether.locking.v1beta1.LockedDelegationDelegatorReward")
-	proto.RegisterType((*LockedDelegationWithTotalShares)(nil), "aether.locking.v1beta1.LockedDelegationWithTotalShares")
+// ValidatorSlashEvent records a slash applied to a validator
+type ValidatorSlashEvent struct {
+	// validator_address is the slashed validator address
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height is the block height the slash was applied
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time the slash was applied
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// fraction is the fraction of the validator tokens slashed
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+	// tokens_per_share_before is the validator exchange rate before the slash
+	TokensPerShareBefore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=tokens_per_share_before,json=tokensPerShareBefore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tokens_per_share_before"`
+	// tokens_per_share_after is the validator exchange rate after the slash
+	TokensPerShareAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=tokens_per_share_after,json=tokensPerShareAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tokens_per_share_after"`
 }
 
-func init() {
-	proto.RegisterFile("aether/locking/v1beta1/locking.proto", fileDescriptor_505f454303ab7e28)
+func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
+func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSlashEvent) ProtoMessage()    {}
+func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{7}
 }
-
-var fileDescriptor_505f454303ab7e28 = []byte{
-	// 773 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcf, 0x4f, 0xdb, 0x48,
-	0x18, 0xcd, 0x24, 0x81, 0x4d, 0x26, 0x2c, 0x22, 0x5e, 0x58, 0x19, 0x84, 0xe2, 0xc8, 0x5a, 0xad,
-	0xa2, 0xdd, 0x8d, 0x2d, 0xd8, 0x3d, 0xac, 0xb2, 0x48, 0x2b, 0xd2, 0x70, 0x6b, 0x55, 0x64, 0x90,
-	0x2a, 0xf5, 0xe2, 0x4e, 0xec, 0xc1, 0x19, 0xe1, 0x78, 0x22, 0x7b, 0x02, 0xe2, 0xd0, 0x4b, 0x2f,
-	0xed, 0x91, 0x23, 0xb7, 0x72, 0xac, 0x7a, 0xaa, 0x2a, 0xae, 0xbd, 0x73, 0x44, 0x9c, 0xaa, 0x1e,
-	0xa0, 0x82, 0x4a, 0x6d, 0xaf, 0xfd, 0x0b, 0xaa, 0xf9, 0xe1, 0x28, 0x0d, 0x54, 0xa5, 0x6a, 0x91,
-	0x7a, 0x49, 0x3c, 0xf6, 0xfb, 0xde, 0xf7, 0xde, 0xe7, 0x37, 0x63, 0xf8, 0x1b, 0xc2, 0xac, 0x83,
-	0x63, 0x3b, 0xa4, 0xde, 0x26, 0x89, 0x02, 0x7b, 0x6b, 0xa1, 0x8d, 0x19, 0x5a, 0x48, 0xd7, 0x56,
-	0x2f, 0xa6, 0x8c, 0x6a, 0xbf, 0x4a, 0x94, 0x95, 0xde, 0x55, 0xa8, 0xb9, 0xe9, 0x80, 0x06, 0x54,
-	0x40, 0x6c, 0x7e, 0x25, 0xd1, 0x73, 0x46, 0x40, 0x69, 0x10, 0x62, 0x5b, 0xac, 0xda, 0xfd, 0x0d,
-	0x9b, 0x91, 0x2e, 0x4e, 0x18, 0xea, 0xf6, 0x14, 0xa0, 0x32, 0x0a, 0xf0, 0xfb, 0x31, 0x62, 0x84,
-	0x46, 0xea, 0x79, 0x19, 0x75, 0x49, 0x44, 0x6d, 0xf1, 0xab, 0x6e, 0xcd, 0x7a, 0x34, 0xe9, 0xd2,
-	0xc4, 0x95, 0xcd, 0xe4, 0x22, 0x65, 0x93, 0x2b, 0xbb, 0x8d, 0x12, 0x3c, 0xd0, 0xef, 0x51, 0xa2,
-	0xd8, 0xcc, 0x07, 0x59, 0x38, 0x75, 0x93, 0x7a, 0x9b, 0xd8, 0x6f, 0xe1, 0x10, 0x07, 0xa2, 0x91,
-	0xb6, 0x02, 0xcb, 0xbe, 0x5c, 0xd1, 0xd8, 0x45, 0xbe, 0x1f, 0xe3, 0x24, 0xd1, 0x41, 0x15, 0xd4,
-	0x8a, 0x4d, 0xfd, 0xf8, 0xa0, 0x3e, 0xad, 0x3a, 0x2c, 0xcb, 0x27, 0x6b, 0x2c, 0x26, 0x51, 0xe0,
-	0x4c, 0x0d, 0x4a, 0xd4, 0x7d, 0x4e, 0xb3, 0x85, 0x42, 0xe2, 0x7f, 0x42, 0x93, 0xfd, 0x12, 0xcd,
-	0xa0, 0x24, 0xa5, 0x71, 0xe0, 0x4f, 0x38, 0x62, 0x31, 0xc1, 0x89, 0x9e, 0xab, 0xe6, 0x6a, 0xa5,
-	0xc5, 0xba, 0x75, 0xf9, 0xc4, 0xad, 0x51, 0x23, 0x2b, 0x11, 0x8b, 0x77, 0x9a, 0xc5, 0xc3, 0x13,
-	0x23, 0xf3, 0xe4, 0xed, 0xb3, 0x3f, 0x80, 0x93, 0x12, 0x35, 0x26, 0x1e, 0xed, 0x1b, 0x99, 0xbd,
-	0x7d, 0x23, 0xf3, 0x6e, 0xdf, 0xc8, 0x98, 0x2f, 0xb2, 0x70, 0xe6, 0xd2, 0x5a, 0x6d, 0x1d, 0x8e,
-	0x27, 0x1d, 0x14, 0xe3, 0xd4, 0xfe, 0x12, 0xe7, 0x7a, 0x75, 0x62, 0xfc, 0x1e, 0x10, 0xd6, 0xe9,
-	0xb7, 0x2d, 0x8f, 0x76, 0xd5, 0xbc, 0xd5, 0x5f, 0x3d, 0xf1, 0x37, 0x6d, 0xb6, 0xd3, 0xc3, 0x89,
-	0xd5, 0xc2, 0xde, 0xf1, 0x41, 0x1d, 0x2a, 0x97, 0x2d, 0xec, 0x39, 0x8a, 0x4b, 0xfb, 0x0f, 0xe6,
-	0x63, 0xc4, 0xb0, 0x98, 0x45, 0x69, 0x71, 0xfe, 0x73, 0x76, 0x1c, 0xc4, 0xf0, 0xb0, 0x7a, 0x51,
-	0xa4, 0x2d, 0xc3, 0x62, 0x3f, 0xe2, 0x50, 0x97, 0x46, 0x7a, 0x4e, 0x30, 0xcc, 0x59, 0x32, 0x33,
-	0x56, 0x9a, 0x19, 0x6b, 0x3d, 0x0d, 0x55, 0xb3, 0xc0, 0xeb, 0x77, 0x4f, 0x0d, 0xe0, 0x14, 0x64,
-	0xd9, 0xed, 0x48, 0xfb, 0x07, 0x42, 0xd4, 0x67, 0xd4, 0x8d, 0x71, 0x84, 0xb7, 0xf5, 0x7c, 0x15,
-	0xd4, 0x0a, 0xcd, 0x99, 0x0f, 0x27, 0x46, 0x79, 0x07, 0x75, 0xc3, 0x86, 0xd9, 0x8f, 0xd4, 0xab,
-	0xc4, 0xa6, 0x53, 0xe4, 0x40, 0x87, 0xe3, 0xb4, 0x49, 0x98, 0x25, 0xbe, 0x3e, 0x56, 0x05, 0xb5,
-	0xbc, 0x93, 0x25, 0x7e, 0xa3, 0xa0, 0xe6, 0x07, 0xcc, 0xc7, 0x00, 0xe6, 0xb9, 0x58, 0xed, 0x7f,
-	0x58, 0x48, 0xd3, 0x2a, 0x06, 0x56, 0x5a, 0x9c, 0xbd, 0x20, 0xad, 0xa5, 0x00, 0x52, 0xd9, 0x9e,
-	0x50, 0x96, 0x16, 0x69, 0xab, 0x43, 0x93, 0xf9, 0xd6, 0x69, 0x0b, 0xa6, 0x46, 0x5e, 0x28, 0x7c,
-	0x0e, 0xe0, 0xf4, 0xe8, 0x1b, 0x5e, 0x45, 0x24, 0xfe, 0xb1, 0xa2, 0x3e, 0x12, 0xcb, 0x8d, 0x8b,
-	0xa9, 0xe4, 0x9a, 0x13, 0xed, 0x16, 0x1c, 0xeb, 0xf1, 0x0b, 0x1d, 0x88, 0xfd, 0xf0, 0xd7, 0x55,
-	0xf7, 0x03, 0xaf, 0x1e, 0x0e, 0x94, 0x64, 0x31, 0xdf, 0xe7, 0xa0, 0x31, 0x0a, 0x6d, 0xa5, 0x0e,
-	0x1d, 0xbc, 0x8d, 0x62, 0xff, 0x72, 0x83, 0xe0, 0xab, 0xf7, 0xf2, 0x43, 0x00, 0x7f, 0xf1, 0x49,
-	0xc2, 0x62, 0xd2, 0xee, 0xf3, 0x36, 0x6e, 0x2c, 0xe8, 0xf5, 0xac, 0x30, 0x32, 0x6f, 0x29, 0x1a,
-	0x7e, 0x5a, 0x0d, 0x5c, 0xb4, 0xb0, 0x77, 0x83, 0x92, 0xa8, 0xf9, 0x2f, 0x17, 0xfe, 0xf4, 0xd4,
-	0xf8, 0xf3, 0x6a, 0x69, 0xe0, 0x35, 0x89, 0xf4, 0xa9, 0x0d, 0xb7, 0x54, 0x86, 0xee, 0xc3, 0x49,
-	0x35, 0xae, 0x54, 0x43, 0xee, 0x5a, 0x35, 0xfc, 0xac, 0xba, 0xa9, 0xf6, 0x21, 0x1c, 0x63, 0x94,
-	0xa1, 0x50, 0xcf, 0x5f, 0x6b, 0x57, 0xd9, 0xa4, 0x51, 0x50, 0xb9, 0x02, 0xe6, 0x1b, 0x70, 0xf1,
-	0x5d, 0xdf, 0x21, 0xac, 0xb3, 0xce, 0x71, 0x6b, 0xf2, 0x78, 0xba, 0x07, 0xcb, 0xa1, 0x80, 0xb8,
-	0xfe, 0x00, 0xa3, 0xb6, 0x73, 0xed, 0xaa, 0x51, 0x1b, 0x8e, 0xd9, 0x54, 0x38, 0xfa, 0x81, 0x71,
-	0xe1, 0x84, 0x10, 0xe6, 0xca, 0x27, 0xdf, 0x65, 0xbb, 0x97, 0x04, 0xa3, 0xd4, 0xd1, 0x5c, 0x3a,
-	0x3c, 0xab, 0x80, 0xa3, 0xb3, 0x0a, 0x78, 0x7d, 0x56, 0x01, 0xbb, 0xe7, 0x95, 0xcc, 0xd1, 0x79,
-	0x25, 0xf3, 0xf2, 0xbc, 0x92, 0xb9, 0x6b, 0x0e, 0x91, 0x4b, 0x2f, 0x78, 0xab, 0x3b, 0xf8, 0xc2,
-	0x0b, 0xf2, 0xf6, 0xb8, 0x38, 0xac, 0xfe, 0xfe, 0x18, 0x00, 0x00, 0xff, 0xff, 0x93, 0x7e, 0x60,
-	0x94, 0x00, 0x08, 0x00, 0x00,
+func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *LockedDelegationEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ValidatorSlashEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSlashEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *ValidatorSlashEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSlashEvent.Merge(m, src)
+}
+func (m *ValidatorSlashEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSlashEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSlashEvent.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*LockedDelegationEntry)
-	if !ok {
-		that2, ok := that.(LockedDelegationEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ValidatorSlashEvent proto.InternalMessageInfo
+
+// ValidatorSlashEvents defines an array of ValidatorSlashEvent objects
+type ValidatorSlashEvents struct {
+	Events []ValidatorSlashEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
+}
+
+func (m *ValidatorSlashEvents) Reset()         { *m = ValidatorSlashEvents{} }
+func (m *ValidatorSlashEvents) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSlashEvents) ProtoMessage()    {}
+func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{8}
+}
+func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSlashEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSlashEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Shares.Equal(that1.Shares) {
-		return false
-	}
-	if !this.Rate.Equal(&that1.Rate) {
-		return false
-	}
-	if !this.UnlockOn.Equal(that1.UnlockOn) {
-		return false
-	}
-	if this.AutoRenew != that1.AutoRenew {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	return true
 }
-func (this *Rate) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ValidatorSlashEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSlashEvents.Merge(m, src)
+}
+func (m *ValidatorSlashEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSlashEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSlashEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSlashEvents proto.InternalMessageInfo
+
+func (m *ValidatorSlashEvents) GetEvents() []ValidatorSlashEvent {
+	if m != nil {
+		return m.Events
 	}
+	return nil
+}
 
-	that1, ok := that.(*Rate)
-	if !ok {
-		that2, ok := that.(Rate)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// LockedDelegationEntrySlash defines the token value of a locked delegation
+// entry before and after a validator slash
+type LockedDelegationEntrySlash struct {
+	// slash_event is the validator slash event
+	SlashEvent ValidatorSlashEvent `protobuf:"bytes,1,opt,name=slash_event,json=slashEvent,proto3" json:"slash_event"`
+	// tokens_before is the entry token value before the slash
+	TokensBefore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tokens_before,json=tokensBefore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tokens_before"`
+	// tokens_after is the entry token value after the slash
+	TokensAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=tokens_after,json=tokensAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tokens_after"`
+}
+
+func (m *LockedDelegationEntrySlash) Reset()         { *m = LockedDelegationEntrySlash{} }
+func (m *LockedDelegationEntrySlash) String() string { return proto.CompactTextString(m) }
+func (*LockedDelegationEntrySlash) ProtoMessage()    {}
+func (*LockedDelegationEntrySlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{9}
+}
+func (m *LockedDelegationEntrySlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedDelegationEntrySlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedDelegationEntrySlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Duration != that1.Duration {
-		return false
-	}
-	if !this.Rate.Equal(that1.Rate) {
-		return false
-	}
-	return true
 }
-func (m *LockedDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LockedDelegationEntrySlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedDelegationEntrySlash.Merge(m, src)
+}
+func (m *LockedDelegationEntrySlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedDelegationEntrySlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedDelegationEntrySlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedDelegationEntrySlash proto.InternalMessageInfo
+
+func (m *LockedDelegationEntrySlash) GetSlashEvent() ValidatorSlashEvent {
+	if m != nil {
+		return m.SlashEvent
 	}
-	return dAtA[:n], nil
+	return ValidatorSlashEvent{}
 }
 
-func (m *LockedDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LockedDelegationUnlock defines a queued locked delegation entry with its
+// pair and current token value
+type LockedDelegationUnlock struct {
+	// delegator_address is the delegator address of the entry
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address of the entry
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// entry is the locked delegation entry
+	Entry LockedDelegationEntry `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry"`
+	// tokens is the current entry token value
+	Tokens github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tokens"`
 }
 
-func (m *LockedDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLocking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *LockedDelegationUnlock) Reset()         { *m = LockedDelegationUnlock{} }
+func (m *LockedDelegationUnlock) String() string { return proto.CompactTextString(m) }
+func (*LockedDelegationUnlock) ProtoMessage()    {}
+func (*LockedDelegationUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{10}
+}
+func (m *LockedDelegationUnlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedDelegationUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedDelegationUnlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *LockedDelegationUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedDelegationUnlock.Merge(m, src)
+}
+func (m *LockedDelegationUnlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedDelegationUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedDelegationUnlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedDelegationUnlock proto.InternalMessageInfo
+
+func (m *LockedDelegationUnlock) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintLocking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *LockedDelegationUnlock) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *LockedDelegationEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LockedDelegationUnlock) GetEntry() LockedDelegationEntry {
+	if m != nil {
+		return m.Entry
 	}
-	return dAtA[:n], nil
+	return LockedDelegationEntry{}
 }
 
-func (m *LockedDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RateLockingStats defines the locked totals of a rate duration
+type RateLockingStats struct {
+	// duration is the rate lock duration
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// shares are the total shares locked with the rate duration
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// tokens are the total tokens locked with the rate duration, calculated with
+	// the current validators exchange rate
+	Tokens github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tokens"`
 }
 
-func (m *LockedDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintLocking(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x28
+func (m *RateLockingStats) Reset()         { *m = RateLockingStats{} }
+func (m *RateLockingStats) String() string { return proto.CompactTextString(m) }
+func (*RateLockingStats) ProtoMessage()    {}
+func (*RateLockingStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{11}
+}
+func (m *RateLockingStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLockingStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLockingStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.AutoRenew {
-		i--
-		if m.AutoRenew {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockOn, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockOn):])
-	if err1 != nil {
-		return 0, err1
+}
+func (m *RateLockingStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLockingStats.Merge(m, src)
+}
+func (m *RateLockingStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLockingStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLockingStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLockingStats proto.InternalMessageInfo
+
+func (m *RateLockingStats) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	i -= n1
-	i = encodeVarintLocking(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// LockingStats defines the locked totals and their split per rate duration
+type LockingStats struct {
+	// shares are the total locked shares
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// tokens are the total locked tokens, calculated with the current validators
+	// exchange rate
+	Tokens github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tokens"`
+	// rates are the locked totals per rate duration
+	Rates []RateLockingStats `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates"`
+}
+
+func (m *LockingStats) Reset()         { *m = LockingStats{} }
+func (m *LockingStats) String() string { return proto.CompactTextString(m) }
+func (*LockingStats) ProtoMessage()    {}
+func (*LockingStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{12}
+}
+func (m *LockingStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockingStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockingStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLocking(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Shares.Size()
-		i -= size
-		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *LockingStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockingStats.Merge(m, src)
+}
+func (m *LockingStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockingStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockingStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockingStats proto.InternalMessageInfo
+
+func (m *LockingStats) GetRates() []RateLockingStats {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+// RateTotalLockingStats defines the module wide locked tokens of a rate
+// duration
+type RateTotalLockingStats struct {
+	// duration is the rate lock duration
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// tokens are the tokens locked with the rate duration on all the validators,
+	// calculated with the current validators exchange rate
+	Tokens github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tokens"`
+}
+
+func (m *RateTotalLockingStats) Reset()         { *m = RateTotalLockingStats{} }
+func (m *RateTotalLockingStats) String() string { return proto.CompactTextString(m) }
+func (*RateTotalLockingStats) ProtoMessage()    {}
+func (*RateTotalLockingStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{13}
+}
+func (m *RateTotalLockingStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateTotalLockingStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateTotalLockingStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintLocking(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *RateTotalLockingStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateTotalLockingStats.Merge(m, src)
+}
+func (m *RateTotalLockingStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateTotalLockingStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateTotalLockingStats.DiscardUnknown(m)
 }
 
-func (m *Rate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RateTotalLockingStats proto.InternalMessageInfo
+
+func (m *RateTotalLockingStats) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Rate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TotalLockingStats defines the module wide locked tokens and their split per
+// rate duration
+// Shares of different validators aren't comparable, so only tokens are summed
+type TotalLockingStats struct {
+	// tokens are the tokens locked on all the validators, calculated with the
+	// current validators exchange rate
+	Tokens github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tokens"`
+	// rates are the module wide locked tokens per rate duration
+	Rates []RateTotalLockingStats `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates"`
 }
 
-func (m *Rate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *TotalLockingStats) Reset()         { *m = TotalLockingStats{} }
+func (m *TotalLockingStats) String() string { return proto.CompactTextString(m) }
+func (*TotalLockingStats) ProtoMessage()    {}
+func (*TotalLockingStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{14}
+}
+func (m *TotalLockingStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TotalLockingStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TotalLockingStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintLocking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err3 != nil {
-		return 0, err3
+		return b[:n], nil
 	}
-	i -= n3
-	i = encodeVarintLocking(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *TotalLockingStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalLockingStats.Merge(m, src)
+}
+func (m *TotalLockingStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TotalLockingStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalLockingStats.DiscardUnknown(m)
 }
 
-func (m *LockedDelegationPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TotalLockingStats proto.InternalMessageInfo
+
+func (m *TotalLockingStats) GetRates() []RateTotalLockingStats {
+	if m != nil {
+		return m.Rates
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LockedDelegationPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorLockingStats defines the locked totals of a validator
+type ValidatorLockingStats struct {
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// stats are the validator locked totals
+	Stats LockingStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats"`
 }
 
-func (m *LockedDelegationPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+func (m *ValidatorLockingStats) Reset()         { *m = ValidatorLockingStats{} }
+func (m *ValidatorLockingStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLockingStats) ProtoMessage()    {}
+func (*ValidatorLockingStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{15}
+}
+func (m *ValidatorLockingStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLockingStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLockingStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintLocking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ValidatorLockingStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLockingStats.Merge(m, src)
+}
+func (m *ValidatorLockingStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLockingStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLockingStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLockingStats proto.InternalMessageInfo
+
+func (m *ValidatorLockingStats) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *LockedDelegationPairs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorLockingStats) GetStats() LockingStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return LockingStats{}
 }
 
-func (m *LockedDelegationPairs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LockingSummary defines the delegated, locked and free amounts of a delegator
+// with its reward ratios and pending rewards
+type LockingSummary struct {
+	// delegated_shares are the delegated shares
+	DelegatedShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=delegated_shares,json=delegatedShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegated_shares"`
+	// delegated_tokens are the delegated tokens
+	DelegatedTokens github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=delegated_tokens,json=delegatedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegated_tokens"`
+	// locked_shares are the shares locked by the entries
+	LockedShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=locked_shares,json=lockedShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"locked_shares"`
+	// locked_tokens are the tokens locked by the entries
+	LockedTokens github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=locked_tokens,json=lockedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"locked_tokens"`
+	// free_tokens are the delegated tokens not locked, which can be undelegated
+	FreeTokens github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=free_tokens,json=freeTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"free_tokens"`
+	// weighted_ratio is the locking reward ratio of the locked tokens
+	WeightedRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=weighted_ratio,json=weightedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weighted_ratio"`
+	// effective_multiplier is the rewards multiplier of the delegated tokens,
+	// one plus the locking rewards ratio on top of the distribution rewards
+	EffectiveMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=effective_multiplier,json=effectiveMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"effective_multiplier"`
+	// distribution_reward is the pending reward from the distribution module
+	DistributionReward github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,8,rep,name=distribution_reward,json=distributionReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"distribution_reward"`
+	// locking_reward is the pending reward from the locking module
+	LockingReward github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,9,rep,name=locking_reward,json=lockingReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"locking_reward"`
+	// next_unlock is the earliest entry unlock time, empty without entries
+	NextUnlock *time.Time `protobuf:"bytes,10,opt,name=next_unlock,json=nextUnlock,proto3,stdtime" json:"next_unlock,omitempty"`
 }
 
-func (m *LockedDelegationPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pairs) > 0 {
-		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLocking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *LockingSummary) Reset()         { *m = LockingSummary{} }
+func (m *LockingSummary) String() string { return proto.CompactTextString(m) }
+func (*LockingSummary) ProtoMessage()    {}
+func (*LockingSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{16}
+}
+func (m *LockingSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockingSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockingSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *LockingSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockingSummary.Merge(m, src)
+}
+func (m *LockingSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockingSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockingSummary.DiscardUnknown(m)
 }
 
-func (m *LockedDelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LockingSummary proto.InternalMessageInfo
+
+func (m *LockingSummary) GetDistributionReward() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.DistributionReward
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LockedDelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LockingSummary) GetLockingReward() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.LockingReward
+	}
+	return nil
 }
 
-func (m *LockedDelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Total) > 0 {
-		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLocking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.LockingReward) > 0 {
-		for iNdEx := len(m.LockingReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LockingReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLocking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *LockingSummary) GetNextUnlock() *time.Time {
+	if m != nil {
+		return m.NextUnlock
 	}
-	if len(m.DistributionReward) > 0 {
-		for iNdEx := len(m.DistributionReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DistributionReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLocking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return nil
+}
+
+// ValidatorLockingSummary defines the locking summary of a delegator on a
+// validator
+type ValidatorLockingSummary struct {
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// summary is the delegator summary on the validator
+	Summary LockingSummary `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary"`
+}
+
+func (m *ValidatorLockingSummary) Reset()         { *m = ValidatorLockingSummary{} }
+func (m *ValidatorLockingSummary) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLockingSummary) ProtoMessage()    {}
+func (*ValidatorLockingSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{17}
+}
+func (m *ValidatorLockingSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLockingSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLockingSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ValidatorLockingSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLockingSummary.Merge(m, src)
+}
+func (m *ValidatorLockingSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLockingSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLockingSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLockingSummary proto.InternalMessageInfo
+
+func (m *ValidatorLockingSummary) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *LockedDelegationWithTotalShares) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorLockingSummary) GetSummary() LockingSummary {
+	if m != nil {
+		return m.Summary
 	}
-	return dAtA[:n], nil
+	return LockingSummary{}
 }
 
-func (m *LockedDelegationWithTotalShares) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RewardDebt defines the locking rewards owed to a delegator on a validator
+// when the reward pool couldn't pay them
+type RewardDebt struct {
+	// delegator_address is the delegator address
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the owed amount
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *LockedDelegationWithTotalShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalLocked.Size()
-		i -= size
-		if _, err := m.TotalLocked.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLocking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.LockedDelegation.MarshalToSizedBuffer(dAtA[:i])
+func (m *RewardDebt) Reset()         { *m = RewardDebt{} }
+func (m *RewardDebt) String() string { return proto.CompactTextString(m) }
+func (*RewardDebt) ProtoMessage()    {}
+func (*RewardDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{18}
+}
+func (m *RewardDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLocking(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *RewardDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardDebt.Merge(m, src)
+}
+func (m *RewardDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardDebt.DiscardUnknown(m)
 }
 
-func encodeVarintLocking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLocking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var xxx_messageInfo_RewardDebt proto.InternalMessageInfo
+
+// RewardRemainder defines the decimal locking rewards of a delegator on a
+// validator truncated on the last payout, carried over to the next one
+type RewardRemainder struct {
+	// delegator_address is the delegator address
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the truncated amount
+	Amount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
 }
-func (m *LockedDelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLocking(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLocking(uint64(l))
-	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovLocking(uint64(l))
+
+func (m *RewardRemainder) Reset()         { *m = RewardRemainder{} }
+func (m *RewardRemainder) String() string { return proto.CompactTextString(m) }
+func (*RewardRemainder) ProtoMessage()    {}
+func (*RewardRemainder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{19}
+}
+func (m *RewardRemainder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardRemainder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardRemainder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RewardRemainder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardRemainder.Merge(m, src)
+}
+func (m *RewardRemainder) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardRemainder) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardRemainder.DiscardUnknown(m)
 }
 
-func (m *LockedDelegationEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Shares.Size()
-	n += 1 + l + sovLocking(uint64(l))
-	l = m.Rate.Size()
-	n += 1 + l + sovLocking(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockOn)
-	n += 1 + l + sovLocking(uint64(l))
-	if m.AutoRenew {
-		n += 2
-	}
-	if m.Id != 0 {
-		n += 1 + sovLocking(uint64(m.Id))
-	}
-	return n
+var xxx_messageInfo_RewardRemainder proto.InternalMessageInfo
+
+// BonusBeneficiary defines the address receiving the locking rewards of a
+// delegator instead of its distribution withdraw address
+type BonusBeneficiary struct {
+	// delegator_address is the delegator address
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// beneficiary_address is the address receiving the locking rewards
+	BeneficiaryAddress string `protobuf:"bytes,2,opt,name=beneficiary_address,json=beneficiaryAddress,proto3" json:"beneficiary_address,omitempty"`
 }
 
-func (m *Rate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BonusBeneficiary) Reset()         { *m = BonusBeneficiary{} }
+func (m *BonusBeneficiary) String() string { return proto.CompactTextString(m) }
+func (*BonusBeneficiary) ProtoMessage()    {}
+func (*BonusBeneficiary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{20}
+}
+func (m *BonusBeneficiary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BonusBeneficiary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BonusBeneficiary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovLocking(uint64(l))
-	l = m.Rate.Size()
-	n += 1 + l + sovLocking(uint64(l))
-	return n
+}
+func (m *BonusBeneficiary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BonusBeneficiary.Merge(m, src)
+}
+func (m *BonusBeneficiary) XXX_Size() int {
+	return m.Size()
+}
+func (m *BonusBeneficiary) XXX_DiscardUnknown() {
+	xxx_messageInfo_BonusBeneficiary.DiscardUnknown(m)
 }
 
-func (m *LockedDelegationPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLocking(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLocking(uint64(l))
-	}
-	return n
+var xxx_messageInfo_BonusBeneficiary proto.InternalMessageInfo
+
+// AccrualCheckpoint defines the locking rewards accrued by a delegator on a
+// validator up to the last change of its locked delegation
+type AccrualCheckpoint struct {
+	// delegator_address is the delegator address
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rewards are the delegation rewards pending at the checkpoint
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// accrued are the locking rewards accrued before the checkpoint
+	Accrued github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=accrued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"accrued"`
+	// index is the validator cumulative reward index at the checkpoint, used on
+	// the standalone reward mode
+	Index github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=index,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"index"`
 }
 
-func (m *LockedDelegationPairs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pairs) > 0 {
-		for _, e := range m.Pairs {
-			l = e.Size()
-			n += 1 + l + sovLocking(uint64(l))
+func (m *AccrualCheckpoint) Reset()         { *m = AccrualCheckpoint{} }
+func (m *AccrualCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*AccrualCheckpoint) ProtoMessage()    {}
+func (*AccrualCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{21}
+}
+func (m *AccrualCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccrualCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccrualCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *AccrualCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccrualCheckpoint.Merge(m, src)
+}
+func (m *AccrualCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccrualCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccrualCheckpoint.DiscardUnknown(m)
 }
 
-func (m *LockedDelegationDelegatorReward) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_AccrualCheckpoint proto.InternalMessageInfo
+
+// QuarantinedPair defines a locked delegation pair whose expired entries
+// couldn't be completed on the end block
+type QuarantinedPair struct {
+	// delegator_address is the delegator address
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// error is the error returned when completing the expired entries
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// height is the block height the pair was quarantined at
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QuarantinedPair) Reset()         { *m = QuarantinedPair{} }
+func (m *QuarantinedPair) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedPair) ProtoMessage()    {}
+func (*QuarantinedPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{22}
+}
+func (m *QuarantinedPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLocking(uint64(l))
+}
+func (m *QuarantinedPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedPair.Merge(m, src)
+}
+func (m *QuarantinedPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedPair proto.InternalMessageInfo
+
+// MintEpoch defines the amount minted on the current hybrid funding epoch
+type MintEpoch struct {
+	// start is when the epoch started
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// minted is the amount minted during the epoch
+	Minted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=minted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted"`
+}
+
+func (m *MintEpoch) Reset()         { *m = MintEpoch{} }
+func (m *MintEpoch) String() string { return proto.CompactTextString(m) }
+func (*MintEpoch) ProtoMessage()    {}
+func (*MintEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{23}
+}
+func (m *MintEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.DistributionReward) > 0 {
-		for _, e := range m.DistributionReward {
-			l = e.Size()
-			n += 1 + l + sovLocking(uint64(l))
+}
+func (m *MintEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintEpoch.Merge(m, src)
+}
+func (m *MintEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintEpoch proto.InternalMessageInfo
+
+func (m *MintEpoch) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *MintEpoch) GetMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+// BudgetWindow defines the locking rewards minted on the current budget window
+type BudgetWindow struct {
+	// start is when the window started
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// consumed is the amount minted during the window
+	Consumed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=consumed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"consumed"`
+}
+
+func (m *BudgetWindow) Reset()         { *m = BudgetWindow{} }
+func (m *BudgetWindow) String() string { return proto.CompactTextString(m) }
+func (*BudgetWindow) ProtoMessage()    {}
+func (*BudgetWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{24}
+}
+func (m *BudgetWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BudgetWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BudgetWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.LockingReward) > 0 {
-		for _, e := range m.LockingReward {
-			l = e.Size()
-			n += 1 + l + sovLocking(uint64(l))
+}
+func (m *BudgetWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BudgetWindow.Merge(m, src)
+}
+func (m *BudgetWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *BudgetWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_BudgetWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BudgetWindow proto.InternalMessageInfo
+
+func (m *BudgetWindow) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *BudgetWindow) GetConsumed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Consumed
+	}
+	return nil
+}
+
+// EntryEscrow defines the locking rewards held for a locked delegation entry
+// with the at maturity payout mode, paid when the entry reaches its unlock time
+type EntryEscrow struct {
+	// entry_id is the locked delegation entry id
+	EntryId uint64 `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	// amount is the escrowed amount
+	Amount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+}
+
+func (m *EntryEscrow) Reset()         { *m = EntryEscrow{} }
+func (m *EntryEscrow) String() string { return proto.CompactTextString(m) }
+func (*EntryEscrow) ProtoMessage()    {}
+func (*EntryEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{25}
+}
+func (m *EntryEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntryEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntryEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
-			l = e.Size()
-			n += 1 + l + sovLocking(uint64(l))
+}
+func (m *EntryEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntryEscrow.Merge(m, src)
+}
+func (m *EntryEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntryEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntryEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntryEscrow proto.InternalMessageInfo
+
+// LockingRewardCompound defines the bond denom locking rewards paid to a
+// delegator on a validator, waiting to be compounded at the end of the block
+type LockingRewardCompound struct {
+	// delegator_address is the delegator address
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the bond denom amount to be compounded
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *LockingRewardCompound) Reset()         { *m = LockingRewardCompound{} }
+func (m *LockingRewardCompound) String() string { return proto.CompactTextString(m) }
+func (*LockingRewardCompound) ProtoMessage()    {}
+func (*LockingRewardCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505f454303ab7e28, []int{26}
+}
+func (m *LockingRewardCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockingRewardCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockingRewardCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockingRewardCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockingRewardCompound.Merge(m, src)
+}
+func (m *LockingRewardCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockingRewardCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockingRewardCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockingRewardCompound proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("aether.locking.v1beta1.ExpiryAction", ExpiryAction_name, ExpiryAction_value)
+	proto.RegisterEnum("aether.locking.v1beta1.PayoutMode", PayoutMode_name, PayoutMode_value)
+	proto.RegisterType((*LockedDelegation)(nil), "aether.locking.v1beta1.LockedDelegation")
+	proto.RegisterType((*LockedDelegationEntry)(nil), "aether.locking.v1beta1.LockedDelegationEntry")
+	proto.RegisterType((*Rate)(nil), "aether.locking.v1beta1.Rate")
+	proto.RegisterType((*LockedDelegationPair)(nil), "aether.locking.v1beta1.LockedDelegationPair")
+	proto.RegisterType((*LockedDelegationPairs)(nil), "aether.locking.v1beta1.LockedDelegationPairs")
+	proto.RegisterType((*LockedDelegationDelegatorReward)(nil), "aether.locking.v1beta1.LockedDelegationDelegatorReward")
+	proto.RegisterType((*LockedDelegationWithTotalShares)(nil), "aether.locking.v1beta1.LockedDelegationWithTotalShares")
+	proto.RegisterType((*ValidatorSlashEvent)(nil), "aether.locking.v1beta1.ValidatorSlashEvent")
+	proto.RegisterType((*ValidatorSlashEvents)(nil), "aether.locking.v1beta1.ValidatorSlashEvents")
+	proto.RegisterType((*LockedDelegationEntrySlash)(nil), "aether.locking.v1beta1.LockedDelegationEntrySlash")
+	proto.RegisterType((*LockedDelegationUnlock)(nil), "aether.locking.v1beta1.LockedDelegationUnlock")
+	proto.RegisterType((*RateLockingStats)(nil), "aether.locking.v1beta1.RateLockingStats")
+	proto.RegisterType((*LockingStats)(nil), "aether.locking.v1beta1.LockingStats")
+	proto.RegisterType((*RateTotalLockingStats)(nil), "aether.locking.v1beta1.RateTotalLockingStats")
+	proto.RegisterType((*TotalLockingStats)(nil), "aether.locking.v1beta1.TotalLockingStats")
+	proto.RegisterType((*ValidatorLockingStats)(nil), "aether.locking.v1beta1.ValidatorLockingStats")
+	proto.RegisterType((*LockingSummary)(nil), "aether.locking.v1beta1.LockingSummary")
+	proto.RegisterType((*ValidatorLockingSummary)(nil), "aether.locking.v1beta1.ValidatorLockingSummary")
+	proto.RegisterType((*RewardDebt)(nil), "aether.locking.v1beta1.RewardDebt")
+	proto.RegisterType((*RewardRemainder)(nil), "aether.locking.v1beta1.RewardRemainder")
+	proto.RegisterType((*BonusBeneficiary)(nil), "aether.locking.v1beta1.BonusBeneficiary")
+	proto.RegisterType((*AccrualCheckpoint)(nil), "aether.locking.v1beta1.AccrualCheckpoint")
+	proto.RegisterType((*QuarantinedPair)(nil), "aether.locking.v1beta1.QuarantinedPair")
+	proto.RegisterType((*MintEpoch)(nil), "aether.locking.v1beta1.MintEpoch")
+	proto.RegisterType((*BudgetWindow)(nil), "aether.locking.v1beta1.BudgetWindow")
+	proto.RegisterType((*EntryEscrow)(nil), "aether.locking.v1beta1.EntryEscrow")
+	proto.RegisterType((*LockingRewardCompound)(nil), "aether.locking.v1beta1.LockingRewardCompound")
+}
+
+func init() {
+	proto.RegisterFile("aether/locking/v1beta1/locking.proto", fileDescriptor_505f454303ab7e28)
+}
+
+var fileDescriptor_505f454303ab7e28 = []byte{
+	// 1981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdd, 0x6b, 0x1b, 0xd9,
+	0x15, 0xd7, 0xe8, 0xc3, 0x96, 0x8f, 0x65, 0xc7, 0xbe, 0xfe, 0xc8, 0x58, 0xa4, 0x96, 0x99, 0x86,
+	0xc5, 0x64, 0x1b, 0x99, 0x75, 0xbb, 0x10, 0xdc, 0x2d, 0x8b, 0x64, 0x89, 0x45, 0x34, 0x76, 0xdc,
+	0xb1, 0xdc, 0x6c, 0x0a, 0x65, 0x3a, 0x9e, 0xb9, 0x92, 0xa6, 0x96, 0xe6, 0xaa, 0x77, 0xae, 0xec,
+	0xe8, 0x61, 0x1f, 0x97, 0x2e, 0x79, 0x28, 0x79, 0xdc, 0x3e, 0x04, 0x42, 0x4b, 0xe9, 0x07, 0x74,
+	0x69, 0xcb, 0x3e, 0x74, 0xff, 0x82, 0x6e, 0xa1, 0x0f, 0xcb, 0x52, 0x68, 0xe9, 0x43, 0xb6, 0x24,
+	0x85, 0xf6, 0xb9, 0x2f, 0x85, 0x52, 0xe8, 0x32, 0xf7, 0xde, 0x91, 0x46, 0x96, 0x9c, 0x38, 0x9b,
+	0x51, 0xf0, 0x4b, 0x32, 0x33, 0x3a, 0xe7, 0x77, 0x3e, 0xef, 0x99, 0x73, 0xce, 0x18, 0xae, 0x9a,
+	0x98, 0x35, 0x30, 0xdd, 0x68, 0x12, 0xeb, 0xc8, 0x71, 0xeb, 0x1b, 0xc7, 0xaf, 0x1d, 0x62, 0x66,
+	0xbe, 0x16, 0xdc, 0xe7, 0xdb, 0x94, 0x30, 0x82, 0x96, 0x05, 0x55, 0x3e, 0x78, 0x2a, 0xa9, 0xb2,
+	0x8b, 0x75, 0x52, 0x27, 0x9c, 0x64, 0xc3, 0xbf, 0x12, 0xd4, 0xd9, 0x5c, 0x9d, 0x90, 0x7a, 0x13,
+	0x6f, 0xf0, 0xbb, 0xc3, 0x4e, 0x6d, 0x83, 0x39, 0x2d, 0xec, 0x31, 0xb3, 0xd5, 0x96, 0x04, 0xab,
+	0xa7, 0x09, 0xec, 0x0e, 0x35, 0x99, 0x43, 0x5c, 0xf9, 0xfb, 0xbc, 0xd9, 0x72, 0x5c, 0xb2, 0xc1,
+	0xff, 0x95, 0x8f, 0x56, 0x2c, 0xe2, 0xb5, 0x88, 0x67, 0x08, 0x61, 0xe2, 0x26, 0x40, 0x13, 0x77,
+	0x1b, 0x87, 0xa6, 0x87, 0x7b, 0xfa, 0x5b, 0xc4, 0x91, 0x68, 0xda, 0xcf, 0xe3, 0x30, 0x77, 0x93,
+	0x58, 0x47, 0xd8, 0x2e, 0xe1, 0x26, 0xae, 0x73, 0x41, 0xa8, 0x0c, 0xf3, 0xb6, 0xb8, 0x23, 0xd4,
+	0x30, 0x6d, 0x9b, 0x62, 0xcf, 0x53, 0x95, 0x35, 0x65, 0x7d, 0xaa, 0xa8, 0x7e, 0xfa, 0xe1, 0xf5,
+	0x45, 0x29, 0xa1, 0x20, 0x7e, 0xd9, 0x67, 0xd4, 0x71, 0xeb, 0xfa, 0x5c, 0x8f, 0x45, 0x3e, 0xf7,
+	0x61, 0x8e, 0xcd, 0xa6, 0x63, 0x0f, 0xc0, 0xc4, 0x9f, 0x05, 0xd3, 0x63, 0x09, 0x60, 0x74, 0x98,
+	0xc4, 0x2e, 0xa3, 0x0e, 0xf6, 0xd4, 0xc4, 0x5a, 0x62, 0x7d, 0x7a, 0xf3, 0x7a, 0x7e, 0xb4, 0xc7,
+	0xf3, 0xa7, 0x0d, 0x29, 0xbb, 0x8c, 0x76, 0x8b, 0x53, 0x1f, 0x3f, 0xca, 0xc5, 0x7e, 0xf1, 0xcf,
+	0xdf, 0x5c, 0x53, 0xf4, 0x00, 0x08, 0x7d, 0x19, 0x66, 0xcc, 0x0e, 0x23, 0x86, 0x45, 0x5a, 0x6d,
+	0xd2, 0x71, 0x6d, 0x35, 0xb9, 0xa6, 0xac, 0xa7, 0xf5, 0x8c, 0xff, 0x70, 0x5b, 0x3e, 0xdb, 0xca,
+	0xbc, 0xf7, 0x30, 0x17, 0x7b, 0xff, 0x61, 0x2e, 0xf6, 0xaf, 0x87, 0xb9, 0x98, 0xf6, 0x87, 0x04,
+	0x2c, 0x8d, 0x14, 0x80, 0xaa, 0x30, 0xe1, 0x35, 0x4c, 0x8a, 0x03, 0x1f, 0xbd, 0xe1, 0x0b, 0xfc,
+	0xdb, 0xa3, 0xdc, 0x2b, 0x75, 0x87, 0x35, 0x3a, 0x87, 0x79, 0x8b, 0xb4, 0x64, 0x50, 0xe4, 0x7f,
+	0xd7, 0x3d, 0xfb, 0x68, 0x83, 0x75, 0xdb, 0xd8, 0xcb, 0x97, 0xb0, 0xf5, 0xe9, 0x87, 0xd7, 0x41,
+	0xba, 0xa2, 0x84, 0x2d, 0x5d, 0x62, 0xa1, 0xaf, 0x43, 0x92, 0x9a, 0x0c, 0x73, 0x87, 0x4d, 0x6f,
+	0x5e, 0x39, 0xcb, 0x66, 0xdd, 0x64, 0x38, 0x6c, 0x22, 0x67, 0x42, 0x05, 0x98, 0xea, 0xb8, 0x3e,
+	0xa9, 0x41, 0x5c, 0x35, 0xc1, 0x11, 0xb2, 0x79, 0x91, 0x58, 0xf9, 0x20, 0xb1, 0xf2, 0xd5, 0x20,
+	0xf3, 0x8a, 0x69, 0x9f, 0xff, 0xfe, 0x67, 0x39, 0x45, 0x4f, 0x0b, 0xb6, 0x5b, 0x2e, 0xfa, 0x1a,
+	0x00, 0x77, 0x11, 0xc5, 0x2e, 0x3e, 0x11, 0xfe, 0x29, 0x2e, 0xfd, 0xfb, 0x51, 0x6e, 0xbe, 0x6b,
+	0xb6, 0x9a, 0x5b, 0x5a, 0xc7, 0x95, 0xf1, 0xc6, 0x9a, 0x3e, 0xe5, 0x13, 0xea, 0x3e, 0x1d, 0x9a,
+	0x85, 0xb8, 0x63, 0xab, 0xa9, 0x35, 0x65, 0x3d, 0xa9, 0xc7, 0x1d, 0x1b, 0x55, 0x60, 0x06, 0xdf,
+	0x6d, 0x3b, 0xb4, 0x6b, 0x98, 0x96, 0xef, 0x31, 0x75, 0x62, 0x4d, 0x59, 0x9f, 0xdd, 0xbc, 0x7a,
+	0x96, 0x39, 0x65, 0x4e, 0x5c, 0xe0, 0xb4, 0x7a, 0x06, 0x87, 0xee, 0xd0, 0x37, 0x60, 0x86, 0xe2,
+	0x40, 0xa8, 0xc1, 0x88, 0x3a, 0xf9, 0x8c, 0x54, 0xca, 0xf4, 0xc9, 0xab, 0x64, 0x2b, 0x2d, 0x23,
+	0xa9, 0x68, 0x7f, 0x8e, 0x43, 0xd2, 0x77, 0x1b, 0x7a, 0x13, 0xd2, 0xc1, 0xe1, 0xe2, 0xa1, 0x9b,
+	0xde, 0x5c, 0x19, 0x72, 0x52, 0x49, 0x12, 0x08, 0x1f, 0xbd, 0xcf, 0x7d, 0x14, 0x30, 0xa1, 0xbd,
+	0x50, 0x8c, 0x5e, 0x34, 0xee, 0x22, 0x70, 0x2e, 0x2c, 0x62, 0x93, 0x36, 0xbb, 0x86, 0x0c, 0x5f,
+	0x1b, 0xbb, 0x66, 0x93, 0x75, 0xd5, 0x44, 0x04, 0x12, 0x10, 0x47, 0x3e, 0xe0, 0xc0, 0x7b, 0x02,
+	0x17, 0x6d, 0xc3, 0x74, 0xdb, 0xec, 0x92, 0x0e, 0x33, 0x5a, 0xc4, 0xc6, 0x3c, 0xcc, 0xb3, 0x9b,
+	0xda, 0x59, 0xd1, 0xd9, 0xe3, 0xa4, 0x3b, 0xc4, 0xc6, 0x3a, 0xb4, 0x7b, 0xd7, 0x5b, 0x49, 0xee,
+	0xd6, 0xdf, 0x29, 0xb0, 0x78, 0xfa, 0x80, 0xec, 0x99, 0x0e, 0xbd, 0x58, 0xe5, 0xe4, 0xd4, 0xa9,
+	0xae, 0xc1, 0xd2, 0x28, 0x9d, 0x3d, 0xb4, 0x03, 0xa9, 0xb6, 0x7f, 0xa1, 0x2a, 0xbc, 0xe6, 0x7c,
+	0xe5, 0xbc, 0x35, 0xc7, 0xe7, 0x0e, 0x9f, 0x47, 0x81, 0xa2, 0xfd, 0x2f, 0x09, 0xb9, 0xd3, 0xa4,
+	0xa5, 0xc0, 0x42, 0x1d, 0x9f, 0x98, 0xd4, 0x1e, 0x6d, 0xa0, 0xf2, 0xdc, 0xf5, 0xf2, 0x87, 0x0a,
+	0x2c, 0xd8, 0x8e, 0xc7, 0xa8, 0x73, 0xd8, 0xf1, 0xc5, 0x18, 0x94, 0xc3, 0xab, 0x71, 0x6e, 0xc8,
+	0x95, 0xbc, 0x84, 0xf1, 0xdf, 0x08, 0x3d, 0x2b, 0x4a, 0xd8, 0xda, 0x26, 0x8e, 0x5b, 0xbc, 0xe1,
+	0x2b, 0xfe, 0xab, 0xcf, 0x72, 0xaf, 0x9e, 0x2f, 0xc1, 0x7c, 0x1e, 0x4f, 0xd8, 0x89, 0xc2, 0x22,
+	0xa5, 0x41, 0xef, 0xc0, 0xac, 0x74, 0x57, 0xa0, 0x43, 0x62, 0xac, 0x3a, 0xcc, 0x48, 0x69, 0x52,
+	0x7c, 0x13, 0x52, 0x8c, 0x30, 0xb3, 0xa9, 0x26, 0xc7, 0x2a, 0x55, 0x08, 0x41, 0xf7, 0x15, 0x50,
+	0x07, 0xad, 0x35, 0x28, 0x6e, 0x99, 0x8e, 0x6b, 0x63, 0xaa, 0xa6, 0xc6, 0xaa, 0xc1, 0xf2, 0x80,
+	0xdd, 0x7a, 0x20, 0x75, 0x2b, 0x2d, 0x53, 0x5d, 0xd1, 0xfe, 0xa1, 0x0c, 0xa7, 0xdf, 0x6d, 0x87,
+	0x35, 0xaa, 0xbe, 0xea, 0xfb, 0xe2, 0x85, 0xf3, 0x3d, 0x98, 0x6f, 0x72, 0x12, 0xc3, 0xee, 0xd1,
+	0xc8, 0xb2, 0xb8, 0x7e, 0xde, 0xec, 0x0f, 0x67, 0xfe, 0x5c, 0xf3, 0xd4, 0x8f, 0xc8, 0x80, 0x0c,
+	0xf7, 0x95, 0x21, 0x7e, 0x89, 0xa4, 0x6c, 0x4e, 0x73, 0x44, 0xa1, 0x87, 0xf6, 0xdf, 0x04, 0x2c,
+	0x7c, 0x3b, 0x38, 0x0f, 0xfb, 0x4d, 0xd3, 0x6b, 0x94, 0x8f, 0xb1, 0xcb, 0xa2, 0x3a, 0x59, 0xcb,
+	0x30, 0xd1, 0xc0, 0x4e, 0xbd, 0xc1, 0xb8, 0xe6, 0x09, 0x5d, 0xde, 0xa1, 0x1b, 0x90, 0xf4, 0xbb,
+	0xb8, 0xe7, 0x7a, 0xd1, 0x72, 0x0e, 0xf4, 0x36, 0xa4, 0x6b, 0x54, 0xbe, 0x19, 0x93, 0x11, 0x78,
+	0xa3, 0x87, 0x86, 0x3c, 0xb8, 0xcc, 0xc8, 0x11, 0x76, 0x3d, 0xa3, 0x8d, 0xa9, 0xc1, 0x7b, 0x0a,
+	0xe3, 0x10, 0xd7, 0x08, 0xc5, 0x6a, 0x2a, 0x02, 0x41, 0x8b, 0x02, 0x7c, 0x0f, 0x53, 0x9e, 0x3d,
+	0x45, 0x8e, 0x8c, 0x7e, 0x00, 0xcb, 0x43, 0x42, 0xcd, 0x1a, 0xc3, 0x54, 0x9d, 0x88, 0x40, 0xe6,
+	0xc2, 0xa0, 0xcc, 0x42, 0x8d, 0x05, 0x39, 0x2e, 0x4b, 0xf9, 0xe2, 0x88, 0xd8, 0x7b, 0x68, 0x17,
+	0x26, 0x30, 0xbf, 0x92, 0xa5, 0xfc, 0xd5, 0xb3, 0x92, 0x79, 0x04, 0x77, 0x38, 0x9f, 0x25, 0x8a,
+	0xf6, 0x51, 0x1c, 0xb2, 0x23, 0x1b, 0x41, 0xce, 0x86, 0x6e, 0xc3, 0xb4, 0xe7, 0x5f, 0x18, 0x9c,
+	0x5c, 0x1e, 0xa0, 0x2f, 0x2a, 0x13, 0xbc, 0x7e, 0x12, 0x9b, 0x30, 0x23, 0x9d, 0x2b, 0xe3, 0x18,
+	0xc5, 0xf1, 0xc9, 0x08, 0x48, 0x19, 0x3f, 0x03, 0xe4, 0xbd, 0x8c, 0x5a, 0x22, 0x9a, 0x03, 0xea,
+	0x23, 0xf2, 0x68, 0x69, 0x7f, 0x8a, 0xc3, 0xf2, 0x69, 0xdf, 0x89, 0x86, 0xe4, 0x82, 0x0d, 0x1d,
+	0xbb, 0x90, 0xf2, 0x67, 0x85, 0xae, 0x3c, 0xd3, 0x5f, 0x7c, 0xe4, 0x48, 0xe1, 0x60, 0x46, 0x10,
+	0x7e, 0x88, 0xe4, 0x98, 0x4b, 0x2c, 0xed, 0xff, 0x0a, 0xcc, 0xf9, 0x9d, 0xec, 0x4d, 0xa1, 0xd5,
+	0x3e, 0x33, 0x99, 0xf7, 0xe2, 0x5d, 0x6d, 0x7f, 0x9e, 0x89, 0x47, 0x38, 0xcf, 0xf4, 0x3d, 0x90,
+	0x88, 0xd0, 0x03, 0xef, 0xc6, 0x21, 0x33, 0x60, 0xfd, 0x78, 0x86, 0xb1, 0xbe, 0xf2, 0xf1, 0xe8,
+	0x94, 0x47, 0x15, 0x48, 0xf9, 0x4d, 0x7f, 0x30, 0xd7, 0xae, 0x3f, 0x6d, 0xc6, 0x0b, 0x1b, 0x39,
+	0x90, 0x5f, 0x1c, 0x41, 0xfb, 0x40, 0x81, 0x25, 0x9d, 0x0f, 0x3a, 0xf2, 0x6d, 0x18, 0x69, 0x3a,
+	0x44, 0x6f, 0xbb, 0xf6, 0x91, 0x02, 0xf3, 0xc3, 0xca, 0xf6, 0x65, 0x29, 0x11, 0xfa, 0x79, 0x37,
+	0xf0, 0x73, 0xfc, 0xe9, 0xfb, 0x83, 0x91, 0x0e, 0x1c, 0xe1, 0xec, 0x9f, 0x29, 0xb0, 0xd4, 0x2b,
+	0xdc, 0x03, 0xfa, 0x47, 0xd4, 0x68, 0x94, 0x21, 0xe5, 0xf9, 0x78, 0x72, 0xf8, 0xbf, 0xfa, 0xb4,
+	0xea, 0x33, 0x52, 0x4f, 0xce, 0xad, 0xfd, 0x32, 0x0d, 0xb3, 0x01, 0x49, 0xa7, 0xd5, 0x32, 0x69,
+	0x17, 0xd5, 0x21, 0x28, 0x99, 0xd8, 0x36, 0x22, 0x3c, 0x28, 0x97, 0x7a, 0xa8, 0xb2, 0x9b, 0x1c,
+	0x10, 0x14, 0x61, 0xfe, 0xf4, 0x05, 0x55, 0x45, 0x70, 0x4d, 0x98, 0x91, 0x6d, 0xab, 0x34, 0x27,
+	0x8a, 0xf2, 0x92, 0x11, 0x90, 0xd2, 0x96, 0xbe, 0x88, 0x08, 0x6b, 0xb8, 0x14, 0x21, 0xad, 0xf8,
+	0x2e, 0x4c, 0xd7, 0x28, 0xc6, 0x81, 0x80, 0x28, 0x5a, 0x34, 0xf0, 0x01, 0x25, 0xbc, 0x05, 0xb3,
+	0x27, 0xbc, 0x57, 0xc5, 0xb6, 0xc1, 0x8f, 0x75, 0x24, 0x0d, 0xd9, 0x4c, 0x80, 0xa9, 0xfb, 0x90,
+	0x88, 0xc0, 0x22, 0xae, 0xd5, 0xb0, 0xc5, 0x9c, 0x63, 0x6c, 0xb4, 0x3a, 0x4d, 0xe6, 0xb4, 0x9b,
+	0x0e, 0xa6, 0xea, 0x64, 0x04, 0xa2, 0x16, 0x7a, 0xc8, 0x3b, 0x3d, 0xe0, 0x33, 0x27, 0xdd, 0xf4,
+	0x05, 0x98, 0x74, 0xa7, 0x5e, 0xe6, 0xa4, 0x5b, 0x80, 0x69, 0x17, 0xdf, 0x65, 0x72, 0x69, 0xa4,
+	0xc2, 0x33, 0xe7, 0x90, 0x24, 0x9f, 0x41, 0xc0, 0x67, 0x12, 0xed, 0x97, 0xf6, 0x6b, 0x05, 0x2e,
+	0x0f, 0xd5, 0x34, 0x59, 0x34, 0x22, 0xaa, 0x6a, 0xdf, 0x84, 0x49, 0x4f, 0x20, 0xca, 0xba, 0xf6,
+	0xca, 0xb3, 0xea, 0x9a, 0xa0, 0x1e, 0xd8, 0xe0, 0x4a, 0x04, 0xed, 0x47, 0x71, 0x00, 0x61, 0x7d,
+	0x09, 0x1f, 0xb2, 0x0b, 0xd6, 0x3d, 0x36, 0x60, 0xc2, 0x6c, 0x91, 0x8e, 0xcb, 0xe4, 0x9b, 0x7d,
+	0x65, 0x64, 0x1a, 0xf0, 0x1c, 0x78, 0x5d, 0xe6, 0xc0, 0xfa, 0x39, 0x72, 0x20, 0x94, 0x00, 0x12,
+	0x3f, 0x34, 0xfe, 0xfc, 0x38, 0x0e, 0x97, 0x4e, 0x2d, 0x00, 0x2e, 0x98, 0x57, 0xdc, 0x53, 0x5e,
+	0x19, 0xd7, 0xe1, 0x18, 0xf6, 0xcd, 0x07, 0x0a, 0xcc, 0x15, 0x89, 0xdb, 0xf1, 0x8a, 0xd8, 0xc5,
+	0x35, 0xc7, 0x72, 0x64, 0x56, 0x47, 0xe1, 0x9c, 0x0a, 0x2c, 0x1c, 0xf6, 0x51, 0xcf, 0xed, 0x1e,
+	0x14, 0x62, 0x0a, 0x56, 0x93, 0x7d, 0x85, 0xff, 0x93, 0x80, 0xf9, 0x82, 0x65, 0xd1, 0x8e, 0xd9,
+	0xdc, 0x6e, 0x60, 0xeb, 0xa8, 0x4d, 0x1c, 0xf7, 0xa2, 0x25, 0xf9, 0xf7, 0x61, 0x52, 0xd4, 0x3a,
+	0x6f, 0x6c, 0x59, 0x1e, 0x08, 0x40, 0x6d, 0x98, 0x34, 0x7d, 0x77, 0x60, 0x7b, 0xcc, 0xcb, 0xbc,
+	0x40, 0x8c, 0xbf, 0x3c, 0xf4, 0xcf, 0xd0, 0xdd, 0x31, 0xaf, 0xee, 0x84, 0x90, 0x50, 0xe4, 0xff,
+	0xa2, 0xc0, 0xa5, 0x6f, 0x75, 0x4c, 0x6a, 0xba, 0xcc, 0x71, 0xb1, 0x7d, 0xf1, 0x16, 0xe8, 0x68,
+	0x11, 0x52, 0x98, 0x52, 0x22, 0xb7, 0x03, 0xba, 0xb8, 0x09, 0xed, 0xc6, 0x92, 0xe1, 0xdd, 0x58,
+	0xc8, 0xb2, 0xdf, 0x2a, 0x30, 0xb5, 0xe3, 0xb8, 0xac, 0xdc, 0x26, 0x56, 0x03, 0x6d, 0xf1, 0x16,
+	0x97, 0x06, 0x0b, 0x92, 0xf3, 0x2d, 0xcd, 0x04, 0x8b, 0x5f, 0x5e, 0x5b, 0x8e, 0xcb, 0x70, 0xb0,
+	0xd3, 0x1e, 0x43, 0x79, 0x15, 0xf8, 0xda, 0xef, 0x15, 0xc8, 0x14, 0x3b, 0x76, 0x1d, 0xb3, 0xdb,
+	0x8e, 0x6b, 0x93, 0x93, 0x17, 0x52, 0xbb, 0x09, 0x69, 0x8b, 0xb8, 0x5e, 0xa7, 0x35, 0x46, 0xc5,
+	0x7b, 0x12, 0xb4, 0x9f, 0x28, 0x30, 0xcd, 0xb7, 0x11, 0x65, 0xcf, 0xa2, 0xe4, 0x04, 0xad, 0x40,
+	0x9a, 0xaf, 0x22, 0x0c, 0xc7, 0xe6, 0xca, 0x27, 0xc5, 0xd7, 0xd0, 0x6e, 0xc5, 0x0e, 0x15, 0xe6,
+	0xf8, 0x4b, 0x2e, 0xcc, 0xef, 0xc6, 0xc5, 0xf7, 0x97, 0x5e, 0x2b, 0x13, 0x7c, 0x7c, 0xbd, 0x60,
+	0x39, 0x5f, 0x0d, 0xbd, 0xba, 0x9e, 0xb7, 0x99, 0xad, 0xb8, 0x2c, 0xd4, 0xcc, 0x56, 0x5c, 0x36,
+	0xec, 0x87, 0x6b, 0x7f, 0x54, 0x20, 0x13, 0xfe, 0xf4, 0x89, 0x6e, 0x80, 0x5a, 0x7e, 0x7b, 0xaf,
+	0xa2, 0xdf, 0x31, 0x0a, 0xdb, 0xd5, 0xca, 0xad, 0x5d, 0xe3, 0x60, 0xb7, 0x54, 0xbe, 0x59, 0x7e,
+	0xab, 0x50, 0x2d, 0xcf, 0xc5, 0xb2, 0xd9, 0x7b, 0x0f, 0xd6, 0x96, 0xc3, 0xf4, 0x07, 0xbd, 0xaf,
+	0xb1, 0xe8, 0x4d, 0xb8, 0x32, 0xc8, 0xb9, 0x5f, 0x2d, 0xdc, 0x31, 0x02, 0xe6, 0xd2, 0x9c, 0x92,
+	0xfd, 0xd2, 0xbd, 0x07, 0x6b, 0x2b, 0x61, 0xee, 0x7d, 0x66, 0x76, 0xe5, 0x0a, 0x0b, 0xdb, 0xc3,
+	0xa2, 0xf5, 0x72, 0x4f, 0x74, 0x7c, 0x58, 0xb4, 0xde, 0xfb, 0xc8, 0x9a, 0x4d, 0xbe, 0xf7, 0xd3,
+	0xd5, 0xd8, 0xb5, 0x77, 0x00, 0xfa, 0xdf, 0x09, 0xd1, 0x26, 0x2c, 0xed, 0x15, 0xee, 0xdc, 0x3a,
+	0xa8, 0x1a, 0x3b, 0xb7, 0x4a, 0x65, 0x63, 0xbf, 0xaa, 0x97, 0x0b, 0x3b, 0x95, 0xdd, 0xb7, 0xe6,
+	0x62, 0xd9, 0xcb, 0xf7, 0x1e, 0xac, 0x2d, 0xf4, 0x49, 0xf7, 0x19, 0xc5, 0xfe, 0x5f, 0x35, 0xd4,
+	0xd1, 0xeb, 0x70, 0x39, 0xcc, 0x53, 0xa8, 0x1a, 0x3b, 0x85, 0xea, 0x81, 0x5e, 0xa9, 0xde, 0x99,
+	0x53, 0xb2, 0xea, 0xbd, 0x07, 0x6b, 0x8b, 0x7d, 0xae, 0x02, 0xdb, 0x31, 0x59, 0x87, 0x3a, 0xac,
+	0x2b, 0xc4, 0x17, 0xdf, 0xf8, 0xf8, 0xf1, 0xaa, 0xf2, 0xc9, 0xe3, 0x55, 0xe5, 0xef, 0x8f, 0x57,
+	0x95, 0xfb, 0x4f, 0x56, 0x63, 0x9f, 0x3c, 0x59, 0x8d, 0xfd, 0xf5, 0xc9, 0x6a, 0xec, 0x3b, 0x5a,
+	0x28, 0x58, 0xa2, 0xf1, 0xc4, 0xc7, 0xad, 0xde, 0x1f, 0x77, 0xf0, 0x60, 0x1d, 0x4e, 0xf0, 0x83,
+	0xfc, 0xd5, 0xcf, 0x07, 0x00, 0x94, 0x99, 0x10, 0x15, 0xfb, 0x21, 0x00, 0x00,
+}
+
+func (this *LockedDelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LockedDelegationEntry)
+	if !ok {
+		that2, ok := that.(LockedDelegationEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Shares.Equal(that1.Shares) {
+		return false
+	}
+	if !this.Rate.Equal(&that1.Rate) {
+		return false
+	}
+	if !this.UnlockOn.Equal(that1.UnlockOn) {
+		return false
+	}
+	if this.AutoRenew != that1.AutoRenew {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ExpiryAction != that1.ExpiryAction {
+		return false
+	}
+	if this.RedelegateTo != that1.RedelegateTo {
+		return false
+	}
+	return true
+}
+func (this *Rate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Rate)
+	if !ok {
+		that2, ok := that.(Rate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if !this.EarlyUnlockPenalty.Equal(that1.EarlyUnlockPenalty) {
+		return false
+	}
+	if this.PayoutMode != that1.PayoutMode {
+		return false
+	}
+	return true
+}
+func (m *LockedDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedDelegationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedelegateTo) > 0 {
+		i -= len(m.RedelegateTo)
+		copy(dAtA[i:], m.RedelegateTo)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.RedelegateTo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryAction != 0 {
+		i = encodeVarintLocking(dAtA, i, uint64(m.ExpiryAction))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Id != 0 {
+		i = encodeVarintLocking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockOn, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockOn):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLocking(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Rate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Rate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Rate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PayoutMode != 0 {
+		i = encodeVarintLocking(dAtA, i, uint64(m.PayoutMode))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.EarlyUnlockPenalty.Size()
+		i -= size
+		if _, err := m.EarlyUnlockPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintLocking(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedDelegationPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedDelegationPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedDelegationPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedDelegationPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedDelegationPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedDelegationPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedDelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedDelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedDelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockingRewardRemainder) > 0 {
+		for iNdEx := len(m.LockingRewardRemainder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockingRewardRemainder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LockingReward) > 0 {
+		for iNdEx := len(m.LockingReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockingReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DistributionReward) > 0 {
+		for iNdEx := len(m.DistributionReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedDelegationWithTotalShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedDelegationWithTotalShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedDelegationWithTotalShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalLocked.Size()
+		i -= size
+		if _, err := m.TotalLocked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.LockedDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSlashEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSlashEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokensPerShareAfter.Size()
+		i -= size
+		if _, err := m.TokensPerShareAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TokensPerShareBefore.Size()
+		i -= size
+		if _, err := m.TokensPerShareBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintLocking(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintLocking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSlashEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSlashEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSlashEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedDelegationEntrySlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedDelegationEntrySlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedDelegationEntrySlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokensAfter.Size()
+		i -= size
+		if _, err := m.TokensAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TokensBefore.Size()
+		i -= size
+		if _, err := m.TokensBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SlashEvent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedDelegationUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedDelegationUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedDelegationUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLockingStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLockingStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLockingStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintLocking(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LockingStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockingStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockingStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateTotalLockingStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateTotalLockingStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateTotalLockingStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintLocking(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TotalLockingStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TotalLockingStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalLockingStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLockingStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLockingStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLockingStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockingSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockingSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockingSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextUnlock != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextUnlock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextUnlock):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintLocking(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.LockingReward) > 0 {
+		for iNdEx := len(m.LockingReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockingReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DistributionReward) > 0 {
+		for iNdEx := len(m.DistributionReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.EffectiveMultiplier.Size()
+		i -= size
+		if _, err := m.EffectiveMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.WeightedRatio.Size()
+		i -= size
+		if _, err := m.WeightedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FreeTokens.Size()
+		i -= size
+		if _, err := m.FreeTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LockedTokens.Size()
+		i -= size
+		if _, err := m.LockedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LockedShares.Size()
+		i -= size
+		if _, err := m.LockedShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DelegatedTokens.Size()
+		i -= size
+		if _, err := m.DelegatedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DelegatedShares.Size()
+		i -= size
+		if _, err := m.DelegatedShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLockingSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLockingSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLockingSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardRemainder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardRemainder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardRemainder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BonusBeneficiary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BonusBeneficiary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BonusBeneficiary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BeneficiaryAddress) > 0 {
+		i -= len(m.BeneficiaryAddress)
+		copy(dAtA[i:], m.BeneficiaryAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.BeneficiaryAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccrualCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccrualCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccrualCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Accrued) > 0 {
+		for iNdEx := len(m.Accrued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accrued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantinedPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintLocking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintLocking(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BudgetWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BudgetWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BudgetWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Consumed) > 0 {
+		for iNdEx := len(m.Consumed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintLocking(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EntryEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntryEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntryEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EntryId != 0 {
+		i = encodeVarintLocking(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockingRewardCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockingRewardCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockingRewardCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLocking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLocking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLocking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLocking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LockedDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	if m.AutoCompound {
+		n += 2
+	}
+	return n
+}
+
+func (m *LockedDelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shares.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockOn)
+	n += 1 + l + sovLocking(uint64(l))
+	if m.AutoRenew {
+		n += 2
+	}
+	if m.Id != 0 {
+		n += 1 + sovLocking(uint64(m.Id))
+	}
+	if m.ExpiryAction != 0 {
+		n += 1 + sovLocking(uint64(m.ExpiryAction))
+	}
+	l = len(m.RedelegateTo)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	return n
+}
+
+func (m *Rate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.EarlyUnlockPenalty.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	if m.PayoutMode != 0 {
+		n += 1 + sovLocking(uint64(m.PayoutMode))
+	}
+	return n
+}
+
+func (m *LockedDelegationPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	return n
+}
+
+func (m *LockedDelegationPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockedDelegationDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	if len(m.DistributionReward) > 0 {
+		for _, e := range m.DistributionReward {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	if len(m.LockingReward) > 0 {
+		for _, e := range m.LockingReward {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	if len(m.LockingRewardRemainder) > 0 {
+		for _, e := range m.LockingRewardRemainder {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockedDelegationWithTotalShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LockedDelegation.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.TotalLocked.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	return n
+}
+
+func (m *ValidatorSlashEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLocking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.Fraction.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.TokensPerShareBefore.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.TokensPerShareAfter.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	return n
+}
+
+func (m *ValidatorSlashEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockedDelegationEntrySlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashEvent.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.TokensBefore.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.TokensAfter.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	return n
+}
+
+func (m *LockedDelegationUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = m.Entry.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.Tokens.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	return n
+}
+
+func (m *RateLockingStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.Tokens.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	return n
+}
+
+func (m *LockingStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shares.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.Tokens.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateTotalLockingStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.Tokens.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	return n
+}
+
+func (m *TotalLockingStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tokens.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorLockingStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	return n
+}
+
+func (m *LockingSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DelegatedShares.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.DelegatedTokens.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.LockedShares.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.LockedTokens.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.FreeTokens.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.WeightedRatio.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	l = m.EffectiveMultiplier.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	if len(m.DistributionReward) > 0 {
+		for _, e := range m.DistributionReward {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	if len(m.LockingReward) > 0 {
+		for _, e := range m.LockingReward {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	if m.NextUnlock != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextUnlock)
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorLockingSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = m.Summary.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	return n
+}
+
+func (m *RewardDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardRemainder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BonusBeneficiary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = len(m.BeneficiaryAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	return n
+}
+
+func (m *AccrualCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	if len(m.Accrued) > 0 {
+		for _, e := range m.Accrued {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuarantinedPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLocking(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MintEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovLocking(uint64(l))
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BudgetWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovLocking(uint64(l))
+	if len(m.Consumed) > 0 {
+		for _, e := range m.Consumed {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EntryEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntryId != 0 {
+		n += 1 + sovLocking(uint64(m.EntryId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovLocking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockingRewardCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLocking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLocking(uint64(l))
+	return n
+}
+
+func sovLocking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLocking(x uint64) (n int) {
+	return sovLocking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LockedDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, LockedDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedDelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedDelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockOn, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryAction", wireType)
+			}
+			m.ExpiryAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryAction |= ExpiryAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegateTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegateTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Rate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Rate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Rate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyUnlockPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyUnlockPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutMode", wireType)
+			}
+			m.PayoutMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutMode |= PayoutMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedDelegationPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedDelegationPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedDelegationPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedDelegationPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedDelegationPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedDelegationPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, LockedDelegationPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedDelegationDelegatorReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedDelegationDelegatorReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedDelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionReward = append(m.DistributionReward, types.DecCoin{})
+			if err := m.DistributionReward[len(m.DistributionReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockingReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockingReward = append(m.LockingReward, types.DecCoin{})
+			if err := m.LockingReward[len(m.LockingReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.DecCoin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockingRewardRemainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockingRewardRemainder = append(m.LockingRewardRemainder, types.DecCoin{})
+			if err := m.LockingRewardRemainder[len(m.LockingRewardRemainder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedDelegationWithTotalShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedDelegationWithTotalShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedDelegationWithTotalShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensPerShareBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokensPerShareBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensPerShareAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokensPerShareAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, ValidatorSlashEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedDelegationEntrySlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedDelegationEntrySlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedDelegationEntrySlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokensBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokensAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedDelegationUnlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedDelegationUnlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedDelegationUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLockingStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLockingStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLockingStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockingStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockingStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockingStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rates = append(m.Rates, RateLockingStats{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateTotalLockingStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateTotalLockingStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateTotalLockingStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalLockingStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalLockingStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalLockingStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rates = append(m.Rates, RateTotalLockingStats{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLockingStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLockingStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLockingStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockingSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockingSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockingSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FreeTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionReward = append(m.DistributionReward, types.DecCoin{})
+			if err := m.DistributionReward[len(m.DistributionReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockingReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockingReward = append(m.LockingReward, types.DecCoin{})
+			if err := m.LockingReward[len(m.LockingReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextUnlock == nil {
+				m.NextUnlock = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextUnlock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLockingSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLockingSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLockingSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *LockedDelegationWithTotalShares) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.LockedDelegation.Size()
-	n += 1 + l + sovLocking(uint64(l))
-	l = m.TotalLocked.Size()
-	n += 1 + l + sovLocking(uint64(l))
-	return n
-}
-
-func sovLocking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLocking(x uint64) (n int) {
-	return sovLocking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *LockedDelegation) Unmarshal(dAtA []byte) error {
+func (m *RewardDebt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1021,10 +6730,10 @@ func (m *LockedDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockedDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardDebt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockedDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardDebt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1093,7 +6802,7 @@ func (m *LockedDelegation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1120,8 +6829,8 @@ func (m *LockedDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, LockedDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1146,7 +6855,7 @@ func (m *LockedDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockedDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *RewardRemainder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1169,15 +6878,15 @@ func (m *LockedDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockedDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardRemainder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockedDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardRemainder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1205,15 +6914,13 @@ func (m *LockedDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocking
@@ -1223,28 +6930,27 @@ func (m *LockedDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLocking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLocking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1271,15 +6977,66 @@ func (m *LockedDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockOn, dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BonusBeneficiary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BonusBeneficiary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BonusBeneficiary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocking
@@ -1289,17 +7046,29 @@ func (m *LockedDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AutoRenew = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
 			}
-			m.Id = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryAddress", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocking
@@ -1309,11 +7078,24 @@ func (m *LockedDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeneficiaryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLocking(dAtA[iNdEx:])
@@ -1335,7 +7117,7 @@ func (m *LockedDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Rate) Unmarshal(dAtA []byte) error {
+func (m *AccrualCheckpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1358,15 +7140,113 @@ func (m *Rate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Rate: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccrualCheckpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Rate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccrualCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1393,15 +7273,16 @@ func (m *Rate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+			m.Accrued = append(m.Accrued, types.DecCoin{})
+			if err := m.Accrued[len(m.Accrued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocking
@@ -1411,23 +7292,23 @@ func (m *Rate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLocking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLocking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Index = append(m.Index, types.DecCoin{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1452,7 +7333,7 @@ func (m *Rate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockedDelegationPair) Unmarshal(dAtA []byte) error {
+func (m *QuarantinedPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1475,10 +7356,10 @@ func (m *LockedDelegationPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockedDelegationPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuarantinedPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockedDelegationPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuarantinedPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1545,6 +7426,57 @@ func (m *LockedDelegationPair) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLocking(dAtA[iNdEx:])
@@ -1566,7 +7498,7 @@ func (m *LockedDelegationPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockedDelegationPairs) Unmarshal(dAtA []byte) error {
+func (m *MintEpoch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1589,15 +7521,15 @@ func (m *LockedDelegationPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockedDelegationPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintEpoch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockedDelegationPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1624,8 +7556,41 @@ func (m *LockedDelegationPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, LockedDelegationPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = append(m.Minted, types.Coin{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1650,7 +7615,7 @@ func (m *LockedDelegationPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockedDelegationDelegatorReward) Unmarshal(dAtA []byte) error {
+func (m *BudgetWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1673,17 +7638,17 @@ func (m *LockedDelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockedDelegationDelegatorReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: BudgetWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockedDelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BudgetWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocking
@@ -1693,27 +7658,28 @@ func (m *LockedDelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLocking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLocking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1740,16 +7706,66 @@ func (m *LockedDelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionReward = append(m.DistributionReward, types.DecCoin{})
-			if err := m.DistributionReward[len(m.DistributionReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Consumed = append(m.Consumed, types.Coin{})
+			if err := m.Consumed[len(m.Consumed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockingReward", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntryEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntryEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntryEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocking
@@ -1759,29 +7775,14 @@ func (m *LockedDelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EntryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLocking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLocking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LockingReward = append(m.LockingReward, types.DecCoin{})
-			if err := m.LockingReward[len(m.LockingReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1808,8 +7809,8 @@ func (m *LockedDelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.DecCoin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1834,7 +7835,7 @@ func (m *LockedDelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockedDelegationWithTotalShares) Unmarshal(dAtA []byte) error {
+func (m *LockingRewardCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1857,17 +7858,17 @@ func (m *LockedDelegationWithTotalShares) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockedDelegationWithTotalShares: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockingRewardCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockedDelegationWithTotalShares: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockingRewardCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocking
@@ -1877,28 +7878,27 @@ func (m *LockedDelegationWithTotalShares) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLocking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLocking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockedDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1926,7 +7926,39 @@ func (m *LockedDelegationWithTotalShares) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12,6 +12,16 @@ const (
 	TypeMsgCreateLockedDelegation     = "create_locked_delegation"
 	TypeMsgRedelegateLockedDelegation = "redelegate_locked_delegations"
 	TypeMsgToggleAutoRenew            = "toggle_auto_renew"
+	TypeMsgSetExpiryAction            = "set_expiry_action"
+	TypeMsgEarlyUnlock                = "early_unlock"
+	TypeMsgSplitLockedDelegationEntry = "split_locked_delegation_entry"
+	TypeMsgExtendLock                 = "extend_lock"
+	TypeMsgLockExistingDelegation     = "lock_existing_delegation"
+	TypeMsgFundRewardPool             = "fund_reward_pool"
+	TypeMsgClaimRewardDebt            = "claim_reward_debt"
+	TypeMsgSetBonusBeneficiary        = "set_bonus_beneficiary"
+	TypeMsgSetAutoCompound            = "set_auto_compound"
+	TypeMsgWithdrawLockingRewards     = "withdraw_locking_rewards"
 	TypeMsgUpdateParams               = "update_params"
 )
 
@@ -19,6 +29,18 @@ var (
 	_ sdk.Msg = &MsgCreateLockedDelegation{}
 	_ sdk.Msg = &MsgRedelegateLockedDelegations{}
 	_ sdk.Msg = &MsgToggleAutoRenew{}
+	_ sdk.Msg = &MsgSetExpiryAction{}
+	_ sdk.Msg = &MsgEarlyUnlock{}
+	_ sdk.Msg = &MsgSplitLockedDelegationEntry{}
+	_ sdk.Msg = &MsgExtendLock{}
+	_ sdk.Msg = &MsgLockExistingDelegation{}
+	_ sdk.Msg = &MsgFundRewardPool{}
+	_ sdk.Msg = &MsgClaimRewardDebt{}
+	_ sdk.Msg = &MsgSetBonusBeneficiary{}
+	_ sdk.Msg = &MsgSetAutoCompound{}
+	_ sdk.Msg = &MsgWithdrawLockingRewards{}
+	_ sdk.Msg = &MsgRetryQuarantinedPairs{}
+	_ sdk.Msg = &MsgFundFromCommunityPool{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
@@ -29,6 +51,8 @@ func NewMsgCreateLockedDelegation(
 	amount sdk.Coin,
 	lockDuration time.Duration,
 	autoRenew bool,
+	expiryAction ExpiryAction,
+	redelegateTo string,
 ) *MsgCreateLockedDelegation {
 	return &MsgCreateLockedDelegation{
 		DelegatorAddress: delAddr.String(),
@@ -36,6 +60,8 @@ func NewMsgCreateLockedDelegation(
 		Amount:           amount,
 		LockDuration:     lockDuration,
 		AutoRenew:        autoRenew,
+		ExpiryAction:     expiryAction,
+		RedelegateTo:     redelegateTo,
 	}
 }
 
@@ -71,7 +97,7 @@ func (msg MsgCreateLockedDelegation) ValidateBasic() error {
 	if err := ValidateNonZeroDuration(msg.LockDuration); err != nil {
 		return sdkerrors.ErrInvalidRequest.Wrapf(ErrLockDurationInvalid, ModuleName, err)
 	}
-	return nil
+	return ValidateExpiryAction(msg.ExpiryAction, msg.RedelegateTo)
 }
 
 // MsgRedelegateLockedDelegation creates a new MsgRedelegateLockedDelegation
@@ -163,6 +189,456 @@ func (msg MsgToggleAutoRenew) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgSetExpiryAction creates a new MsgSetExpiryAction
+func NewMsgSetExpiryAction(
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	id uint64,
+	expiryAction ExpiryAction,
+	redelegateTo string,
+) *MsgSetExpiryAction {
+	return &MsgSetExpiryAction{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Id:               id,
+		ExpiryAction:     expiryAction,
+		RedelegateTo:     redelegateTo,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgSetExpiryAction) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgSetExpiryAction) Type() string { return TypeMsgSetExpiryAction }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgSetExpiryAction) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSetExpiryAction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgSetExpiryAction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	return ValidateExpiryAction(msg.ExpiryAction, msg.RedelegateTo)
+}
+
+// NewMsgEarlyUnlock creates a new MsgEarlyUnlock
+func NewMsgEarlyUnlock(
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	ids []uint64,
+) *MsgEarlyUnlock {
+	return &MsgEarlyUnlock{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Ids:              ids,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgEarlyUnlock) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgEarlyUnlock) Type() string { return TypeMsgEarlyUnlock }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgEarlyUnlock) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgEarlyUnlock) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgEarlyUnlock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	if len(msg.Ids) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf(ErrEntryIdsEmpty, ModuleName)
+	}
+	return nil
+}
+
+// NewMsgSplitLockedDelegationEntry creates a new MsgSplitLockedDelegationEntry
+func NewMsgSplitLockedDelegationEntry(
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	id uint64,
+	shares sdk.Dec,
+) *MsgSplitLockedDelegationEntry {
+	return &MsgSplitLockedDelegationEntry{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Id:               id,
+		Shares:           shares,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgSplitLockedDelegationEntry) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgSplitLockedDelegationEntry) Type() string { return TypeMsgSplitLockedDelegationEntry }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgSplitLockedDelegationEntry) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSplitLockedDelegationEntry) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgSplitLockedDelegationEntry) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	if err := ValidatePositiveDec(msg.Shares); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf(ErrSharesInvalid, ModuleName, err)
+	}
+	return nil
+}
+
+// NewMsgExtendLock creates a new MsgExtendLock
+func NewMsgExtendLock(
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	id uint64,
+	lockDuration time.Duration,
+) *MsgExtendLock {
+	return &MsgExtendLock{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Id:               id,
+		LockDuration:     lockDuration,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgExtendLock) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgExtendLock) Type() string { return TypeMsgExtendLock }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgExtendLock) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgExtendLock) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgExtendLock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	if err := ValidateNonZeroDuration(msg.LockDuration); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf(ErrLockDurationInvalid, ModuleName, err)
+	}
+	return nil
+}
+
+// NewMsgLockExistingDelegation creates a new MsgLockExistingDelegation
+func NewMsgLockExistingDelegation(
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	amount sdk.Coin,
+	lockDuration time.Duration,
+	autoRenew bool,
+	expiryAction ExpiryAction,
+	redelegateTo string,
+) *MsgLockExistingDelegation {
+	return &MsgLockExistingDelegation{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+		LockDuration:     lockDuration,
+		AutoRenew:        autoRenew,
+		ExpiryAction:     expiryAction,
+		RedelegateTo:     redelegateTo,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgLockExistingDelegation) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgLockExistingDelegation) Type() string { return TypeMsgLockExistingDelegation }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgLockExistingDelegation) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgLockExistingDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgLockExistingDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	if err := ValidatePositiveCoin(msg.Amount); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf(ErrSharesInvalid, ModuleName, err)
+	}
+	if err := ValidateNonZeroDuration(msg.LockDuration); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf(ErrLockDurationInvalid, ModuleName, err)
+	}
+	return ValidateExpiryAction(msg.ExpiryAction, msg.RedelegateTo)
+}
+
+// NewMsgFundRewardPool creates a new MsgFundRewardPool
+func NewMsgFundRewardPool(
+	depositor sdk.AccAddress,
+	amount sdk.Coins,
+) *MsgFundRewardPool {
+	return &MsgFundRewardPool{
+		Depositor: depositor.String(),
+		Amount:    amount,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgFundRewardPool) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgFundRewardPool) Type() string { return TypeMsgFundRewardPool }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgFundRewardPool) GetSigners() []sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(msg.Depositor)
+	return []sdk.AccAddress{depositor}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgFundRewardPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgFundRewardPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrDepositorAddressInvalid, ModuleName, err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrapf(ErrFundAmountInvalid, ModuleName, msg.Amount)
+	}
+	return nil
+}
+
+// NewMsgClaimRewardDebt creates a new MsgClaimRewardDebt
+func NewMsgClaimRewardDebt(
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+) *MsgClaimRewardDebt {
+	return &MsgClaimRewardDebt{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgClaimRewardDebt) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgClaimRewardDebt) Type() string { return TypeMsgClaimRewardDebt }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgClaimRewardDebt) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgClaimRewardDebt) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgClaimRewardDebt) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	return nil
+}
+
+// NewMsgSetBonusBeneficiary creates a new MsgSetBonusBeneficiary
+// an empty beneficiary removes the bonus beneficiary
+func NewMsgSetBonusBeneficiary(
+	delAddr sdk.AccAddress,
+	beneficiary sdk.AccAddress,
+) *MsgSetBonusBeneficiary {
+	msg := &MsgSetBonusBeneficiary{
+		DelegatorAddress: delAddr.String(),
+	}
+	if !beneficiary.Empty() {
+		msg.BeneficiaryAddress = beneficiary.String()
+	}
+	return msg
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgSetBonusBeneficiary) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgSetBonusBeneficiary) Type() string { return TypeMsgSetBonusBeneficiary }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgSetBonusBeneficiary) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSetBonusBeneficiary) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgSetBonusBeneficiary) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if msg.BeneficiaryAddress == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.BeneficiaryAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrBeneficiaryInvalid, ModuleName, err)
+	}
+	return nil
+}
+
+// NewMsgSetAutoCompound creates a new MsgSetAutoCompound
+func NewMsgSetAutoCompound(
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	autoCompound bool,
+) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		AutoCompound:     autoCompound,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgSetAutoCompound) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	return nil
+}
+
+// NewMsgWithdrawLockingRewards creates a new MsgWithdrawLockingRewards
+func NewMsgWithdrawLockingRewards(
+	delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+) *MsgWithdrawLockingRewards {
+	return &MsgWithdrawLockingRewards{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgWithdrawLockingRewards) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgWithdrawLockingRewards) Type() string { return TypeMsgWithdrawLockingRewards }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgWithdrawLockingRewards) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgWithdrawLockingRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgWithdrawLockingRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrDelegatorAddressInvalid, ModuleName, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	return nil
+}
+
 // GetSignBytes returns the message bytes to sign over.
 func (m *MsgUpdateParams) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(m)
@@ -182,3 +658,54 @@ func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// GetSignBytes returns the message bytes to sign over.
+func (m *MsgRetryQuarantinedPairs) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic executes sanity validation on the provided data
+func (m *MsgRetryQuarantinedPairs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrAuthorityInvalid, ModuleName, err)
+	}
+	if len(m.Pairs) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf(ErrPairsEmpty, ModuleName)
+	}
+	for _, pair := range m.Pairs {
+		if err := pair.Validate(); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+		}
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgRetryQuarantinedPairs message
+func (m *MsgRetryQuarantinedPairs) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (m *MsgFundFromCommunityPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic executes sanity validation on the provided data
+func (m *MsgFundFromCommunityPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(ErrAuthorityInvalid, ModuleName, err)
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrapf(ErrFundAmountInvalid, ModuleName, m.Amount)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgFundFromCommunityPool message
+func (m *MsgFundFromCommunityPool) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
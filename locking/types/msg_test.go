@@ -32,9 +32,24 @@ func TestMsgCreateLockedDelegationValidateBasic(t *testing.T) {
 				coin,
 				time.Hour,
 				false,
+				types.ExpiryActionUndelegate,
+				"",
 			),
 			pass: true,
 		},
+		{
+			name: "fail - redelegate without validator",
+			msg: *types.NewMsgCreateLockedDelegation(
+				addr,
+				valAddr,
+				coin,
+				time.Hour,
+				false,
+				types.ExpiryActionRedelegate,
+				"",
+			),
+			pass: false,
+		},
 		{
 			name: "fail - bad DelegatorAddress",
 			msg: types.MsgCreateLockedDelegation{
@@ -104,6 +119,99 @@ func TestMsgCreateLockedDelegationValidateBasic(t *testing.T) {
 	}
 }
 
+// TestMsgLockExistingDelegationValidateBasic tests the ValidateBasic method of the MsgLockExistingDelegation
+func TestMsgLockExistingDelegationValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("address"))
+	valAddr := sdk.ValAddress([]byte("val"))
+	coin := sdk.NewCoin("test", sdk.OneInt())
+
+	tests := []struct {
+		name string
+		msg  types.MsgLockExistingDelegation
+		pass bool
+	}{
+		{
+			name: "pass",
+			msg: *types.NewMsgLockExistingDelegation(
+				addr,
+				valAddr,
+				coin,
+				time.Hour,
+				false,
+				types.ExpiryActionUndelegate,
+				"",
+			),
+			pass: true,
+		},
+		{
+			name: "fail - bad DelegatorAddress",
+			msg: types.MsgLockExistingDelegation{
+				DelegatorAddress: "",
+				ValidatorAddress: valAddr.String(),
+				Amount:           coin,
+				LockDuration:     time.Hour,
+			},
+			pass: false,
+		},
+		{
+			name: "fail - bad ValidatorAddress",
+			msg: types.MsgLockExistingDelegation{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: "",
+				Amount:           coin,
+				LockDuration:     time.Hour,
+			},
+			pass: false,
+		},
+		{
+			name: "fail - bad Amount",
+			msg: types.MsgLockExistingDelegation{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: valAddr.String(),
+				Amount:           sdk.NewCoin("test", sdk.ZeroInt()),
+				LockDuration:     time.Hour,
+			},
+			pass: false,
+		},
+		{
+			name: "fail - bad lock duration",
+			msg: types.MsgLockExistingDelegation{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: valAddr.String(),
+				Amount:           coin,
+				LockDuration:     0,
+			},
+			pass: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.pass {
+				require.NoError(t, err)
+
+				// Validate the other params
+				require.Equal(t, types.RouterKey, tc.msg.Route())
+				require.Equal(t, types.TypeMsgLockExistingDelegation, tc.msg.Type())
+
+				// Test the Get signers
+				delegator, err := sdk.AccAddressFromBech32(tc.msg.DelegatorAddress)
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{delegator}, tc.msg.GetSigners())
+
+				// Test the GetSignBytes
+				// Since the object never changes, we can remove the lint for gosec
+				//nolint:gosec
+				bz := types.ModuleCdc.MustMarshalJSON(&tc.msg)
+				require.Equal(t, sdk.MustSortJSON(bz), tc.msg.GetSignBytes())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 // TestMsgRedelegateLockedDelegationValidateBasic tests the ValidateBasic method of the
 // NewMsgRedelegateLockedDelegation type in the types package
 func TestMsgRedelegateLockedDelegationValidateBasic(t *testing.T) {
@@ -246,44 +354,845 @@ func TestMsgToggleAutoRenewValidateBasic(t *testing.T) {
 	}
 }
 
-// TestMsgUpdateParamsValidateBasic tests the ValidateBasic method of the MsgUpdateParams
-func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+// TestMsgSetExpiryActionValidateBasic tests the ValidateBasic method of the MsgSetExpiryAction
+func TestMsgSetExpiryActionValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("address"))
+	valAddr := sdk.ValAddress([]byte("val"))
+	dstValAddr := sdk.ValAddress([]byte("val2"))
+
 	tests := []struct {
-		name        string
-		msg         types.MsgUpdateParams
-		errContains string
+		name string
+		msg  types.MsgSetExpiryAction
+		pass bool
 	}{
 		{
 			name: "pass",
-			msg: types.MsgUpdateParams{
-				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-				Params:    types.DefaultParams(),
+			msg: *types.NewMsgSetExpiryAction(
+				addr,
+				valAddr,
+				0,
+				types.ExpiryActionStayDelegated,
+				"",
+			),
+			pass: true,
+		},
+		{
+			name: "pass - redelegate",
+			msg: *types.NewMsgSetExpiryAction(
+				addr,
+				valAddr,
+				0,
+				types.ExpiryActionRedelegate,
+				dstValAddr.String(),
+			),
+			pass: true,
+		},
+		{
+			name: "fail - bad DelegatorAddress",
+			msg: types.MsgSetExpiryAction{
+				DelegatorAddress: "",
+				ValidatorAddress: valAddr.String(),
 			},
+			pass: false,
 		},
 		{
-			name: "fail - bad Authority",
-			msg: types.MsgUpdateParams{
-				Authority: "",
-				Params:    types.DefaultParams(),
+			name: "fail - bad ValidatorAddress",
+			msg: types.MsgSetExpiryAction{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: "",
 			},
-			errContains: "locking invalid authority address",
+			pass: false,
 		},
 		{
-			name: "fail - bad param",
-			msg: types.MsgUpdateParams{
-				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-				Params:    types.Params{},
+			name: "fail - unknown expiry action",
+			msg: types.MsgSetExpiryAction{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: valAddr.String(),
+				ExpiryAction:     types.ExpiryAction(3),
 			},
-			errContains: "locking max entries is invalid",
+			pass: false,
+		},
+		{
+			name: "fail - redelegate without validator",
+			msg: types.MsgSetExpiryAction{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: valAddr.String(),
+				ExpiryAction:     types.ExpiryActionRedelegate,
+			},
+			pass: false,
+		},
+		{
+			name: "fail - validator without redelegate",
+			msg: types.MsgSetExpiryAction{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: valAddr.String(),
+				ExpiryAction:     types.ExpiryActionUndelegate,
+				RedelegateTo:     dstValAddr.String(),
+			},
+			pass: false,
 		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			err := tc.msg.ValidateBasic()
 
-			if tc.errContains == "" {
+			if tc.pass {
+				require.NoError(t, err)
+
+				// Validate the other params
+				require.Equal(t, types.RouterKey, tc.msg.Route())
+				require.Equal(t, types.TypeMsgSetExpiryAction, tc.msg.Type())
+
+				// Test the Get signers
+				delegator, err := sdk.AccAddressFromBech32(tc.msg.DelegatorAddress)
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{delegator}, tc.msg.GetSigners())
+
+				// Test the GetSignBytes
+				// Since the object never changes, we can remove the lint for gosec
+				//nolint:gosec
+				bz := types.ModuleCdc.MustMarshalJSON(&tc.msg)
+				require.Equal(t, sdk.MustSortJSON(bz), tc.msg.GetSignBytes())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestMsgEarlyUnlockValidateBasic tests the ValidateBasic method of the MsgEarlyUnlock
+func TestMsgEarlyUnlockValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("address"))
+	valAddr := sdk.ValAddress([]byte("val"))
+
+	tests := []struct {
+		name string
+		msg  types.MsgEarlyUnlock
+		pass bool
+	}{
+		{
+			name: "pass",
+			msg: *types.NewMsgEarlyUnlock(
+				addr,
+				valAddr,
+				[]uint64{1, 2},
+			),
+			pass: true,
+		},
+		{
+			name: "fail - bad DelegatorAddress",
+			msg: types.MsgEarlyUnlock{
+				DelegatorAddress: "",
+				ValidatorAddress: valAddr.String(),
+				Ids:              []uint64{1},
+			},
+			pass: false,
+		},
+		{
+			name: "fail - bad ValidatorAddress",
+			msg: types.MsgEarlyUnlock{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: "",
+				Ids:              []uint64{1},
+			},
+			pass: false,
+		},
+		{
+			name: "fail - empty ids",
+			msg: types.MsgEarlyUnlock{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: valAddr.String(),
+			},
+			pass: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.pass {
 				require.NoError(t, err)
+
 				// Validate the other params
+				require.Equal(t, types.RouterKey, tc.msg.Route())
+				require.Equal(t, types.TypeMsgEarlyUnlock, tc.msg.Type())
+
+				// Test the Get signers
+				delegator, err := sdk.AccAddressFromBech32(tc.msg.DelegatorAddress)
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{delegator}, tc.msg.GetSigners())
+
+				// Test the GetSignBytes
+				// Since the object never changes, we can remove the lint for gosec
+				//nolint:gosec
+				bz := types.ModuleCdc.MustMarshalJSON(&tc.msg)
+				require.Equal(t, sdk.MustSortJSON(bz), tc.msg.GetSignBytes())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestMsgSplitLockedDelegationEntryValidateBasic tests the ValidateBasic method of the MsgSplitLockedDelegationEntry
+func TestMsgSplitLockedDelegationEntryValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("address"))
+	valAddr := sdk.ValAddress([]byte("val"))
+
+	tests := []struct {
+		name string
+		msg  types.MsgSplitLockedDelegationEntry
+		pass bool
+	}{
+		{
+			name: "pass",
+			msg: *types.NewMsgSplitLockedDelegationEntry(
+				addr,
+				valAddr,
+				1,
+				sdk.OneDec(),
+			),
+			pass: true,
+		},
+		{
+			name: "fail - bad DelegatorAddress",
+			msg: types.MsgSplitLockedDelegationEntry{
+				DelegatorAddress: "",
+				ValidatorAddress: valAddr.String(),
+				Shares:           sdk.OneDec(),
+			},
+			pass: false,
+		},
+		{
+			name: "fail - bad ValidatorAddress",
+			msg: types.MsgSplitLockedDelegationEntry{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: "",
+				Shares:           sdk.OneDec(),
+			},
+			pass: false,
+		},
+		{
+			name: "fail - zero shares",
+			msg: types.MsgSplitLockedDelegationEntry{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: valAddr.String(),
+				Shares:           sdk.ZeroDec(),
+			},
+			pass: false,
+		},
+		{
+			name: "fail - nil shares",
+			msg: types.MsgSplitLockedDelegationEntry{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: valAddr.String(),
+			},
+			pass: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.pass {
+				require.NoError(t, err)
+
+				// Validate the other params
+				require.Equal(t, types.RouterKey, tc.msg.Route())
+				require.Equal(t, types.TypeMsgSplitLockedDelegationEntry, tc.msg.Type())
+
+				// Test the Get signers
+				delegator, err := sdk.AccAddressFromBech32(tc.msg.DelegatorAddress)
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{delegator}, tc.msg.GetSigners())
+
+				// Test the GetSignBytes
+				// Since the object never changes, we can remove the lint for gosec
+				//nolint:gosec
+				bz := types.ModuleCdc.MustMarshalJSON(&tc.msg)
+				require.Equal(t, sdk.MustSortJSON(bz), tc.msg.GetSignBytes())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestMsgExtendLockValidateBasic tests the ValidateBasic method of the MsgExtendLock
+func TestMsgExtendLockValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("address"))
+	valAddr := sdk.ValAddress([]byte("val"))
+
+	tests := []struct {
+		name string
+		msg  types.MsgExtendLock
+		pass bool
+	}{
+		{
+			name: "pass",
+			msg: *types.NewMsgExtendLock(
+				addr,
+				valAddr,
+				1,
+				time.Hour,
+			),
+			pass: true,
+		},
+		{
+			name: "fail - bad DelegatorAddress",
+			msg: types.MsgExtendLock{
+				DelegatorAddress: "",
+				ValidatorAddress: valAddr.String(),
+				LockDuration:     time.Hour,
+			},
+			pass: false,
+		},
+		{
+			name: "fail - bad ValidatorAddress",
+			msg: types.MsgExtendLock{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: "",
+				LockDuration:     time.Hour,
+			},
+			pass: false,
+		},
+		{
+			name: "fail - bad lock duration",
+			msg: types.MsgExtendLock{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: valAddr.String(),
+				LockDuration:     0,
+			},
+			pass: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.pass {
+				require.NoError(t, err)
+
+				// Validate the other params
+				require.Equal(t, types.RouterKey, tc.msg.Route())
+				require.Equal(t, types.TypeMsgExtendLock, tc.msg.Type())
+
+				// Test the Get signers
+				delegator, err := sdk.AccAddressFromBech32(tc.msg.DelegatorAddress)
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{delegator}, tc.msg.GetSigners())
+
+				// Test the GetSignBytes
+				// Since the object never changes, we can remove the lint for gosec
+				//nolint:gosec
+				bz := types.ModuleCdc.MustMarshalJSON(&tc.msg)
+				require.Equal(t, sdk.MustSortJSON(bz), tc.msg.GetSignBytes())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestMsgFundRewardPoolValidateBasic tests the ValidateBasic method of the MsgFundRewardPool
+func TestMsgFundRewardPoolValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("address"))
+
+	tests := []struct {
+		name string
+		msg  types.MsgFundRewardPool
+		pass bool
+	}{
+		{
+			name: "pass",
+			msg: *types.NewMsgFundRewardPool(
+				addr,
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			),
+			pass: true,
+		},
+		{
+			name: "fail - bad Depositor",
+			msg: types.MsgFundRewardPool{
+				Depositor: "",
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			pass: false,
+		},
+		{
+			name: "fail - empty amount",
+			msg: types.MsgFundRewardPool{
+				Depositor: addr.String(),
+			},
+			pass: false,
+		},
+		{
+			name: "fail - invalid amount",
+			msg: types.MsgFundRewardPool{
+				Depositor: addr.String(),
+				Amount:    sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+			},
+			pass: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.pass {
+				require.NoError(t, err)
+
+				// Validate the other params
+				require.Equal(t, types.RouterKey, tc.msg.Route())
+				require.Equal(t, types.TypeMsgFundRewardPool, tc.msg.Type())
+
+				// Test the Get signers
+				depositor, err := sdk.AccAddressFromBech32(tc.msg.Depositor)
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{depositor}, tc.msg.GetSigners())
+
+				// Test the GetSignBytes
+				// Since the object never changes, we can remove the lint for gosec
+				//nolint:gosec
+				bz := types.ModuleCdc.MustMarshalJSON(&tc.msg)
+				require.Equal(t, sdk.MustSortJSON(bz), tc.msg.GetSignBytes())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestMsgClaimRewardDebtValidateBasic tests the ValidateBasic method of the MsgClaimRewardDebt
+func TestMsgClaimRewardDebtValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("address"))
+	valAddr := sdk.ValAddress([]byte("val"))
+
+	tests := []struct {
+		name string
+		msg  types.MsgClaimRewardDebt
+		pass bool
+	}{
+		{
+			name: "pass",
+			msg: *types.NewMsgClaimRewardDebt(
+				addr,
+				valAddr,
+			),
+			pass: true,
+		},
+		{
+			name: "fail - bad DelegatorAddress",
+			msg: types.MsgClaimRewardDebt{
+				DelegatorAddress: "",
+				ValidatorAddress: valAddr.String(),
+			},
+			pass: false,
+		},
+		{
+			name: "fail - bad ValidatorAddress",
+			msg: types.MsgClaimRewardDebt{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: "",
+			},
+			pass: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.pass {
+				require.NoError(t, err)
+
+				// Validate the other params
+				require.Equal(t, types.RouterKey, tc.msg.Route())
+				require.Equal(t, types.TypeMsgClaimRewardDebt, tc.msg.Type())
+
+				// Test the Get signers
+				delegator, err := sdk.AccAddressFromBech32(tc.msg.DelegatorAddress)
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{delegator}, tc.msg.GetSigners())
+
+				// Test the GetSignBytes
+				// Since the object never changes, we can remove the lint for gosec
+				//nolint:gosec
+				bz := types.ModuleCdc.MustMarshalJSON(&tc.msg)
+				require.Equal(t, sdk.MustSortJSON(bz), tc.msg.GetSignBytes())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestMsgSetBonusBeneficiaryValidateBasic tests the ValidateBasic method of the MsgSetBonusBeneficiary
+func TestMsgSetBonusBeneficiaryValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("address"))
+	beneficiary := sdk.AccAddress([]byte("beneficiary"))
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetBonusBeneficiary
+		pass bool
+	}{
+		{
+			name: "pass",
+			msg: *types.NewMsgSetBonusBeneficiary(
+				addr,
+				beneficiary,
+			),
+			pass: true,
+		},
+		{
+			name: "pass - remove the beneficiary",
+			msg: *types.NewMsgSetBonusBeneficiary(
+				addr,
+				nil,
+			),
+			pass: true,
+		},
+		{
+			name: "fail - bad DelegatorAddress",
+			msg: types.MsgSetBonusBeneficiary{
+				DelegatorAddress:   "",
+				BeneficiaryAddress: beneficiary.String(),
+			},
+			pass: false,
+		},
+		{
+			name: "fail - bad BeneficiaryAddress",
+			msg: types.MsgSetBonusBeneficiary{
+				DelegatorAddress:   addr.String(),
+				BeneficiaryAddress: "test",
+			},
+			pass: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.pass {
+				require.NoError(t, err)
+
+				// Validate the other params
+				require.Equal(t, types.RouterKey, tc.msg.Route())
+				require.Equal(t, types.TypeMsgSetBonusBeneficiary, tc.msg.Type())
+
+				// Test the Get signers
+				delegator, err := sdk.AccAddressFromBech32(tc.msg.DelegatorAddress)
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{delegator}, tc.msg.GetSigners())
+
+				// Test the GetSignBytes
+				// Since the object never changes, we can remove the lint for gosec
+				//nolint:gosec
+				bz := types.ModuleCdc.MustMarshalJSON(&tc.msg)
+				require.Equal(t, sdk.MustSortJSON(bz), tc.msg.GetSignBytes())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestMsgSetAutoCompoundValidateBasic tests the ValidateBasic method of the MsgSetAutoCompound
+func TestMsgSetAutoCompoundValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("address"))
+	valAddr := sdk.ValAddress([]byte("val"))
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetAutoCompound
+		pass bool
+	}{
+		{
+			name: "pass",
+			msg: *types.NewMsgSetAutoCompound(
+				addr,
+				valAddr,
+				true,
+			),
+			pass: true,
+		},
+		{
+			name: "fail - bad DelegatorAddress",
+			msg: types.MsgSetAutoCompound{
+				DelegatorAddress: "",
+				ValidatorAddress: valAddr.String(),
+			},
+			pass: false,
+		},
+		{
+			name: "fail - bad ValidatorAddress",
+			msg: types.MsgSetAutoCompound{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: "",
+			},
+			pass: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.pass {
+				require.NoError(t, err)
+
+				// Validate the other params
+				require.Equal(t, types.RouterKey, tc.msg.Route())
+				require.Equal(t, types.TypeMsgSetAutoCompound, tc.msg.Type())
+
+				// Test the Get signers
+				delegator, err := sdk.AccAddressFromBech32(tc.msg.DelegatorAddress)
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{delegator}, tc.msg.GetSigners())
+
+				// Test the GetSignBytes
+				// Since the object never changes, we can remove the lint for gosec
+				//nolint:gosec
+				bz := types.ModuleCdc.MustMarshalJSON(&tc.msg)
+				require.Equal(t, sdk.MustSortJSON(bz), tc.msg.GetSignBytes())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestMsgWithdrawLockingRewardsValidateBasic tests the ValidateBasic method of the MsgWithdrawLockingRewards
+func TestMsgWithdrawLockingRewardsValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("address"))
+	valAddr := sdk.ValAddress([]byte("val"))
+
+	tests := []struct {
+		name string
+		msg  types.MsgWithdrawLockingRewards
+		pass bool
+	}{
+		{
+			name: "pass",
+			msg: *types.NewMsgWithdrawLockingRewards(
+				addr,
+				valAddr,
+			),
+			pass: true,
+		},
+		{
+			name: "fail - bad DelegatorAddress",
+			msg: types.MsgWithdrawLockingRewards{
+				DelegatorAddress: "",
+				ValidatorAddress: valAddr.String(),
+			},
+			pass: false,
+		},
+		{
+			name: "fail - bad ValidatorAddress",
+			msg: types.MsgWithdrawLockingRewards{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: "",
+			},
+			pass: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.pass {
+				require.NoError(t, err)
+
+				// Validate the other params
+				require.Equal(t, types.RouterKey, tc.msg.Route())
+				require.Equal(t, types.TypeMsgWithdrawLockingRewards, tc.msg.Type())
+
+				// Test the Get signers
+				delegator, err := sdk.AccAddressFromBech32(tc.msg.DelegatorAddress)
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{delegator}, tc.msg.GetSigners())
+
+				// Test the GetSignBytes
+				// Since the object never changes, we can remove the lint for gosec
+				//nolint:gosec
+				bz := types.ModuleCdc.MustMarshalJSON(&tc.msg)
+				require.Equal(t, sdk.MustSortJSON(bz), tc.msg.GetSignBytes())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestMsgUpdateParamsValidateBasic tests the ValidateBasic method of the MsgUpdateParams
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	tests := []struct {
+		name        string
+		msg         types.MsgUpdateParams
+		errContains string
+	}{
+		{
+			name: "pass",
+			msg: types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.DefaultParams(),
+			},
+		},
+		{
+			name: "fail - bad Authority",
+			msg: types.MsgUpdateParams{
+				Authority: "",
+				Params:    types.DefaultParams(),
+			},
+			errContains: "locking invalid authority address",
+		},
+		{
+			name: "fail - bad param",
+			msg: types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.Params{},
+			},
+			errContains: "locking max entries is invalid",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				// Validate the other params
+
+				// Test the Get signers
+				authority, err := sdk.AccAddressFromBech32(tc.msg.Authority)
+				require.NoError(t, err, tc.name)
+				require.Equal(t, []sdk.AccAddress{authority}, tc.msg.GetSigners(), tc.name)
+
+				// Test the GetSignBytes
+				// Since the object never changes, we can remove the lint for gosec
+				//nolint:gosec
+				bz := types.ModuleCdc.MustMarshalJSON(&tc.msg)
+				require.Equal(t, sdk.MustSortJSON(bz), tc.msg.GetSignBytes())
+			} else {
+				require.ErrorContains(t, err, tc.errContains, tc.name)
+			}
+		})
+	}
+}
+
+// TestMsgRetryQuarantinedPairsValidateBasic tests the ValidateBasic method of the MsgRetryQuarantinedPairs
+func TestMsgRetryQuarantinedPairsValidateBasic(t *testing.T) {
+	pair := types.LockedDelegationPair{
+		DelegatorAddress: sdk.AccAddress([]byte("address")).String(),
+		ValidatorAddress: sdk.ValAddress([]byte("val")).String(),
+	}
+
+	tests := []struct {
+		name        string
+		msg         types.MsgRetryQuarantinedPairs
+		errContains string
+	}{
+		{
+			name: "pass",
+			msg: types.MsgRetryQuarantinedPairs{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Pairs:     []types.LockedDelegationPair{pair},
+			},
+		},
+		{
+			name: "fail - bad Authority",
+			msg: types.MsgRetryQuarantinedPairs{
+				Authority: "",
+				Pairs:     []types.LockedDelegationPair{pair},
+			},
+			errContains: "locking invalid authority address",
+		},
+		{
+			name: "fail - empty pairs",
+			msg: types.MsgRetryQuarantinedPairs{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			},
+			errContains: "locking pairs cannot be empty",
+		},
+		{
+			name: "fail - bad pair",
+			msg: types.MsgRetryQuarantinedPairs{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Pairs:     []types.LockedDelegationPair{{DelegatorAddress: pair.DelegatorAddress}},
+			},
+			errContains: "locking invalid validator address",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.errContains == "" {
+				require.NoError(t, err)
+
+				// Test the Get signers
+				authority, err := sdk.AccAddressFromBech32(tc.msg.Authority)
+				require.NoError(t, err, tc.name)
+				require.Equal(t, []sdk.AccAddress{authority}, tc.msg.GetSigners(), tc.name)
+
+				// Test the GetSignBytes
+				// Since the object never changes, we can remove the lint for gosec
+				//nolint:gosec
+				bz := types.ModuleCdc.MustMarshalJSON(&tc.msg)
+				require.Equal(t, sdk.MustSortJSON(bz), tc.msg.GetSignBytes())
+			} else {
+				require.ErrorContains(t, err, tc.errContains, tc.name)
+			}
+		})
+	}
+}
+
+// TestMsgFundFromCommunityPoolValidateBasic tests the ValidateBasic method of the MsgFundFromCommunityPool
+func TestMsgFundFromCommunityPoolValidateBasic(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tests := []struct {
+		name        string
+		msg         types.MsgFundFromCommunityPool
+		errContains string
+	}{
+		{
+			name: "pass",
+			msg: types.MsgFundFromCommunityPool{
+				Authority: authority,
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+		},
+		{
+			name: "fail - bad Authority",
+			msg: types.MsgFundFromCommunityPool{
+				Authority: "",
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			errContains: "locking invalid authority address",
+		},
+		{
+			name: "fail - empty amount",
+			msg: types.MsgFundFromCommunityPool{
+				Authority: authority,
+			},
+			errContains: "locking invalid fund amount",
+		},
+		{
+			name: "fail - invalid amount",
+			msg: types.MsgFundFromCommunityPool{
+				Authority: authority,
+				Amount:    sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+			},
+			errContains: "locking invalid fund amount",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.errContains == "" {
+				require.NoError(t, err)
 
 				// Test the Get signers
 				authority, err := sdk.AccAddressFromBech32(tc.msg.Authority)
@@ -19,40 +19,144 @@ const (
 	ErrRateDurationInvalid = "%s rate duration is invalid: %s"
 	ErrRateDecInvalid      = "%s rate dec is invalid: %s"
 	ErrRateNotUnique       = "%s rate duration of %s not unique for the current rates"
+	ErrRatePenaltyInvalid  = "%s rate early unlock penalty is invalid: %s"
+	ErrRatePayoutInvalid   = "%s rate payout mode is invalid: %s"
+
+	ErrPenaltyDestinationInvalid   = "%s penalty destination is invalid: %s"
+	ErrDoubleSignPolicyInvalid     = "%s double sign policy is invalid: %s"
+	ErrValidatorExitPolicyInvalid  = "%s validator exit policy is invalid: %s"
+	ErrFundingModeInvalid          = "%s funding mode is invalid: %s"
+	ErrHybridMintCapInvalid        = "%s hybrid mint cap is invalid: %s"
+	ErrHybridEpochDurationInvalid  = "%s hybrid epoch duration is invalid: %s"
+	ErrBudgetTypeInvalid           = "%s budget type is invalid: %s"
+	ErrBudgetAmountInvalid         = "%s budget amount is invalid: %s"
+	ErrBudgetSupplyFractionInvalid = "%s budget supply fraction is invalid: %s"
+	ErrBudgetWindowInvalid         = "%s budget window is invalid: %s"
+	ErrBudgetExceededActionInvalid = "%s budget exceeded action is invalid: %s"
+	ErrRewardModeInvalid           = "%s reward mode is invalid: %s"
+	ErrRewardDenomPolicyInvalid    = "%s reward denom policy is invalid: %s"
+	ErrRewardDenomAllowListInvalid = "%s reward denom allow list is invalid: %s"
+	ErrRewardDenomPricesInvalid    = "%s reward denom prices are invalid: %s"
 )
 
 var (
 	// DefaultMaxEntries is the max of locked delegations a val/del can have
 	DefaultMaxEntries uint32 = 100
 
+	// DefaultEarlyUnlockPenalty is the share of the locked delegation forfeited on early unlocks
+	DefaultEarlyUnlockPenalty = sdk.NewDecWithPrec(1, 1)
+
 	// DefaultRates defines the default rates of the reward system
 	DefaultRates = []Rate{
 		// 8 months lock 2.2% reward
-		NewRate(8*30*24*time.Hour, sdk.NewDecWithPrec(22, 1)),
+		NewRateWithPenalty(8*30*24*time.Hour, sdk.NewDecWithPrec(22, 1), DefaultEarlyUnlockPenalty),
 		// 16 months lock 3.3% reward
-		NewRate(16*30*24*time.Hour, sdk.NewDecWithPrec(33, 1)),
+		NewRateWithPenalty(16*30*24*time.Hour, sdk.NewDecWithPrec(33, 1), DefaultEarlyUnlockPenalty),
 		// 24 months lock 4.4% reward
-		NewRate(24*30*24*time.Hour, sdk.NewDecWithPrec(44, 1)),
+		NewRateWithPenalty(24*30*24*time.Hour, sdk.NewDecWithPrec(44, 1), DefaultEarlyUnlockPenalty),
 		// 32 months lock 5.5% reward
-		NewRate(32*30*24*time.Hour, sdk.NewDecWithPrec(55, 1)),
+		NewRateWithPenalty(32*30*24*time.Hour, sdk.NewDecWithPrec(55, 1), DefaultEarlyUnlockPenalty),
 	}
+
+	// DefaultPenaltyDestination burns the early unlock penalties
+	DefaultPenaltyDestination = PenaltyDestinationBurn
+
+	// DefaultDoubleSignPolicy keeps the locks of tombstoned validators untouched
+	DefaultDoubleSignPolicy = DoubleSignPolicyKeep
+
+	// DefaultValidatorExitPolicy keeps the locks of exited validators untouched
+	DefaultValidatorExitPolicy = ValidatorExitPolicyNone
+
+	// DefaultFundingMode mints the locking rewards
+	DefaultFundingMode = FundingModeMint
+
+	// DefaultHybridMintCap is empty, nothing is minted on the hybrid funding mode
+	DefaultHybridMintCap sdk.Coins
+
+	// DefaultHybridEpochDuration is a day long epoch for the hybrid mint cap
+	DefaultHybridEpochDuration = 24 * time.Hour
+
+	// DefaultBudgetType doesn't limit the minted locking rewards
+	DefaultBudgetType = BudgetTypeNone
+
+	// DefaultBudgetAmount is empty, nothing is minted on the absolute budget type
+	DefaultBudgetAmount sdk.Coins
+
+	// DefaultBudgetSupplyFraction is zero, nothing is minted on the supply fraction budget type
+	DefaultBudgetSupplyFraction = sdk.ZeroDec()
+
+	// DefaultBudgetWindow is a day long budget window
+	DefaultBudgetWindow = 24 * time.Hour
+
+	// DefaultBudgetExceededAction keeps the rewards over the budget as debt
+	DefaultBudgetExceededAction = BudgetExceededActionDefer
+
+	// DefaultRewardMode pays the locking rewards on the distribution withdraw hook
+	DefaultRewardMode = RewardModeHook
+
+	// DefaultMaxExpiredPairsPerBlock is the max of expired pairs completed per block
+	DefaultMaxExpiredPairsPerBlock uint32 = 100
+
+	// DefaultRewardDenomPolicy pays the locking rewards on every distribution reward denom
+	DefaultRewardDenomPolicy = RewardDenomPolicyAll
+
+	// DefaultRewardDenomAllowList is empty, nothing is paid on the allow list reward denom policy
+	DefaultRewardDenomAllowList []string
+
+	// DefaultRewardDenomPrices is empty, only the bond denom rewards are paid on the bond denom reward denom policy
+	DefaultRewardDenomPrices []DenomPrice
 )
 
-// NewParams returns a new param
+// BudgetYear is the duration used to turn the yearly budget supply fraction into a window budget
+const BudgetYear = 365 * 24 * time.Hour
+
+// NewParams returns a new param, the remaining fields are set to their defaults
 func NewParams(
 	maxEntries uint32, rates []Rate,
 ) Params {
 	return Params{
-		MaxEntries: maxEntries,
-		Rates:      rates,
+		MaxEntries:              maxEntries,
+		Rates:                   rates,
+		PenaltyDestination:      DefaultPenaltyDestination,
+		DoubleSignPolicy:        DefaultDoubleSignPolicy,
+		ValidatorExitPolicy:     DefaultValidatorExitPolicy,
+		FundingMode:             DefaultFundingMode,
+		HybridMintCap:           DefaultHybridMintCap,
+		HybridEpochDuration:     DefaultHybridEpochDuration,
+		BudgetType:              DefaultBudgetType,
+		BudgetAmount:            DefaultBudgetAmount,
+		BudgetSupplyFraction:    DefaultBudgetSupplyFraction,
+		BudgetWindow:            DefaultBudgetWindow,
+		BudgetExceededAction:    DefaultBudgetExceededAction,
+		RewardMode:              DefaultRewardMode,
+		MaxExpiredPairsPerBlock: DefaultMaxExpiredPairsPerBlock,
+		RewardDenomPolicy:       DefaultRewardDenomPolicy,
+		RewardDenomAllowList:    DefaultRewardDenomAllowList,
+		RewardDenomPrices:       DefaultRewardDenomPrices,
 	}
 }
 
 // DefaultParams returns the default params
 func DefaultParams() Params {
 	return Params{
-		MaxEntries: DefaultMaxEntries,
-		Rates:      DefaultRates,
+		MaxEntries:              DefaultMaxEntries,
+		Rates:                   DefaultRates,
+		PenaltyDestination:      DefaultPenaltyDestination,
+		DoubleSignPolicy:        DefaultDoubleSignPolicy,
+		ValidatorExitPolicy:     DefaultValidatorExitPolicy,
+		FundingMode:             DefaultFundingMode,
+		HybridMintCap:           DefaultHybridMintCap,
+		HybridEpochDuration:     DefaultHybridEpochDuration,
+		BudgetType:              DefaultBudgetType,
+		BudgetAmount:            DefaultBudgetAmount,
+		BudgetSupplyFraction:    DefaultBudgetSupplyFraction,
+		BudgetWindow:            DefaultBudgetWindow,
+		BudgetExceededAction:    DefaultBudgetExceededAction,
+		RewardMode:              DefaultRewardMode,
+		MaxExpiredPairsPerBlock: DefaultMaxExpiredPairsPerBlock,
+		RewardDenomPolicy:       DefaultRewardDenomPolicy,
+		RewardDenomAllowList:    DefaultRewardDenomAllowList,
+		RewardDenomPrices:       DefaultRewardDenomPrices,
 	}
 }
 
@@ -75,9 +179,119 @@ func (p Params) Validate() error {
 		}
 		seenDurations[rate.Duration] = true
 	}
+
+	if _, exists := PenaltyDestination_name[int32(p.PenaltyDestination)]; !exists {
+		return fmt.Errorf(ErrPenaltyDestinationInvalid, ModuleName, p.PenaltyDestination)
+	}
+	if _, exists := DoubleSignPolicy_name[int32(p.DoubleSignPolicy)]; !exists {
+		return fmt.Errorf(ErrDoubleSignPolicyInvalid, ModuleName, p.DoubleSignPolicy)
+	}
+	if _, exists := ValidatorExitPolicy_name[int32(p.ValidatorExitPolicy)]; !exists {
+		return fmt.Errorf(ErrValidatorExitPolicyInvalid, ModuleName, p.ValidatorExitPolicy)
+	}
+	if _, exists := FundingMode_name[int32(p.FundingMode)]; !exists {
+		return fmt.Errorf(ErrFundingModeInvalid, ModuleName, p.FundingMode)
+	}
+	if err := p.HybridMintCap.Validate(); err != nil {
+		return fmt.Errorf(ErrHybridMintCapInvalid, ModuleName, err)
+	}
+	// The epoch is only used on the hybrid funding mode
+	if p.HybridEpochDuration < 0 || (p.FundingMode == FundingModeHybrid && p.HybridEpochDuration == 0) {
+		return fmt.Errorf(ErrHybridEpochDurationInvalid, ModuleName, p.HybridEpochDuration)
+	}
+	if _, exists := BudgetType_name[int32(p.BudgetType)]; !exists {
+		return fmt.Errorf(ErrBudgetTypeInvalid, ModuleName, p.BudgetType)
+	}
+	if err := p.BudgetAmount.Validate(); err != nil {
+		return fmt.Errorf(ErrBudgetAmountInvalid, ModuleName, err)
+	}
+	if err := ValidateFraction(p.GetBudgetSupplyFraction()); err != nil {
+		return fmt.Errorf(ErrBudgetSupplyFractionInvalid, ModuleName, err)
+	}
+	// The window is only used when there's a budget
+	if p.BudgetWindow < 0 || (p.BudgetType != BudgetTypeNone && p.BudgetWindow == 0) {
+		return fmt.Errorf(ErrBudgetWindowInvalid, ModuleName, p.BudgetWindow)
+	}
+	if _, exists := BudgetExceededAction_name[int32(p.BudgetExceededAction)]; !exists {
+		return fmt.Errorf(ErrBudgetExceededActionInvalid, ModuleName, p.BudgetExceededAction)
+	}
+	if _, exists := RewardMode_name[int32(p.RewardMode)]; !exists {
+		return fmt.Errorf(ErrRewardModeInvalid, ModuleName, p.RewardMode)
+	}
+	if _, exists := RewardDenomPolicy_name[int32(p.RewardDenomPolicy)]; !exists {
+		return fmt.Errorf(ErrRewardDenomPolicyInvalid, ModuleName, p.RewardDenomPolicy)
+	}
+	if err := validateRewardDenomAllowList(p.RewardDenomAllowList); err != nil {
+		return fmt.Errorf(ErrRewardDenomAllowListInvalid, ModuleName, err)
+	}
+	if err := validateRewardDenomPrices(p.RewardDenomPrices); err != nil {
+		return fmt.Errorf(ErrRewardDenomPricesInvalid, ModuleName, err)
+	}
+	return nil
+}
+
+// validateRewardDenomAllowList checks the allow list denoms are valid and unique
+func validateRewardDenomAllowList(denoms []string) error {
+	seenDenoms := make(map[string]bool)
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicated denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
 	return nil
 }
 
+// validateRewardDenomPrices checks the priced denoms are valid and unique with a positive price
+func validateRewardDenomPrices(prices []DenomPrice) error {
+	seenDenoms := make(map[string]bool)
+	for _, price := range prices {
+		if err := sdk.ValidateDenom(price.Denom); err != nil {
+			return err
+		}
+		if seenDenoms[price.Denom] {
+			return fmt.Errorf("duplicated denom %s", price.Denom)
+		}
+		seenDenoms[price.Denom] = true
+		if price.Price.IsNil() || !price.Price.IsPositive() {
+			return fmt.Errorf("price of %s must be positive", price.Denom)
+		}
+	}
+	return nil
+}
+
+// GetRewardDenomPrice returns the fixed bond denom price of a denom
+func (p Params) GetRewardDenomPrice(denom string) (price sdk.Dec, found bool) {
+	for _, denomPrice := range p.RewardDenomPrices {
+		if denomPrice.Denom == denom {
+			return denomPrice.Price, true
+		}
+	}
+	return sdk.Dec{}, false
+}
+
+// IsRewardDenomAllowed returns true if the denom is on the reward denom allow list
+func (p Params) IsRewardDenomAllowed(denom string) bool {
+	for _, allowed := range p.RewardDenomAllowList {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// GetBudgetSupplyFraction returns the budget supply fraction
+// params stored before the budget was introduced carry a zero fraction
+func (p Params) GetBudgetSupplyFraction() sdk.Dec {
+	if p.BudgetSupplyFraction.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.BudgetSupplyFraction
+}
+
 // String returns the string representation of Params
 func (p Params) String() string {
 	out, err := yaml.Marshal(p)
@@ -97,13 +311,32 @@ func (p Params) GetRateFromDuration(duration time.Duration) (rate Rate, found bo
 	return Rate{}, false
 }
 
-// NewRate returns a new rate
+// ShortestRateDuration returns the shortest duration between the params rates
+func (p Params) ShortestRateDuration() (duration time.Duration, found bool) {
+	for _, rate := range p.Rates {
+		if !found || rate.Duration < duration {
+			duration = rate.Duration
+			found = true
+		}
+	}
+	return duration, found
+}
+
+// NewRate returns a new rate without early unlock penalty
 func NewRate(
 	duration time.Duration, rate sdk.Dec,
+) Rate {
+	return NewRateWithPenalty(duration, rate, sdk.ZeroDec())
+}
+
+// NewRateWithPenalty returns a new rate with an early unlock penalty
+func NewRateWithPenalty(
+	duration time.Duration, rate sdk.Dec, earlyUnlockPenalty sdk.Dec,
 ) Rate {
 	return Rate{
-		Duration: duration,
-		Rate:     rate,
+		Duration:           duration,
+		Rate:               rate,
+		EarlyUnlockPenalty: earlyUnlockPenalty,
 	}
 }
 
@@ -115,5 +348,20 @@ func (r Rate) Validate() error {
 	if err := ValidateNonZeroDec(r.Rate); err != nil {
 		return fmt.Errorf(ErrRateDecInvalid, ModuleName, err)
 	}
+	if err := ValidateFraction(r.GetEarlyUnlockPenalty()); err != nil {
+		return fmt.Errorf(ErrRatePenaltyInvalid, ModuleName, err)
+	}
+	if _, exists := PayoutMode_name[int32(r.PayoutMode)]; !exists {
+		return fmt.Errorf(ErrRatePayoutInvalid, ModuleName, r.PayoutMode)
+	}
 	return nil
 }
+
+// GetEarlyUnlockPenalty returns the early unlock penalty
+// rates stored before the penalty was introduced carry no penalty
+func (r Rate) GetEarlyUnlockPenalty() sdk.Dec {
+	if r.EarlyUnlockPenalty.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return r.EarlyUnlockPenalty
+}
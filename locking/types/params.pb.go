@@ -6,18 +6,24 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,12 +31,320 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PenaltyDestination defines the possible destinations of early unlock
+// penalties
+type PenaltyDestination int32
+
+const (
+	// PENALTY_DESTINATION_BURN burns the penalty
+	PenaltyDestinationBurn PenaltyDestination = 0
+	// PENALTY_DESTINATION_COMMUNITY_POOL sends the penalty to the community pool
+	PenaltyDestinationCommunityPool PenaltyDestination = 1
+)
+
+var PenaltyDestination_name = map[int32]string{
+	0: "PENALTY_DESTINATION_BURN",
+	1: "PENALTY_DESTINATION_COMMUNITY_POOL",
+}
+
+var PenaltyDestination_value = map[string]int32{
+	"PENALTY_DESTINATION_BURN":           0,
+	"PENALTY_DESTINATION_COMMUNITY_POOL": 1,
+}
+
+func (x PenaltyDestination) String() string {
+	return proto.EnumName(PenaltyDestination_name, int32(x))
+}
+
+func (PenaltyDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f220ba57d416d870, []int{0}
+}
+
+// DoubleSignPolicy defines the possible actions taken on locked delegations
+// when their validator is tombstoned for double signing
+type DoubleSignPolicy int32
+
+const (
+	// DOUBLE_SIGN_POLICY_KEEP keeps the locks untouched
+	DoubleSignPolicyKeep DoubleSignPolicy = 0
+	// DOUBLE_SIGN_POLICY_RELEASE removes the locks, keeping the delegation
+	DoubleSignPolicyRelease DoubleSignPolicy = 1
+	// DOUBLE_SIGN_POLICY_SHORTEN caps the locks to the shortest rate duration
+	// and disables their auto renew
+	DoubleSignPolicyShorten DoubleSignPolicy = 2
+)
+
+var DoubleSignPolicy_name = map[int32]string{
+	0: "DOUBLE_SIGN_POLICY_KEEP",
+	1: "DOUBLE_SIGN_POLICY_RELEASE",
+	2: "DOUBLE_SIGN_POLICY_SHORTEN",
+}
+
+var DoubleSignPolicy_value = map[string]int32{
+	"DOUBLE_SIGN_POLICY_KEEP":    0,
+	"DOUBLE_SIGN_POLICY_RELEASE": 1,
+	"DOUBLE_SIGN_POLICY_SHORTEN": 2,
+}
+
+func (x DoubleSignPolicy) String() string {
+	return proto.EnumName(DoubleSignPolicy_name, int32(x))
+}
+
+func (DoubleSignPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f220ba57d416d870, []int{1}
+}
+
+// ValidatorExitPolicy defines the possible actions taken on locked delegations
+// when their validator is tombstoned or removed
+type ValidatorExitPolicy int32
+
+const (
+	// VALIDATOR_EXIT_POLICY_NONE keeps the locks untouched
+	ValidatorExitPolicyNone ValidatorExitPolicy = 0
+	// VALIDATOR_EXIT_POLICY_FORCE_UNLOCK removes the locks and undelegates the
+	// locked shares
+	ValidatorExitPolicyForceUnlock ValidatorExitPolicy = 1
+	// VALIDATOR_EXIT_POLICY_FREE_REDELEGATE allows the locks to be redelegated
+	// without the max entries limit
+	ValidatorExitPolicyFreeRedelegate ValidatorExitPolicy = 2
+)
+
+var ValidatorExitPolicy_name = map[int32]string{
+	0: "VALIDATOR_EXIT_POLICY_NONE",
+	1: "VALIDATOR_EXIT_POLICY_FORCE_UNLOCK",
+	2: "VALIDATOR_EXIT_POLICY_FREE_REDELEGATE",
+}
+
+var ValidatorExitPolicy_value = map[string]int32{
+	"VALIDATOR_EXIT_POLICY_NONE":            0,
+	"VALIDATOR_EXIT_POLICY_FORCE_UNLOCK":    1,
+	"VALIDATOR_EXIT_POLICY_FREE_REDELEGATE": 2,
+}
+
+func (x ValidatorExitPolicy) String() string {
+	return proto.EnumName(ValidatorExitPolicy_name, int32(x))
+}
+
+func (ValidatorExitPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f220ba57d416d870, []int{2}
+}
+
+// FundingMode defines the possible sources of the locking rewards
+type FundingMode int32
+
+const (
+	// FUNDING_MODE_MINT mints the rewards
+	FundingModeMint FundingMode = 0
+	// FUNDING_MODE_POOL pays the rewards from the reward pool
+	FundingModePool FundingMode = 1
+	// FUNDING_MODE_HYBRID mints the rewards up to the epoch mint cap and pays the
+	// remaining from the reward pool
+	FundingModeHybrid FundingMode = 2
+)
+
+var FundingMode_name = map[int32]string{
+	0: "FUNDING_MODE_MINT",
+	1: "FUNDING_MODE_POOL",
+	2: "FUNDING_MODE_HYBRID",
+}
+
+var FundingMode_value = map[string]int32{
+	"FUNDING_MODE_MINT":   0,
+	"FUNDING_MODE_POOL":   1,
+	"FUNDING_MODE_HYBRID": 2,
+}
+
+func (x FundingMode) String() string {
+	return proto.EnumName(FundingMode_name, int32(x))
+}
+
+func (FundingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f220ba57d416d870, []int{3}
+}
+
+// RewardMode defines how the locking rewards are calculated and withdrawn
+type RewardMode int32
+
+const (
+	// REWARD_MODE_HOOK pays the locking rewards on the distribution withdraw
+	// hook, it requires the hook on the distribution module
+	RewardModeHook RewardMode = 0
+	// REWARD_MODE_STANDALONE calculates the locking rewards with the validator
+	// cumulative reward index and pays them with MsgWithdrawLockingRewards
+	RewardModeStandalone RewardMode = 1
+)
+
+var RewardMode_name = map[int32]string{
+	0: "REWARD_MODE_HOOK",
+	1: "REWARD_MODE_STANDALONE",
+}
+
+var RewardMode_value = map[string]int32{
+	"REWARD_MODE_HOOK":       0,
+	"REWARD_MODE_STANDALONE": 1,
+}
+
+func (x RewardMode) String() string {
+	return proto.EnumName(RewardMode_name, int32(x))
+}
+
+func (RewardMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f220ba57d416d870, []int{4}
+}
+
+// BudgetType defines how the locking rewards budget is calculated
+type BudgetType int32
+
+const (
+	// BUDGET_TYPE_NONE doesn't limit the minted rewards
+	BudgetTypeNone BudgetType = 0
+	// BUDGET_TYPE_ABSOLUTE limits the minted rewards to the budget amount
+	BudgetTypeAbsolute BudgetType = 1
+	// BUDGET_TYPE_SUPPLY_FRACTION limits the minted rewards to a yearly fraction
+	// of the bond denom supply
+	BudgetTypeSupplyFraction BudgetType = 2
+)
+
+var BudgetType_name = map[int32]string{
+	0: "BUDGET_TYPE_NONE",
+	1: "BUDGET_TYPE_ABSOLUTE",
+	2: "BUDGET_TYPE_SUPPLY_FRACTION",
+}
+
+var BudgetType_value = map[string]int32{
+	"BUDGET_TYPE_NONE":            0,
+	"BUDGET_TYPE_ABSOLUTE":        1,
+	"BUDGET_TYPE_SUPPLY_FRACTION": 2,
+}
+
+func (x BudgetType) String() string {
+	return proto.EnumName(BudgetType_name, int32(x))
+}
+
+func (BudgetType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f220ba57d416d870, []int{5}
+}
+
+// BudgetExceededAction defines what happens to the rewards over the budget
+type BudgetExceededAction int32
+
+const (
+	// BUDGET_EXCEEDED_ACTION_DEFER keeps the rewards over the budget as reward
+	// debt
+	BudgetExceededActionDefer BudgetExceededAction = 0
+	// BUDGET_EXCEEDED_ACTION_TRUNCATE only pays the rewards up to the budget,
+	// the rewards over it are dropped
+	BudgetExceededActionTruncate BudgetExceededAction = 1
+)
+
+var BudgetExceededAction_name = map[int32]string{
+	0: "BUDGET_EXCEEDED_ACTION_DEFER",
+	1: "BUDGET_EXCEEDED_ACTION_TRUNCATE",
+}
+
+var BudgetExceededAction_value = map[string]int32{
+	"BUDGET_EXCEEDED_ACTION_DEFER":    0,
+	"BUDGET_EXCEEDED_ACTION_TRUNCATE": 1,
+}
+
+func (x BudgetExceededAction) String() string {
+	return proto.EnumName(BudgetExceededAction_name, int32(x))
+}
+
+func (BudgetExceededAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f220ba57d416d870, []int{6}
+}
+
+// RewardDenomPolicy defines on which denoms the locking rewards are paid
+type RewardDenomPolicy int32
+
+const (
+	// REWARD_DENOM_POLICY_ALL pays the locking rewards on every distribution
+	// reward denom
+	RewardDenomPolicyAll RewardDenomPolicy = 0
+	// REWARD_DENOM_POLICY_ALLOW_LIST only pays the locking rewards on the denoms
+	// of the reward denom allow list
+	RewardDenomPolicyAllowList RewardDenomPolicy = 1
+	// REWARD_DENOM_POLICY_BOND_DENOM pays the locking rewards in the bond denom,
+	// converting the other denoms with the reward denom prices, denoms without a
+	// price are dropped
+	RewardDenomPolicyBondDenom RewardDenomPolicy = 2
+	// REWARD_DENOM_POLICY_NATIVE_ONLY only pays the locking rewards on the bond
+	// denom rewards
+	RewardDenomPolicyNativeOnly RewardDenomPolicy = 3
+)
+
+var RewardDenomPolicy_name = map[int32]string{
+	0: "REWARD_DENOM_POLICY_ALL",
+	1: "REWARD_DENOM_POLICY_ALLOW_LIST",
+	2: "REWARD_DENOM_POLICY_BOND_DENOM",
+	3: "REWARD_DENOM_POLICY_NATIVE_ONLY",
+}
+
+var RewardDenomPolicy_value = map[string]int32{
+	"REWARD_DENOM_POLICY_ALL":         0,
+	"REWARD_DENOM_POLICY_ALLOW_LIST":  1,
+	"REWARD_DENOM_POLICY_BOND_DENOM":  2,
+	"REWARD_DENOM_POLICY_NATIVE_ONLY": 3,
+}
+
+func (x RewardDenomPolicy) String() string {
+	return proto.EnumName(RewardDenomPolicy_name, int32(x))
+}
+
+func (RewardDenomPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f220ba57d416d870, []int{7}
+}
+
 // Params defines the locking module's parameters.
 type Params struct {
 	// max_entries is the max entries for locked delegation (per pair).
 	MaxEntries uint32 `protobuf:"varint,1,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
 	// Rates are the rates of rewards
 	Rates []Rate `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates"`
+	// penalty_destination defines where the early unlock penalties are sent
+	PenaltyDestination PenaltyDestination `protobuf:"varint,3,opt,name=penalty_destination,json=penaltyDestination,proto3,enum=aether.locking.v1beta1.PenaltyDestination" json:"penalty_destination,omitempty"`
+	// double_sign_policy defines what happens to the locks on a validator
+	// tombstoned for double signing
+	DoubleSignPolicy DoubleSignPolicy `protobuf:"varint,4,opt,name=double_sign_policy,json=doubleSignPolicy,proto3,enum=aether.locking.v1beta1.DoubleSignPolicy" json:"double_sign_policy,omitempty"`
+	// validator_exit_policy defines what happens to the locks on a validator
+	// that is tombstoned or removed
+	ValidatorExitPolicy ValidatorExitPolicy `protobuf:"varint,5,opt,name=validator_exit_policy,json=validatorExitPolicy,proto3,enum=aether.locking.v1beta1.ValidatorExitPolicy" json:"validator_exit_policy,omitempty"`
+	// funding_mode defines how the locking rewards are funded
+	FundingMode FundingMode `protobuf:"varint,6,opt,name=funding_mode,json=fundingMode,proto3,enum=aether.locking.v1beta1.FundingMode" json:"funding_mode,omitempty"`
+	// hybrid_mint_cap is the max amount minted per epoch on the hybrid funding
+	// mode, the remaining rewards are paid by the reward pool
+	HybridMintCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=hybrid_mint_cap,json=hybridMintCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"hybrid_mint_cap"`
+	// hybrid_epoch_duration is the duration of an epoch for the hybrid mint cap
+	HybridEpochDuration time.Duration `protobuf:"bytes,8,opt,name=hybrid_epoch_duration,json=hybridEpochDuration,proto3,stdduration" json:"hybrid_epoch_duration"`
+	// budget_type defines how the max amount of locking rewards minted per
+	// budget window is calculated
+	BudgetType BudgetType `protobuf:"varint,9,opt,name=budget_type,json=budgetType,proto3,enum=aether.locking.v1beta1.BudgetType" json:"budget_type,omitempty"`
+	// budget_amount is the max amount minted per budget window on the absolute
+	// budget type
+	BudgetAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=budget_amount,json=budgetAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget_amount"`
+	// budget_supply_fraction is the yearly fraction of the bond denom supply
+	// that can be minted on the supply fraction budget type
+	BudgetSupplyFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=budget_supply_fraction,json=budgetSupplyFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"budget_supply_fraction"`
+	// budget_window is the duration of a budget window
+	BudgetWindow time.Duration `protobuf:"bytes,12,opt,name=budget_window,json=budgetWindow,proto3,stdduration" json:"budget_window"`
+	// budget_exceeded_action defines what happens to the rewards that can't be
+	// minted once the budget is exhausted
+	BudgetExceededAction BudgetExceededAction `protobuf:"varint,13,opt,name=budget_exceeded_action,json=budgetExceededAction,proto3,enum=aether.locking.v1beta1.BudgetExceededAction" json:"budget_exceeded_action,omitempty"`
+	// reward_mode defines how the locking rewards are calculated and withdrawn
+	RewardMode RewardMode `protobuf:"varint,14,opt,name=reward_mode,json=rewardMode,proto3,enum=aether.locking.v1beta1.RewardMode" json:"reward_mode,omitempty"`
+	// max_expired_pairs_per_block is the max number of expired locked delegation
+	// pairs completed per block, the remaining pairs stay on the queue for the
+	// next blocks, zero removes the limit
+	MaxExpiredPairsPerBlock uint32 `protobuf:"varint,15,opt,name=max_expired_pairs_per_block,json=maxExpiredPairsPerBlock,proto3" json:"max_expired_pairs_per_block,omitempty"`
+	// reward_denom_policy defines on which denoms the locking rewards are paid
+	RewardDenomPolicy RewardDenomPolicy `protobuf:"varint,16,opt,name=reward_denom_policy,json=rewardDenomPolicy,proto3,enum=aether.locking.v1beta1.RewardDenomPolicy" json:"reward_denom_policy,omitempty"`
+	// reward_denom_allow_list are the denoms the locking rewards are paid on with
+	// the allow list reward denom policy
+	RewardDenomAllowList []string `protobuf:"bytes,17,rep,name=reward_denom_allow_list,json=rewardDenomAllowList,proto3" json:"reward_denom_allow_list,omitempty"`
+	// reward_denom_prices are the fixed prices used to convert the rewards to the
+	// bond denom with the bond denom reward denom policy
+	RewardDenomPrices []DenomPrice `protobuf:"bytes,18,rep,name=reward_denom_prices,json=rewardDenomPrices,proto3" json:"reward_denom_prices"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -79,8 +393,170 @@ func (m *Params) GetRates() []Rate {
 	return nil
 }
 
+func (m *Params) GetPenaltyDestination() PenaltyDestination {
+	if m != nil {
+		return m.PenaltyDestination
+	}
+	return PenaltyDestinationBurn
+}
+
+func (m *Params) GetDoubleSignPolicy() DoubleSignPolicy {
+	if m != nil {
+		return m.DoubleSignPolicy
+	}
+	return DoubleSignPolicyKeep
+}
+
+func (m *Params) GetValidatorExitPolicy() ValidatorExitPolicy {
+	if m != nil {
+		return m.ValidatorExitPolicy
+	}
+	return ValidatorExitPolicyNone
+}
+
+func (m *Params) GetFundingMode() FundingMode {
+	if m != nil {
+		return m.FundingMode
+	}
+	return FundingModeMint
+}
+
+func (m *Params) GetHybridMintCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.HybridMintCap
+	}
+	return nil
+}
+
+func (m *Params) GetHybridEpochDuration() time.Duration {
+	if m != nil {
+		return m.HybridEpochDuration
+	}
+	return 0
+}
+
+func (m *Params) GetBudgetType() BudgetType {
+	if m != nil {
+		return m.BudgetType
+	}
+	return BudgetTypeNone
+}
+
+func (m *Params) GetBudgetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BudgetAmount
+	}
+	return nil
+}
+
+func (m *Params) GetBudgetWindow() time.Duration {
+	if m != nil {
+		return m.BudgetWindow
+	}
+	return 0
+}
+
+func (m *Params) GetBudgetExceededAction() BudgetExceededAction {
+	if m != nil {
+		return m.BudgetExceededAction
+	}
+	return BudgetExceededActionDefer
+}
+
+func (m *Params) GetRewardMode() RewardMode {
+	if m != nil {
+		return m.RewardMode
+	}
+	return RewardModeHook
+}
+
+func (m *Params) GetMaxExpiredPairsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxExpiredPairsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetRewardDenomPolicy() RewardDenomPolicy {
+	if m != nil {
+		return m.RewardDenomPolicy
+	}
+	return RewardDenomPolicyAll
+}
+
+func (m *Params) GetRewardDenomAllowList() []string {
+	if m != nil {
+		return m.RewardDenomAllowList
+	}
+	return nil
+}
+
+func (m *Params) GetRewardDenomPrices() []DenomPrice {
+	if m != nil {
+		return m.RewardDenomPrices
+	}
+	return nil
+}
+
+// DenomPrice defines a fixed price of a denom in the bond denom
+type DenomPrice struct {
+	// denom is the priced denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the amount of bond denom paid for one unit of the denom
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *DenomPrice) Reset()         { *m = DenomPrice{} }
+func (m *DenomPrice) String() string { return proto.CompactTextString(m) }
+func (*DenomPrice) ProtoMessage()    {}
+func (*DenomPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f220ba57d416d870, []int{1}
+}
+func (m *DenomPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPrice.Merge(m, src)
+}
+func (m *DenomPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPrice proto.InternalMessageInfo
+
+func (m *DenomPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("aether.locking.v1beta1.PenaltyDestination", PenaltyDestination_name, PenaltyDestination_value)
+	proto.RegisterEnum("aether.locking.v1beta1.DoubleSignPolicy", DoubleSignPolicy_name, DoubleSignPolicy_value)
+	proto.RegisterEnum("aether.locking.v1beta1.ValidatorExitPolicy", ValidatorExitPolicy_name, ValidatorExitPolicy_value)
+	proto.RegisterEnum("aether.locking.v1beta1.FundingMode", FundingMode_name, FundingMode_value)
+	proto.RegisterEnum("aether.locking.v1beta1.RewardMode", RewardMode_name, RewardMode_value)
+	proto.RegisterEnum("aether.locking.v1beta1.BudgetType", BudgetType_name, BudgetType_value)
+	proto.RegisterEnum("aether.locking.v1beta1.BudgetExceededAction", BudgetExceededAction_name, BudgetExceededAction_value)
+	proto.RegisterEnum("aether.locking.v1beta1.RewardDenomPolicy", RewardDenomPolicy_name, RewardDenomPolicy_value)
 	proto.RegisterType((*Params)(nil), "aether.locking.v1beta1.Params")
+	proto.RegisterType((*DenomPrice)(nil), "aether.locking.v1beta1.DenomPrice")
 }
 
 func init() {
@@ -88,26 +564,132 @@ func init() {
 }
 
 var fileDescriptor_f220ba57d416d870 = []byte{
-	// 257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0x4c, 0x2d, 0xc9,
-	0x48, 0x2d, 0xd2, 0xcf, 0xc9, 0x4f, 0xce, 0xce, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x83, 0x28, 0xd2, 0x83, 0x2a, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x24, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0x21,
-	0x12, 0x10, 0x0e, 0x54, 0x4a, 0x30, 0x31, 0x37, 0x33, 0x2f, 0x5f, 0x1f, 0x4c, 0x42, 0x85, 0x54,
-	0x70, 0x38, 0x00, 0x66, 0x17, 0x58, 0x95, 0x52, 0x1e, 0x17, 0x5b, 0x00, 0xd8, 0x45, 0x42, 0xf2,
-	0x5c, 0xdc, 0xb9, 0x89, 0x15, 0xf1, 0xa9, 0x79, 0x25, 0x45, 0x99, 0xa9, 0xc5, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0xbc, 0x41, 0x5c, 0xb9, 0x89, 0x15, 0xae, 0x10, 0x11, 0x21, 0x5b, 0x2e, 0xd6, 0xa2,
-	0xc4, 0x92, 0xd4, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x19, 0x3d, 0xec, 0x8e, 0xd7,
-	0x0b, 0x4a, 0x2c, 0x49, 0x75, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c,
-	0x41, 0x10, 0x5d, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0xd9, 0x9c, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x52, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0x3e, 0xc4, 0xe4, 0xd4, 0xb2, 0x5c, 0xb8, 0xeb, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
-	0xc0, 0x8e, 0x36, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x49, 0x9d, 0xec, 0x0b, 0x5d, 0x01, 0x00,
-	0x00,
+	// 1533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x41, 0x6f, 0xe3, 0x5a,
+	0x15, 0x8e, 0xd3, 0x76, 0x78, 0xbd, 0x9d, 0xce, 0xb8, 0xb7, 0x9d, 0x8e, 0xc7, 0x2d, 0x89, 0xe9,
+	0xf0, 0x50, 0x28, 0xbc, 0x84, 0xf7, 0xe0, 0x49, 0xe8, 0x31, 0x4f, 0xc8, 0x8e, 0x6f, 0xdb, 0x50,
+	0xd7, 0x8e, 0x1c, 0xa7, 0x9d, 0x82, 0xd0, 0x95, 0x13, 0xdf, 0xa6, 0xd6, 0x38, 0xbe, 0x96, 0xed,
+	0xb4, 0x8d, 0xc4, 0x0f, 0x40, 0x59, 0xb1, 0x1c, 0x09, 0x05, 0x0d, 0x82, 0x05, 0x42, 0x42, 0x62,
+	0xc1, 0x8f, 0x18, 0x76, 0x23, 0x56, 0x88, 0xc5, 0x0c, 0x9a, 0x59, 0xc0, 0x96, 0x0d, 0x6b, 0xe4,
+	0x6b, 0xa7, 0x49, 0x9b, 0x64, 0x34, 0x0b, 0xde, 0xa6, 0xf5, 0x3d, 0xfe, 0xbe, 0xef, 0x9c, 0x73,
+	0xcf, 0xf1, 0x39, 0x2d, 0x78, 0x6c, 0x93, 0xf8, 0x9c, 0x84, 0x15, 0x8f, 0xb6, 0x9f, 0xb9, 0x7e,
+	0xa7, 0x72, 0xf1, 0x69, 0x8b, 0xc4, 0xf6, 0xa7, 0x95, 0xc0, 0x0e, 0xed, 0x6e, 0x54, 0x0e, 0x42,
+	0x1a, 0x53, 0xb8, 0x99, 0x82, 0xca, 0x19, 0xa8, 0x9c, 0x81, 0xc4, 0x8d, 0x0e, 0xed, 0x50, 0x06,
+	0xa9, 0x24, 0x4f, 0x29, 0x5a, 0x2c, 0x74, 0x28, 0xed, 0x78, 0xa4, 0xc2, 0x4e, 0xad, 0xde, 0x59,
+	0xc5, 0xe9, 0x85, 0x76, 0xec, 0x52, 0x3f, 0x7b, 0xff, 0xa8, 0x4d, 0xa3, 0x2e, 0x8d, 0x70, 0x4a,
+	0x4c, 0x0f, 0x23, 0x6a, 0x7a, 0xaa, 0xb4, 0xec, 0x88, 0x5c, 0x87, 0xd2, 0xa6, 0xee, 0x88, 0xba,
+	0x66, 0x77, 0x5d, 0x9f, 0x56, 0xd8, 0xcf, 0xcc, 0xf4, 0xcd, 0x39, 0x09, 0x8c, 0x62, 0x65, 0xa8,
+	0x9d, 0xff, 0xac, 0x80, 0x3b, 0x75, 0x96, 0x12, 0x2c, 0x82, 0x95, 0xae, 0x7d, 0x85, 0x89, 0x1f,
+	0x87, 0x2e, 0x89, 0x04, 0x4e, 0xe2, 0x4a, 0xab, 0x26, 0xe8, 0xda, 0x57, 0x28, 0xb5, 0xc0, 0x2f,
+	0xc1, 0x52, 0x68, 0xc7, 0x24, 0x12, 0xf2, 0xd2, 0x42, 0x69, 0xe5, 0xb3, 0xed, 0xf2, 0xec, 0xec,
+	0xcb, 0xa6, 0x1d, 0x13, 0x65, 0xf9, 0xe5, 0xeb, 0x62, 0xee, 0x0f, 0xff, 0xfa, 0xf3, 0x2e, 0x67,
+	0xa6, 0x2c, 0xf8, 0x33, 0xb0, 0x1e, 0x10, 0xdf, 0xf6, 0xe2, 0x3e, 0x76, 0x48, 0x14, 0xbb, 0x3e,
+	0xcb, 0x5d, 0x58, 0x90, 0xb8, 0xd2, 0xbd, 0xcf, 0x76, 0xe7, 0x89, 0xd5, 0x53, 0x8a, 0x3a, 0x66,
+	0x98, 0x30, 0x98, 0xb2, 0xc1, 0x63, 0x00, 0x1d, 0xda, 0x6b, 0x79, 0x04, 0x47, 0x6e, 0xc7, 0xc7,
+	0x01, 0xf5, 0xdc, 0x76, 0x5f, 0x58, 0x64, 0xda, 0xa5, 0x79, 0xda, 0x2a, 0x63, 0x34, 0xdc, 0x8e,
+	0x5f, 0x67, 0x78, 0x93, 0x77, 0x6e, 0x59, 0x20, 0x06, 0x0f, 0x2e, 0x6c, 0xcf, 0x75, 0xec, 0x98,
+	0x86, 0x98, 0x5c, 0xb9, 0xf1, 0x48, 0x7a, 0x89, 0x49, 0x7f, 0x67, 0x9e, 0xf4, 0xf1, 0x88, 0x84,
+	0xae, 0xdc, 0x38, 0x53, 0x5f, 0xbf, 0x98, 0x36, 0xc2, 0x3d, 0x70, 0xf7, 0xac, 0xe7, 0x3b, 0xae,
+	0xdf, 0xc1, 0x5d, 0xea, 0x10, 0xe1, 0x0e, 0xd3, 0x7d, 0x3c, 0x4f, 0x77, 0x2f, 0xc5, 0x1e, 0x51,
+	0x87, 0x98, 0x2b, 0x67, 0xe3, 0x03, 0xbc, 0x02, 0xf7, 0xcf, 0xfb, 0xad, 0xd0, 0x75, 0x70, 0xd7,
+	0xf5, 0x63, 0xdc, 0xb6, 0x03, 0xe1, 0x6b, 0xac, 0x4c, 0x8f, 0xca, 0x59, 0x27, 0x25, 0xbd, 0x73,
+	0xad, 0x53, 0xa5, 0xae, 0xaf, 0x7c, 0x9e, 0xd4, 0xe8, 0x8f, 0x6f, 0x8a, 0xa5, 0x8e, 0x1b, 0x9f,
+	0xf7, 0x5a, 0xe5, 0x36, 0xed, 0x66, 0x6d, 0x97, 0xfd, 0xfa, 0x24, 0x72, 0x9e, 0x55, 0xe2, 0x7e,
+	0x40, 0x22, 0x46, 0x88, 0xd2, 0x7a, 0xae, 0xa6, 0x8e, 0x8e, 0x5c, 0x3f, 0xae, 0xda, 0x01, 0x3c,
+	0x01, 0x0f, 0x32, 0xcf, 0x24, 0xa0, 0xed, 0x73, 0x3c, 0xea, 0x6a, 0xe1, 0x23, 0x89, 0x63, 0xfe,
+	0xd3, 0xb6, 0x2f, 0x8f, 0xda, 0xbe, 0xac, 0x66, 0x00, 0xe5, 0xa3, 0xc4, 0xff, 0xf3, 0x37, 0x45,
+	0xce, 0x5c, 0x4f, 0x15, 0x50, 0x22, 0x30, 0x7a, 0x0d, 0xab, 0x60, 0xa5, 0xd5, 0x73, 0x3a, 0x24,
+	0xc6, 0x49, 0x08, 0xc2, 0x32, 0xbb, 0x99, 0x9d, 0x79, 0x37, 0xa3, 0x30, 0xa8, 0xd5, 0x0f, 0x88,
+	0x09, 0x5a, 0xd7, 0xcf, 0xb0, 0x07, 0x56, 0x33, 0x11, 0xbb, 0x4b, 0x7b, 0x7e, 0x2c, 0x80, 0xaf,
+	0xe8, 0x56, 0xee, 0xa6, 0x6e, 0x64, 0xe6, 0x05, 0x86, 0x60, 0x33, 0x73, 0x1b, 0xf5, 0x82, 0xc0,
+	0xeb, 0xe3, 0xb3, 0xd0, 0x6e, 0xb3, 0x5b, 0x59, 0x91, 0xb8, 0xd2, 0xb2, 0xf2, 0x24, 0x71, 0xf2,
+	0x8f, 0xd7, 0xc5, 0x6f, 0x7d, 0x80, 0x13, 0x95, 0xb4, 0xff, 0xf6, 0x97, 0x4f, 0x40, 0x16, 0xb0,
+	0x4a, 0xda, 0xe6, 0x46, 0xaa, 0xdd, 0x60, 0xd2, 0x7b, 0x99, 0x32, 0x3c, 0xb8, 0x4e, 0xf5, 0xd2,
+	0xf5, 0x1d, 0x7a, 0x29, 0xdc, 0xfd, 0xf0, 0x02, 0x64, 0xd1, 0x9f, 0x30, 0x22, 0x6c, 0x5d, 0x47,
+	0x4f, 0xae, 0xda, 0x84, 0x38, 0xc4, 0xc1, 0x59, 0xf4, 0xab, 0xac, 0x08, 0xdf, 0x7d, 0x7f, 0x11,
+	0x50, 0x46, 0x92, 0x19, 0x67, 0x14, 0xed, 0x4d, 0x6b, 0x52, 0xdd, 0x90, 0x5c, 0xda, 0xa1, 0x93,
+	0xf6, 0xfd, 0xbd, 0xf7, 0x57, 0xd7, 0x64, 0x50, 0xd6, 0xf6, 0x20, 0xbc, 0x7e, 0x86, 0x4f, 0xc0,
+	0x16, 0x9b, 0x59, 0x57, 0x81, 0x1b, 0x12, 0x07, 0x07, 0xb6, 0x1b, 0x46, 0x38, 0x20, 0x21, 0x6e,
+	0x25, 0x02, 0xc2, 0x7d, 0x36, 0xc3, 0x1e, 0x26, 0x33, 0x2c, 0x45, 0xd4, 0x13, 0x40, 0x9d, 0x84,
+	0x4a, 0xf2, 0x1a, 0x9e, 0x82, 0xf5, 0x2c, 0x04, 0x87, 0xf8, 0xb4, 0x3b, 0xfa, 0xb4, 0x79, 0x16,
+	0xca, 0xb7, 0xdf, 0x1f, 0x8a, 0x9a, 0x30, 0xb2, 0x0f, 0x7b, 0x2d, 0xbc, 0x6d, 0x82, 0x9f, 0x83,
+	0x87, 0x37, 0xa4, 0x6d, 0xcf, 0xa3, 0x97, 0xd8, 0x73, 0xa3, 0x58, 0x58, 0x93, 0x16, 0x4a, 0xcb,
+	0xe6, 0xc6, 0x04, 0x47, 0x4e, 0x5e, 0x6a, 0x6e, 0x14, 0xc3, 0x9f, 0xdf, 0x8e, 0x28, 0x74, 0xdb,
+	0x24, 0x12, 0x20, 0xeb, 0xd9, 0xb9, 0x97, 0x93, 0x3a, 0x4e, 0xa0, 0x93, 0x63, 0xf7, 0x46, 0x54,
+	0x4c, 0xe7, 0x8b, 0xc5, 0xe7, 0x2f, 0x8a, 0xb9, 0x9d, 0x5f, 0x00, 0x30, 0x36, 0xc2, 0x0d, 0xb0,
+	0xc4, 0x7c, 0xb1, 0x81, 0xbf, 0x6c, 0xa6, 0x07, 0x68, 0x82, 0x25, 0xe6, 0x5b, 0xc8, 0xff, 0x1f,
+	0xda, 0x35, 0x95, 0xfa, 0x62, 0xf1, 0xdf, 0x2f, 0x8a, 0xdc, 0xee, 0x6f, 0x39, 0x00, 0xa7, 0x87,
+	0x3a, 0xfc, 0x21, 0x10, 0xea, 0x48, 0x97, 0x35, 0xeb, 0x14, 0xab, 0xa8, 0x61, 0xd5, 0x74, 0xd9,
+	0xaa, 0x19, 0x3a, 0x56, 0x9a, 0xa6, 0xce, 0xe7, 0x44, 0x71, 0x30, 0x94, 0x36, 0xa7, 0x59, 0x4a,
+	0x2f, 0xf4, 0xe1, 0x21, 0xd8, 0x99, 0xc5, 0xac, 0x1a, 0x47, 0x47, 0x4d, 0xbd, 0x66, 0x9d, 0xe2,
+	0xba, 0x61, 0x68, 0x3c, 0x27, 0x3e, 0x1e, 0x0c, 0xa5, 0xe2, 0xb4, 0x46, 0x95, 0x76, 0xbb, 0x3d,
+	0xdf, 0x8d, 0xfb, 0x75, 0x4a, 0x3d, 0x71, 0xf1, 0x97, 0xbf, 0x2b, 0xe4, 0x76, 0xff, 0xca, 0x01,
+	0xfe, 0xf6, 0x72, 0x48, 0x4a, 0xaa, 0x1a, 0x4d, 0x45, 0x43, 0xb8, 0x51, 0xdb, 0xd7, 0x71, 0xdd,
+	0xd0, 0x6a, 0xd5, 0x53, 0x7c, 0x88, 0x50, 0x9d, 0xcf, 0x89, 0xc2, 0x60, 0x28, 0x6d, 0xdc, 0xa6,
+	0x1c, 0x12, 0x12, 0xc0, 0x1f, 0x01, 0x71, 0x06, 0xcd, 0x44, 0x1a, 0x92, 0x1b, 0x88, 0xe7, 0xc4,
+	0xad, 0xc1, 0x50, 0x7a, 0x38, 0xb5, 0x89, 0x88, 0x47, 0xec, 0x88, 0xcc, 0x21, 0x37, 0x0e, 0x0c,
+	0xd3, 0x42, 0x3a, 0x9f, 0x9f, 0x4d, 0x6e, 0x9c, 0xd3, 0x30, 0x26, 0x7e, 0x96, 0xcb, 0x7f, 0x39,
+	0xb0, 0x3e, 0x63, 0x1b, 0x25, 0xd2, 0xc7, 0xb2, 0x56, 0x53, 0x65, 0xcb, 0x30, 0x31, 0x7a, 0x5a,
+	0xb3, 0x46, 0xea, 0xba, 0xa1, 0x23, 0x3e, 0x97, 0x4a, 0xcf, 0x20, 0xea, 0xd4, 0x27, 0xf0, 0x27,
+	0x60, 0x67, 0x36, 0x79, 0xcf, 0x30, 0xab, 0x08, 0x37, 0x75, 0xcd, 0xa8, 0x1e, 0xf2, 0x9c, 0xb8,
+	0x33, 0x18, 0x4a, 0x85, 0x19, 0x22, 0x7b, 0x34, 0x6c, 0x93, 0xa6, 0xcf, 0xbe, 0xc2, 0x3a, 0xf8,
+	0x78, 0x8e, 0x96, 0x89, 0x10, 0x36, 0x91, 0x8a, 0x34, 0xb4, 0x2f, 0x5b, 0x88, 0xcf, 0x8b, 0x1f,
+	0x0f, 0x86, 0xd2, 0x37, 0x66, 0xc9, 0x85, 0x84, 0x98, 0xc4, 0x21, 0x1e, 0xe9, 0xd8, 0x31, 0xc9,
+	0x12, 0xff, 0x35, 0x07, 0x56, 0x26, 0xd6, 0x25, 0xdc, 0x05, 0x6b, 0x7b, 0x4d, 0x5d, 0xad, 0xe9,
+	0xfb, 0xf8, 0xc8, 0x50, 0x11, 0x3e, 0xaa, 0xe9, 0x16, 0x9f, 0x13, 0xd7, 0x07, 0x43, 0xe9, 0xfe,
+	0x04, 0x2e, 0x59, 0x6b, 0x53, 0xd8, 0xac, 0x85, 0x6e, 0x63, 0x93, 0x96, 0x81, 0x65, 0xb0, 0x7e,
+	0x03, 0x7b, 0x70, 0xaa, 0x98, 0x35, 0x95, 0xcf, 0x8b, 0x0f, 0x06, 0x43, 0x69, 0x6d, 0x02, 0x7d,
+	0xc0, 0x76, 0x5c, 0x16, 0x5d, 0x08, 0xc0, 0x78, 0xa6, 0xc1, 0x12, 0xe0, 0x4d, 0x74, 0x22, 0x9b,
+	0x6a, 0x26, 0x61, 0x18, 0x87, 0x7c, 0x4e, 0x84, 0x83, 0xa1, 0x74, 0x6f, 0x8c, 0x3a, 0xa0, 0xf4,
+	0x19, 0xfc, 0x01, 0xd8, 0x9c, 0x44, 0x36, 0x2c, 0x59, 0x57, 0x65, 0x2d, 0x29, 0x19, 0x97, 0x36,
+	0xe1, 0x18, 0xdf, 0x88, 0x6d, 0xdf, 0xb1, 0x3d, 0xea, 0x8f, 0x6e, 0xe4, 0x4f, 0x1c, 0x00, 0xe3,
+	0x35, 0x99, 0x38, 0x55, 0x9a, 0xea, 0x3e, 0xb2, 0xb0, 0x75, 0x5a, 0x47, 0xa3, 0xba, 0x33, 0xa7,
+	0x63, 0x14, 0x2b, 0xf7, 0xf7, 0xc0, 0xc6, 0x24, 0x52, 0x56, 0x1a, 0x86, 0xd6, 0xb4, 0x12, 0x97,
+	0x9b, 0x83, 0xa1, 0x04, 0xc7, 0x68, 0xb9, 0x15, 0x51, 0xaf, 0x17, 0x13, 0xf8, 0x25, 0xd8, 0x9a,
+	0x64, 0x34, 0x9a, 0xf5, 0xba, 0x96, 0x54, 0x54, 0xae, 0x26, 0x1f, 0x27, 0x9f, 0x17, 0xb7, 0x07,
+	0x43, 0x49, 0x18, 0x13, 0x6f, 0xae, 0xb2, 0x2c, 0xde, 0xdf, 0x73, 0x60, 0x63, 0xd6, 0x46, 0x81,
+	0x3f, 0x06, 0xdb, 0x99, 0x3a, 0x7a, 0x5a, 0x45, 0x48, 0x45, 0x2a, 0x4e, 0x85, 0xb1, 0x8a, 0xf6,
+	0x90, 0xc9, 0xe7, 0xc4, 0xaf, 0x0f, 0x86, 0xd2, 0xa3, 0x59, 0x5c, 0x95, 0x9c, 0x91, 0x10, 0x22,
+	0x50, 0x9c, 0x23, 0x60, 0x99, 0x4d, 0xbd, 0x2a, 0xb3, 0xdc, 0xa4, 0xc1, 0x50, 0xda, 0x9e, 0xa5,
+	0x61, 0x85, 0x3d, 0xbf, 0x3d, 0x6e, 0xb4, 0xdf, 0xe4, 0xc1, 0x9a, 0x39, 0x6b, 0x03, 0x64, 0x85,
+	0x52, 0x91, 0x6e, 0x1c, 0x8d, 0x9a, 0x5a, 0xd6, 0xb4, 0xd1, 0xb8, 0x98, 0xe2, 0xc8, 0x9e, 0x07,
+	0x15, 0x50, 0x98, 0x43, 0x33, 0x4e, 0xb0, 0x56, 0x6b, 0x58, 0x3c, 0x27, 0x16, 0x06, 0x43, 0x49,
+	0x9c, 0xc5, 0xce, 0xb6, 0xc8, 0x1c, 0x0d, 0xc5, 0xd0, 0x33, 0x0b, 0x9f, 0x9f, 0xa3, 0xa1, 0x50,
+	0x3f, 0x3d, 0x42, 0x15, 0x14, 0x67, 0x69, 0x24, 0xc3, 0xf5, 0x18, 0x61, 0x43, 0xd7, 0x4e, 0xf9,
+	0x05, 0xb1, 0x38, 0x18, 0x4a, 0x5b, 0x53, 0x22, 0xba, 0x1d, 0xbb, 0x17, 0xc4, 0xf0, 0xbd, 0x7e,
+	0x7a, 0x41, 0xca, 0x93, 0x97, 0x6f, 0x0b, 0xdc, 0xab, 0xb7, 0x05, 0xee, 0x9f, 0x6f, 0x0b, 0xdc,
+	0xaf, 0xde, 0x15, 0x72, 0xaf, 0xde, 0x15, 0x72, 0x7f, 0x7f, 0x57, 0xc8, 0xfd, 0x74, 0x67, 0x62,
+	0x9f, 0xa4, 0xcb, 0x8d, 0x5c, 0x74, 0xaf, 0xff, 0x65, 0x61, 0xfb, 0xa4, 0x75, 0x87, 0xfd, 0xdd,
+	0xf2, 0xfd, 0xff, 0x0d, 0x00, 0x52, 0xfb, 0xd0, 0x92, 0x92, 0x0d, 0x00, 0x00,
 }
 
+func (this *DenomPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomPrice)
+	if !ok {
+		that2, ok := that.(DenomPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -128,6 +710,134 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardDenomPrices) > 0 {
+		for iNdEx := len(m.RewardDenomPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardDenomPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.RewardDenomAllowList) > 0 {
+		for iNdEx := len(m.RewardDenomAllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RewardDenomAllowList[iNdEx])
+			copy(dAtA[i:], m.RewardDenomAllowList[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.RewardDenomAllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.RewardDenomPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardDenomPolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxExpiredPairsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExpiredPairsPerBlock))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.RewardMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardMode))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BudgetExceededAction != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BudgetExceededAction))
+		i--
+		dAtA[i] = 0x68
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BudgetWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BudgetWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.BudgetSupplyFraction.Size()
+		i -= size
+		if _, err := m.BudgetSupplyFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.BudgetAmount) > 0 {
+		for iNdEx := len(m.BudgetAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BudgetAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.BudgetType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BudgetType))
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.HybridEpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HybridEpochDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	if len(m.HybridMintCap) > 0 {
+		for iNdEx := len(m.HybridMintCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HybridMintCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.FundingMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FundingMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValidatorExitPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ValidatorExitPolicy))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DoubleSignPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DoubleSignPolicy))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PenaltyDestination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PenaltyDestination))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Rates) > 0 {
 		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -150,15 +860,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+func (m *DenomPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *Params) Size() (n int) {
@@ -176,6 +926,78 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.PenaltyDestination != 0 {
+		n += 1 + sovParams(uint64(m.PenaltyDestination))
+	}
+	if m.DoubleSignPolicy != 0 {
+		n += 1 + sovParams(uint64(m.DoubleSignPolicy))
+	}
+	if m.ValidatorExitPolicy != 0 {
+		n += 1 + sovParams(uint64(m.ValidatorExitPolicy))
+	}
+	if m.FundingMode != 0 {
+		n += 1 + sovParams(uint64(m.FundingMode))
+	}
+	if len(m.HybridMintCap) > 0 {
+		for _, e := range m.HybridMintCap {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HybridEpochDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.BudgetType != 0 {
+		n += 1 + sovParams(uint64(m.BudgetType))
+	}
+	if len(m.BudgetAmount) > 0 {
+		for _, e := range m.BudgetAmount {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.BudgetSupplyFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BudgetWindow)
+	n += 1 + l + sovParams(uint64(l))
+	if m.BudgetExceededAction != 0 {
+		n += 1 + sovParams(uint64(m.BudgetExceededAction))
+	}
+	if m.RewardMode != 0 {
+		n += 1 + sovParams(uint64(m.RewardMode))
+	}
+	if m.MaxExpiredPairsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxExpiredPairsPerBlock))
+	}
+	if m.RewardDenomPolicy != 0 {
+		n += 2 + sovParams(uint64(m.RewardDenomPolicy))
+	}
+	if len(m.RewardDenomAllowList) > 0 {
+		for _, s := range m.RewardDenomAllowList {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RewardDenomPrices) > 0 {
+		for _, e := range m.RewardDenomPrices {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -267,6 +1089,527 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyDestination", wireType)
+			}
+			m.PenaltyDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PenaltyDestination |= PenaltyDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSignPolicy", wireType)
+			}
+			m.DoubleSignPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DoubleSignPolicy |= DoubleSignPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorExitPolicy", wireType)
+			}
+			m.ValidatorExitPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorExitPolicy |= ValidatorExitPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingMode", wireType)
+			}
+			m.FundingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingMode |= FundingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HybridMintCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HybridMintCap = append(m.HybridMintCap, types.Coin{})
+			if err := m.HybridMintCap[len(m.HybridMintCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HybridEpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.HybridEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetType", wireType)
+			}
+			m.BudgetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BudgetType |= BudgetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BudgetAmount = append(m.BudgetAmount, types.Coin{})
+			if err := m.BudgetAmount[len(m.BudgetAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetSupplyFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BudgetSupplyFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BudgetWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetExceededAction", wireType)
+			}
+			m.BudgetExceededAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BudgetExceededAction |= BudgetExceededAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardMode", wireType)
+			}
+			m.RewardMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardMode |= RewardMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpiredPairsPerBlock", wireType)
+			}
+			m.MaxExpiredPairsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpiredPairsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomPolicy", wireType)
+			}
+			m.RewardDenomPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDenomPolicy |= RewardDenomPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomAllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenomAllowList = append(m.RewardDenomAllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenomPrices = append(m.RewardDenomPrices, DenomPrice{})
+			if err := m.RewardDenomPrices[len(m.RewardDenomPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -62,6 +62,247 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			},
 			true,
 		},
+		{
+			"pass - rate with no early unlock penalty",
+			func() types.Params {
+				return types.NewParams(types.DefaultMaxEntries, []types.Rate{
+					{Duration: 10, Rate: sdk.OneDec()},
+				})
+			},
+			false,
+		},
+		{
+			"fail - negative early unlock penalty",
+			func() types.Params {
+				return types.NewParams(types.DefaultMaxEntries, []types.Rate{
+					types.NewRateWithPenalty(10, sdk.OneDec(), sdk.NewDec(-1)),
+				})
+			},
+			true,
+		},
+		{
+			"fail - early unlock penalty of one",
+			func() types.Params {
+				return types.NewParams(types.DefaultMaxEntries, []types.Rate{
+					types.NewRateWithPenalty(10, sdk.OneDec(), sdk.OneDec()),
+				})
+			},
+			true,
+		},
+		{
+			"pass - rate with at maturity payout",
+			func() types.Params {
+				rate := types.NewRate(10, sdk.OneDec())
+				rate.PayoutMode = types.PayoutModeAtMaturity
+				return types.NewParams(types.DefaultMaxEntries, []types.Rate{rate})
+			},
+			false,
+		},
+		{
+			"fail - invalid rate payout mode",
+			func() types.Params {
+				rate := types.NewRate(10, sdk.OneDec())
+				rate.PayoutMode = 100
+				return types.NewParams(types.DefaultMaxEntries, []types.Rate{rate})
+			},
+			true,
+		},
+		{
+			"fail - invalid penalty destination",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.PenaltyDestination = 100
+				return params
+			},
+			true,
+		},
+		{
+			"fail - invalid double sign policy",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.DoubleSignPolicy = 100
+				return params
+			},
+			true,
+		},
+		{
+			"fail - invalid validator exit policy",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.ValidatorExitPolicy = 100
+				return params
+			},
+			true,
+		},
+		{
+			"fail - invalid funding mode",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.FundingMode = 100
+				return params
+			},
+			true,
+		},
+		{
+			"fail - invalid hybrid mint cap",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.HybridMintCap = sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}
+				return params
+			},
+			true,
+		},
+		{
+			"fail - hybrid funding mode without epoch duration",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.FundingMode = types.FundingModeHybrid
+				params.HybridEpochDuration = 0
+				return params
+			},
+			true,
+		},
+		{
+			"pass - mint funding mode without epoch duration",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.HybridEpochDuration = 0
+				return params
+			},
+			false,
+		},
+		{
+			"fail - invalid budget type",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.BudgetType = 100
+				return params
+			},
+			true,
+		},
+		{
+			"fail - invalid budget amount",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.BudgetAmount = sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}
+				return params
+			},
+			true,
+		},
+		{
+			"fail - budget supply fraction out of range",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.BudgetSupplyFraction = sdk.OneDec()
+				return params
+			},
+			true,
+		},
+		{
+			"fail - budget without window",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.BudgetType = types.BudgetTypeAbsolute
+				params.BudgetWindow = 0
+				return params
+			},
+			true,
+		},
+		{
+			"fail - invalid budget exceeded action",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.BudgetExceededAction = 100
+				return params
+			},
+			true,
+		},
+		{
+			"fail - invalid reward mode",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RewardMode = 100
+				return params
+			},
+			true,
+		},
+		{
+			"fail - invalid reward denom policy",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RewardDenomPolicy = 100
+				return params
+			},
+			true,
+		},
+		{
+			"pass - reward denom allow list and prices",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RewardDenomPolicy = types.RewardDenomPolicyBondDenom
+				params.RewardDenomAllowList = []string{"aether", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}
+				params.RewardDenomPrices = []types.DenomPrice{{Denom: "uatom", Price: sdk.NewDecWithPrec(5, 1)}}
+				return params
+			},
+			false,
+		},
+		{
+			"fail - invalid reward denom allow list denom",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RewardDenomAllowList = []string{"1"}
+				return params
+			},
+			true,
+		},
+		{
+			"fail - duplicated reward denom allow list denom",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RewardDenomAllowList = []string{"aether", "aether"}
+				return params
+			},
+			true,
+		},
+		{
+			"fail - duplicated reward denom price",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RewardDenomPrices = []types.DenomPrice{
+					{Denom: "uatom", Price: sdk.OneDec()},
+					{Denom: "uatom", Price: sdk.OneDec()},
+				}
+				return params
+			},
+			true,
+		},
+		{
+			"fail - zero reward denom price",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RewardDenomPrices = []types.DenomPrice{{Denom: "uatom", Price: sdk.ZeroDec()}}
+				return params
+			},
+			true,
+		},
+		{
+			"fail - nil reward denom price",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RewardDenomPrices = []types.DenomPrice{{Denom: "uatom"}}
+				return params
+			},
+			true,
+		},
+		{
+			"pass - nil budget supply fraction",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.BudgetSupplyFraction = sdk.Dec{}
+				return params
+			},
+			false,
+		},
 		{
 			"fail - invalid rewards denom",
 			func() types.Params {
@@ -86,7 +327,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 // TestParamsString tests the return string from the param
 func TestParamsString(t *testing.T) {
 	p := types.NewParams(types.DefaultMaxEntries+1, nil)
-	expected := fmt.Sprintf("maxentries: %d\nrates: []\n", types.DefaultMaxEntries+1)
+	expected := fmt.Sprintf("maxentries: %d\nrates: []\npenaltydestination: 0\ndoublesignpolicy: 0\nvalidatorexitpolicy: 0\nfundingmode: 0\nhybridmintcap: []\nhybridepochduration: 24h0m0s\nbudgettype: 0\nbudgetamount: []\nbudgetsupplyfraction: \"0.000000000000000000\"\nbudgetwindow: 24h0m0s\nbudgetexceededaction: 0\nrewardmode: 0\nmaxexpiredpairsperblock: 100\nrewarddenompolicy: 0\nrewarddenomallowlist: []\nrewarddenomprices: []\n", types.DefaultMaxEntries+1)
 	got := p.String()
 	require.Equal(t, expected, got)
 }
@@ -14,19 +14,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -117,7 +122,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-// QueryLockedDelegationRequest is request type for the Query/Delegation RPC method
+// QueryLockedDelegationRequest is request type for the Query/Delegation RPC
+// method
 type QueryLockedDelegationRequest struct {
 	// delegator_addr defines the delegator address to query for
 	DelegatorAddr string `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
@@ -160,7 +166,8 @@ func (m *QueryLockedDelegationRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryLockedDelegationRequest proto.InternalMessageInfo
 
-// QueryLockedDelegationResponse is response type for the Query/Delegation RPC method
+// QueryLockedDelegationResponse is response type for the Query/Delegation RPC
+// method
 type QueryLockedDelegationResponse struct {
 	// locked_delegation_responses defines the locked delegation info
 	LockedDelegations []LockedDelegationWithTotalShares `protobuf:"bytes,1,rep,name=locked_delegations,json=lockedDelegations,proto3" json:"locked_delegations"`
@@ -217,7 +224,8 @@ func (m *QueryLockedDelegationResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryDelegatorLockedDelegationsRequest is request type for the Query/DelegatorDelegations RPC method.
+// QueryDelegatorLockedDelegationsRequest is request type for the
+// Query/DelegatorDelegations RPC method.
 type QueryDelegatorLockedDelegationsRequest struct {
 	// delegator_addr defines the delegator address to query for.
 	DelegatorAddr string `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
@@ -318,6 +326,108 @@ func (m *QueryDelegatorLockedDelegationsResponse) GetPagination() *query.PageRes
 	return nil
 }
 
+// QueryValidatorLockedDelegationsRequest is request type for the
+// Query/ValidatorLockedDelegations RPC method.
+type QueryValidatorLockedDelegationsRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorLockedDelegationsRequest) Reset() {
+	*m = QueryValidatorLockedDelegationsRequest{}
+}
+func (m *QueryValidatorLockedDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorLockedDelegationsRequest) ProtoMessage()    {}
+func (*QueryValidatorLockedDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{6}
+}
+func (m *QueryValidatorLockedDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorLockedDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorLockedDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorLockedDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorLockedDelegationsRequest.Merge(m, src)
+}
+func (m *QueryValidatorLockedDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorLockedDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorLockedDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorLockedDelegationsRequest proto.InternalMessageInfo
+
+// QueryValidatorLockedDelegationsResponse is response type for the
+// Query/ValidatorLockedDelegations RPC method.
+type QueryValidatorLockedDelegationsResponse struct {
+	// locked_delegation_responses defines the locked delegation info
+	LockedDelegations []LockedDelegationWithTotalShares `protobuf:"bytes,1,rep,name=locked_delegations,json=lockedDelegations,proto3" json:"locked_delegations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorLockedDelegationsResponse) Reset() {
+	*m = QueryValidatorLockedDelegationsResponse{}
+}
+func (m *QueryValidatorLockedDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorLockedDelegationsResponse) ProtoMessage()    {}
+func (*QueryValidatorLockedDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{7}
+}
+func (m *QueryValidatorLockedDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorLockedDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorLockedDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorLockedDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorLockedDelegationsResponse.Merge(m, src)
+}
+func (m *QueryValidatorLockedDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorLockedDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorLockedDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorLockedDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorLockedDelegationsResponse) GetLockedDelegations() []LockedDelegationWithTotalShares {
+	if m != nil {
+		return m.LockedDelegations
+	}
+	return nil
+}
+
+func (m *QueryValidatorLockedDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryLockedDelegationRewardsRequest is the request type for the
 // Query/LockedDelegationRewards RPC method
 type QueryLockedDelegationRewardsRequest struct {
@@ -331,7 +441,7 @@ func (m *QueryLockedDelegationRewardsRequest) Reset()         { *m = QueryLocked
 func (m *QueryLockedDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLockedDelegationRewardsRequest) ProtoMessage()    {}
 func (*QueryLockedDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_054ebd2246d2b6c0, []int{6}
+	return fileDescriptor_054ebd2246d2b6c0, []int{8}
 }
 func (m *QueryLockedDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,13 +479,16 @@ type QueryLockedDelegationRewardsResponse struct {
 	LockingReward github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=locking_reward,json=lockingReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"locking_reward"`
 	// total is the sum between the distribution_reward and the locking_reward
 	Total github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total"`
+	// locking_reward_remainder is the decimal remainder carried over from the
+	// previous locking rewards payout, included in the locking_reward
+	LockingRewardRemainder github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=locking_reward_remainder,json=lockingRewardRemainder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"locking_reward_remainder"`
 }
 
 func (m *QueryLockedDelegationRewardsResponse) Reset()         { *m = QueryLockedDelegationRewardsResponse{} }
 func (m *QueryLockedDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLockedDelegationRewardsResponse) ProtoMessage()    {}
 func (*QueryLockedDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_054ebd2246d2b6c0, []int{7}
+	return fileDescriptor_054ebd2246d2b6c0, []int{9}
 }
 func (m *QueryLockedDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,6 +538,13 @@ func (m *QueryLockedDelegationRewardsResponse) GetTotal() github_com_cosmos_cosm
 	return nil
 }
 
+func (m *QueryLockedDelegationRewardsResponse) GetLockingRewardRemainder() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.LockingRewardRemainder
+	}
+	return nil
+}
+
 // QueryLockedDelegationTotalRewardsRequest is the request type for the
 // Query/LockedDelegationTotalRewards RPC method
 type QueryLockedDelegationTotalRewardsRequest struct {
@@ -438,7 +558,7 @@ func (m *QueryLockedDelegationTotalRewardsRequest) Reset() {
 func (m *QueryLockedDelegationTotalRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLockedDelegationTotalRewardsRequest) ProtoMessage()    {}
 func (*QueryLockedDelegationTotalRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_054ebd2246d2b6c0, []int{8}
+	return fileDescriptor_054ebd2246d2b6c0, []int{10}
 }
 func (m *QueryLockedDelegationTotalRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,7 +604,7 @@ func (m *QueryLockedDelegationTotalRewardsResponse) String() string {
 }
 func (*QueryLockedDelegationTotalRewardsResponse) ProtoMessage() {}
 func (*QueryLockedDelegationTotalRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_054ebd2246d2b6c0, []int{9}
+	return fileDescriptor_054ebd2246d2b6c0, []int{11}
 }
 func (m *QueryLockedDelegationTotalRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,956 +647,6812 @@ func (m *QueryLockedDelegationTotalRewardsResponse) GetTotal() github_com_cosmos
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "aether.locking.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "aether.locking.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryLockedDelegationRequest)(nil), "aether.locking.v1beta1.QueryLockedDelegationRequest")
-	proto.RegisterType((*QueryLockedDelegationResponse)(nil), "aether.locking.v1beta1.QueryLockedDelegationResponse")
-	proto.RegisterType((*QueryDelegatorLockedDelegationsRequest)(nil), "aether.locking.v1beta1.QueryDelegatorLockedDelegationsRequest")
-	proto.RegisterType((*QueryDelegatorLockedDelegationsResponse)(nil), "aether.locking.v1beta1.QueryDelegatorLockedDelegationsResponse")
-	proto.RegisterType((*QueryLockedDelegationRewardsRequest)(nil), "aether.locking.v1beta1.QueryLockedDelegationRewardsRequest")
-	proto.RegisterType((*QueryLockedDelegationRewardsResponse)(nil), "aether.locking.v1beta1.QueryLockedDelegationRewardsResponse")
-	proto.RegisterType((*QueryLockedDelegationTotalRewardsRequest)(nil), "aether.locking.v1beta1.QueryLockedDelegationTotalRewardsRequest")
-	proto.RegisterType((*QueryLockedDelegationTotalRewardsResponse)(nil), "aether.locking.v1beta1.QueryLockedDelegationTotalRewardsResponse")
+// QueryLockedDelegationEntrySlashesRequest is the request type for the
+// Query/LockedDelegationEntrySlashes RPC method
+type QueryLockedDelegationEntrySlashesRequest struct {
+	// id is the locked delegation entry id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("aether/locking/v1beta1/query.proto", fileDescriptor_054ebd2246d2b6c0)
+func (m *QueryLockedDelegationEntrySlashesRequest) Reset() {
+	*m = QueryLockedDelegationEntrySlashesRequest{}
 }
-
-var fileDescriptor_054ebd2246d2b6c0 = []byte{
-	// 965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4d, 0x6c, 0x1b, 0x45,
-	0x14, 0xf6, 0xd8, 0xd4, 0xb4, 0x53, 0xb5, 0xaa, 0xa7, 0x11, 0x98, 0xc5, 0xac, 0xab, 0x6d, 0xe5,
-	0x86, 0x54, 0xd9, 0x55, 0x03, 0x08, 0x44, 0x4d, 0x7f, 0x4c, 0x68, 0xa9, 0xc4, 0x01, 0x9c, 0x48,
-	0x15, 0x11, 0x92, 0xb5, 0xf6, 0x8e, 0xd6, 0xab, 0xac, 0x77, 0x9c, 0x9d, 0x75, 0x50, 0x14, 0xf9,
-	0xc2, 0x85, 0x70, 0x43, 0xe2, 0x8c, 0x94, 0x23, 0xe2, 0x04, 0x52, 0x6e, 0x88, 0x33, 0x39, 0x46,
-	0xe1, 0x82, 0x38, 0x04, 0x94, 0x00, 0x41, 0xe2, 0x82, 0xb8, 0x70, 0x45, 0x3b, 0x33, 0xbb, 0xde,
-	0xb5, 0xbd, 0x76, 0x9c, 0x1f, 0x7a, 0xb1, 0xd7, 0x33, 0xef, 0x7d, 0xef, 0xbd, 0xef, 0x7b, 0xf3,
-	0x76, 0x0c, 0x15, 0x1d, 0x7b, 0x4d, 0xec, 0x6a, 0x36, 0x69, 0x2c, 0x5b, 0x8e, 0xa9, 0xad, 0xde,
-	0xae, 0x63, 0x4f, 0xbf, 0xad, 0xad, 0x74, 0xb0, 0xbb, 0xa6, 0xb6, 0x5d, 0xe2, 0x11, 0xf4, 0x1c,
-	0xb7, 0x51, 0x85, 0x8d, 0x2a, 0x6c, 0xa4, 0x82, 0x49, 0x88, 0x69, 0x63, 0x4d, 0x6f, 0x5b, 0x9a,
-	0xee, 0x38, 0xc4, 0xd3, 0x3d, 0x8b, 0x38, 0x94, 0x7b, 0x49, 0x53, 0x26, 0x31, 0x09, 0x7b, 0xd4,
-	0xfc, 0x27, 0xb1, 0x9a, 0xd3, 0x5b, 0x96, 0x43, 0x34, 0xf6, 0x29, 0x96, 0x66, 0x1a, 0x84, 0xb6,
-	0x08, 0xd5, 0xea, 0x3a, 0xc5, 0x3c, 0x6e, 0x98, 0x45, 0x5b, 0x37, 0x2d, 0x87, 0xa1, 0x0a, 0xdb,
-	0x17, 0xb8, 0x6d, 0x8d, 0xe3, 0xf2, 0x1f, 0x62, 0xeb, 0x45, 0x01, 0x13, 0x20, 0x44, 0x4b, 0x90,
-	0xe4, 0x68, 0x8c, 0x00, 0xbd, 0x41, 0xac, 0x00, 0xf7, 0x7a, 0x02, 0x0d, 0x6d, 0xdd, 0xd5, 0x5b,
-	0x41, 0x84, 0x1b, 0x09, 0x46, 0x01, 0x2f, 0xcc, 0x4a, 0x99, 0x82, 0xe8, 0x03, 0x3f, 0xf2, 0xfb,
-	0xcc, 0xb5, 0x8a, 0x57, 0x3a, 0x98, 0x7a, 0xca, 0x02, 0xbc, 0x1a, 0x5b, 0xa5, 0x6d, 0xe2, 0x50,
-	0x8c, 0xca, 0x30, 0xcb, 0x43, 0xe4, 0xc1, 0x35, 0x30, 0x7d, 0x71, 0x4e, 0x56, 0x87, 0x73, 0xad,
-	0x72, 0xbf, 0xca, 0x33, 0xdb, 0x7b, 0xc5, 0x54, 0x55, 0xf8, 0x28, 0xff, 0x00, 0x58, 0x60, 0xa8,
-	0xef, 0x91, 0xc6, 0x32, 0x36, 0xe6, 0xb1, 0x8d, 0x4d, 0xc6, 0x96, 0x88, 0x8a, 0xee, 0xc1, 0xcb,
-	0x06, 0x5f, 0x24, 0x6e, 0x4d, 0x37, 0x0c, 0x97, 0x85, 0xb9, 0x50, 0xc9, 0xef, 0x6e, 0xcd, 0x4e,
-	0x09, 0xf6, 0x1e, 0x18, 0x86, 0x8b, 0x29, 0x5d, 0xf0, 0x5c, 0xcb, 0x31, 0xab, 0x97, 0x42, 0x7b,
-	0x7f, 0xdd, 0x07, 0x58, 0xd5, 0x6d, 0xcb, 0xe8, 0x01, 0xa4, 0xc7, 0x01, 0x84, 0xf6, 0x0c, 0xe0,
-	0x21, 0x84, 0x3d, 0x11, 0xf3, 0x19, 0x56, 0x64, 0x49, 0x15, 0x9e, 0xbe, 0x1a, 0x2a, 0x97, 0xa9,
-	0x57, 0xa7, 0x89, 0x45, 0xf6, 0xd5, 0x88, 0xe7, 0x9b, 0xe7, 0x37, 0x36, 0x8b, 0xa9, 0x3f, 0x37,
-	0x8b, 0x29, 0xe5, 0xdb, 0x34, 0x7c, 0x29, 0xa1, 0x68, 0x41, 0xea, 0x0a, 0x44, 0x36, 0xdb, 0xab,
-	0x19, 0xe1, 0xa6, 0x4f, 0x70, 0x66, 0xfa, 0xe2, 0xdc, 0xeb, 0x49, 0x04, 0xf7, 0xa3, 0x3d, 0xb1,
-	0xbc, 0xe6, 0x22, 0xf1, 0x74, 0x7b, 0xa1, 0xa9, 0xbb, 0x98, 0x56, 0x2e, 0xf8, 0xcc, 0x7f, 0x75,
-	0xf8, 0xcd, 0x0c, 0xa8, 0xe6, 0xec, 0x3e, 0x5b, 0x8a, 0x16, 0x61, 0x96, 0x32, 0x3b, 0xc1, 0x4f,
-	0xd9, 0xb7, 0xfe, 0x79, 0xaf, 0x58, 0x32, 0x2d, 0xaf, 0xd9, 0xa9, 0xab, 0x0d, 0xd2, 0x12, 0xdd,
-	0x2a, 0xbe, 0x66, 0xa9, 0xb1, 0xac, 0x79, 0x6b, 0x6d, 0x4c, 0xd5, 0xc7, 0x8e, 0xb7, 0xbb, 0x35,
-	0x0b, 0x05, 0x27, 0x8f, 0x1d, 0xaf, 0x2a, 0xb0, 0xd0, 0xa3, 0x21, 0xe4, 0xdd, 0x1c, 0x4b, 0x1e,
-	0x67, 0x21, 0xca, 0x9e, 0xf2, 0x1d, 0x80, 0x25, 0xc6, 0xd9, 0x7c, 0xa0, 0x6e, 0x7f, 0xb9, 0xf4,
-	0xd4, 0x5a, 0x26, 0xae, 0x78, 0xfa, 0x14, 0x14, 0xff, 0x1d, 0xc0, 0x9b, 0x63, 0xb3, 0x7f, 0x7a,
-	0xda, 0x3f, 0x1a, 0x52, 0xf0, 0xb1, 0x54, 0xfa, 0x1e, 0xc0, 0xeb, 0x09, 0x9d, 0xfd, 0xb1, 0xee,
-	0x1a, 0xa1, 0x44, 0xef, 0xc0, 0x5c, 0x5c, 0x22, 0x4c, 0xe9, 0x58, 0x95, 0xae, 0xc4, 0x54, 0xc2,
-	0x94, 0xfa, 0x30, 0xf1, 0xb3, 0xed, 0xc3, 0x8c, 0x3b, 0xde, 0x57, 0x62, 0xc7, 0x1b, 0x53, 0x1a,
-	0xd1, 0xe9, 0xcb, 0x0c, 0xbc, 0x31, 0x3a, 0x7f, 0x21, 0xd2, 0xa7, 0x00, 0x5e, 0x35, 0x2c, 0xea,
-	0xb9, 0x56, 0xbd, 0xe3, 0xef, 0xd7, 0x5c, 0x66, 0x20, 0x64, 0x2a, 0xc4, 0xb8, 0x0b, 0x58, 0x9b,
-	0xc7, 0x8d, 0xb7, 0x89, 0xe5, 0x54, 0xde, 0xf0, 0xb5, 0xf8, 0xfa, 0x97, 0xe2, 0xad, 0x23, 0x9c,
-	0x2c, 0xe1, 0x43, 0xb9, 0x74, 0x28, 0x1a, 0x92, 0xa7, 0x84, 0xba, 0xf0, 0xb2, 0x68, 0x86, 0x20,
-	0x87, 0xf4, 0x99, 0xe6, 0x70, 0x49, 0x44, 0x13, 0xe1, 0x6d, 0x78, 0xce, 0xf3, 0xfb, 0x2c, 0x9f,
-	0x39, 0xd3, 0xa8, 0x3c, 0x88, 0xb2, 0x0e, 0xa7, 0x87, 0xca, 0xc3, 0x5a, 0xfd, 0x4c, 0x7a, 0x2c,
-	0xd2, 0x1c, 0xff, 0x02, 0xf8, 0xf2, 0x11, 0xa2, 0x8b, 0x0e, 0xf9, 0x08, 0x3e, 0xcb, 0xf5, 0x98,
-	0xf8, 0xec, 0x86, 0x33, 0x82, 0x43, 0x46, 0xcf, 0x6e, 0x00, 0xd9, 0xa3, 0x3d, 0xfd, 0x3f, 0xd0,
-	0x3e, 0xf7, 0xc3, 0x79, 0x78, 0x8e, 0x55, 0x8e, 0x3e, 0x03, 0x30, 0xcb, 0x5f, 0xe4, 0x68, 0x26,
-	0xa9, 0x9e, 0xc1, 0xbb, 0x83, 0x74, 0xeb, 0x48, 0xb6, 0x9c, 0x39, 0xa5, 0xf4, 0xc9, 0x8f, 0xbf,
-	0x7d, 0x91, 0xbe, 0x86, 0x64, 0x6d, 0xe4, 0x95, 0x06, 0xfd, 0x01, 0x60, 0x6e, 0x60, 0x8c, 0xa2,
-	0x57, 0x47, 0x86, 0x4a, 0xb8, 0x66, 0x48, 0xaf, 0x4d, 0xe8, 0x25, 0x52, 0x35, 0x36, 0x7c, 0x9a,
-	0x58, 0xbe, 0x1f, 0xa2, 0x27, 0x49, 0xf9, 0x86, 0x03, 0x87, 0x6a, 0xeb, 0xf1, 0x79, 0xd5, 0xd5,
-	0x06, 0x47, 0xbd, 0xb6, 0x1e, 0x6f, 0xdb, 0x2e, 0x3a, 0x04, 0x50, 0x4a, 0x7e, 0x71, 0xa0, 0xbb,
-	0x23, 0x73, 0x1f, 0xfb, 0xbe, 0x94, 0xee, 0x1d, 0xdb, 0x5f, 0xb0, 0xf0, 0x6e, 0x8f, 0x85, 0xb7,
-	0xd0, 0x1d, 0x6d, 0xc4, 0x1d, 0x73, 0x5c, 0xa5, 0x7f, 0x03, 0xf8, 0x7c, 0xc2, 0xe8, 0x45, 0x77,
-	0x26, 0x94, 0x28, 0x3a, 0x0c, 0xa4, 0xf2, 0xf1, 0x9c, 0x45, 0x81, 0x4b, 0xac, 0xb6, 0x45, 0x54,
-	0x4d, 0xaa, 0x2d, 0xac, 0x63, 0xa0, 0x26, 0x4c, 0x69, 0x57, 0x13, 0xa7, 0xb6, 0x5f, 0x7d, 0x7f,
-	0x0f, 0xfd, 0x05, 0x60, 0x61, 0xd4, 0x40, 0x41, 0xf7, 0x27, 0x4a, 0x7d, 0xc8, 0x24, 0x94, 0x1e,
-	0x9c, 0x00, 0x41, 0x30, 0xf0, 0x90, 0x31, 0x70, 0x1f, 0xdd, 0x3d, 0x19, 0x03, 0x95, 0xf2, 0xf6,
-	0xbe, 0x0c, 0x76, 0xf6, 0x65, 0xf0, 0xeb, 0xbe, 0x0c, 0x3e, 0x3f, 0x90, 0x53, 0x3b, 0x07, 0x72,
-	0xea, 0xa7, 0x03, 0x39, 0xb5, 0xa4, 0x44, 0x86, 0x13, 0x8f, 0x81, 0x57, 0x5b, 0x61, 0x18, 0x36,
-	0x9c, 0xea, 0x59, 0xf6, 0xff, 0xe4, 0x95, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x10, 0x7b, 0x42,
-	0x0e, 0xf3, 0x0d, 0x00, 0x00,
+func (m *QueryLockedDelegationEntrySlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedDelegationEntrySlashesRequest) ProtoMessage()    {}
+func (*QueryLockedDelegationEntrySlashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{12}
+}
+func (m *QueryLockedDelegationEntrySlashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedDelegationEntrySlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedDelegationEntrySlashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockedDelegationEntrySlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedDelegationEntrySlashesRequest.Merge(m, src)
+}
+func (m *QueryLockedDelegationEntrySlashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedDelegationEntrySlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedDelegationEntrySlashesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryLockedDelegationEntrySlashesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the params of the locking module
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// LockedDelegation queries locked delegatation info for given validator delegator pair
-	LockedDelegations(ctx context.Context, in *QueryLockedDelegationRequest, opts ...grpc.CallOption) (*QueryLockedDelegationResponse, error)
-	// DelegatorLockedDelegations queries all locked delegations of a given delegator address
-	DelegatorLockedDelegations(ctx context.Context, in *QueryDelegatorLockedDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorLockedDelegationsResponse, error)
-	// LockedDelegationRewards queries the total rewards accrued by locked delegations
-	LockedDelegationRewards(ctx context.Context, in *QueryLockedDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryLockedDelegationRewardsResponse, error)
-	// LockedDelegationTotalRewards queries the total locked delegation rewards accrued by a each
-	// validator
-	LockedDelegationTotalRewards(ctx context.Context, in *QueryLockedDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryLockedDelegationTotalRewardsResponse, error)
+func (m *QueryLockedDelegationEntrySlashesRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryLockedDelegationEntrySlashesResponse is the response type for the
+// Query/LockedDelegationEntrySlashes RPC method
+type QueryLockedDelegationEntrySlashesResponse struct {
+	// validator_address is the validator address of the entry
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// entry is the locked delegation entry
+	Entry LockedDelegationEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry"`
+	// tokens is the current entry token value
+	Tokens github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tokens"`
+	// slashes are the entry token values before and after each validator slash
+	Slashes []LockedDelegationEntrySlash `protobuf:"bytes,4,rep,name=slashes,proto3" json:"slashes"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryLockedDelegationEntrySlashesResponse) Reset() {
+	*m = QueryLockedDelegationEntrySlashesResponse{}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLockedDelegationEntrySlashesResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (c *queryClient) LockedDelegations(ctx context.Context, in *QueryLockedDelegationRequest, opts ...grpc.CallOption) (*QueryLockedDelegationResponse, error) {
-	out := new(QueryLockedDelegationResponse)
-	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/LockedDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (*QueryLockedDelegationEntrySlashesResponse) ProtoMessage() {}
+func (*QueryLockedDelegationEntrySlashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{13}
+}
+func (m *QueryLockedDelegationEntrySlashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedDelegationEntrySlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedDelegationEntrySlashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLockedDelegationEntrySlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedDelegationEntrySlashesResponse.Merge(m, src)
+}
+func (m *QueryLockedDelegationEntrySlashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedDelegationEntrySlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedDelegationEntrySlashesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DelegatorLockedDelegations(ctx context.Context, in *QueryDelegatorLockedDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorLockedDelegationsResponse, error) {
-	out := new(QueryDelegatorLockedDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/DelegatorLockedDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLockedDelegationEntrySlashesResponse proto.InternalMessageInfo
+
+func (m *QueryLockedDelegationEntrySlashesResponse) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) LockedDelegationRewards(ctx context.Context, in *QueryLockedDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryLockedDelegationRewardsResponse, error) {
-	out := new(QueryLockedDelegationRewardsResponse)
-	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/LockedDelegationRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLockedDelegationEntrySlashesResponse) GetEntry() LockedDelegationEntry {
+	if m != nil {
+		return m.Entry
 	}
-	return out, nil
+	return LockedDelegationEntry{}
 }
 
-func (c *queryClient) LockedDelegationTotalRewards(ctx context.Context, in *QueryLockedDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryLockedDelegationTotalRewardsResponse, error) {
-	out := new(QueryLockedDelegationTotalRewardsResponse)
-	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/LockedDelegationTotalRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLockedDelegationEntrySlashesResponse) GetSlashes() []LockedDelegationEntrySlash {
+	if m != nil {
+		return m.Slashes
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the params of the locking module
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// LockedDelegation queries locked delegatation info for given validator delegator pair
-	LockedDelegations(context.Context, *QueryLockedDelegationRequest) (*QueryLockedDelegationResponse, error)
-	// DelegatorLockedDelegations queries all locked delegations of a given delegator address
-	DelegatorLockedDelegations(context.Context, *QueryDelegatorLockedDelegationsRequest) (*QueryDelegatorLockedDelegationsResponse, error)
-	// LockedDelegationRewards queries the total rewards accrued by locked delegations
-	LockedDelegationRewards(context.Context, *QueryLockedDelegationRewardsRequest) (*QueryLockedDelegationRewardsResponse, error)
-	// LockedDelegationTotalRewards queries the total locked delegation rewards accrued by a each
+// QueryLockingStatsRequest is the request type for the Query/LockingStats RPC
+// method
+type QueryLockingStatsRequest struct {
+	// validator_addr optionally restricts the validators stats to a single
 	// validator
-	LockedDelegationTotalRewards(context.Context, *QueryLockedDelegationTotalRewardsRequest) (*QueryLockedDelegationTotalRewardsResponse, error)
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryLockingStatsRequest) Reset()         { *m = QueryLockingStatsRequest{} }
+func (m *QueryLockingStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockingStatsRequest) ProtoMessage()    {}
+func (*QueryLockingStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{14}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryLockingStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LockedDelegations(ctx context.Context, req *QueryLockedDelegationRequest) (*QueryLockedDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LockedDelegations not implemented")
+func (m *QueryLockingStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockingStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DelegatorLockedDelegations(ctx context.Context, req *QueryDelegatorLockedDelegationsRequest) (*QueryDelegatorLockedDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorLockedDelegations not implemented")
+func (m *QueryLockingStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockingStatsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LockedDelegationRewards(ctx context.Context, req *QueryLockedDelegationRewardsRequest) (*QueryLockedDelegationRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LockedDelegationRewards not implemented")
+func (m *QueryLockingStatsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) LockedDelegationTotalRewards(ctx context.Context, req *QueryLockedDelegationTotalRewardsRequest) (*QueryLockedDelegationTotalRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LockedDelegationTotalRewards not implemented")
+func (m *QueryLockingStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockingStatsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryLockingStatsRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aether.locking.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryLockingStatsRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_LockedDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLockedDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LockedDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aether.locking.v1beta1.Query/LockedDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LockedDelegations(ctx, req.(*QueryLockedDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryLockingStatsResponse is the response type for the Query/LockingStats RPC
+// method
+type QueryLockingStatsResponse struct {
+	// total are the module wide locked tokens
+	Total TotalLockingStats `protobuf:"bytes,1,opt,name=total,proto3" json:"total"`
+	// validators are the locked totals per validator
+	Validators []ValidatorLockingStats `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
 }
 
-func _Query_DelegatorLockedDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorLockedDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorLockedDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aether.locking.v1beta1.Query/DelegatorLockedDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorLockedDelegations(ctx, req.(*QueryDelegatorLockedDelegationsRequest))
+func (m *QueryLockingStatsResponse) Reset()         { *m = QueryLockingStatsResponse{} }
+func (m *QueryLockingStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockingStatsResponse) ProtoMessage()    {}
+func (*QueryLockingStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{15}
+}
+func (m *QueryLockingStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockingStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockingStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLockingStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockingStatsResponse.Merge(m, src)
+}
+func (m *QueryLockingStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockingStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockingStatsResponse.DiscardUnknown(m)
 }
 
-func _Query_LockedDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLockedDelegationRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LockedDelegationRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aether.locking.v1beta1.Query/LockedDelegationRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LockedDelegationRewards(ctx, req.(*QueryLockedDelegationRewardsRequest))
+var xxx_messageInfo_QueryLockingStatsResponse proto.InternalMessageInfo
+
+func (m *QueryLockingStatsResponse) GetTotal() TotalLockingStats {
+	if m != nil {
+		return m.Total
 	}
-	return interceptor(ctx, in, info, handler)
+	return TotalLockingStats{}
 }
 
-func _Query_LockedDelegationTotalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLockedDelegationTotalRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LockedDelegationTotalRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aether.locking.v1beta1.Query/LockedDelegationTotalRewards",
+func (m *QueryLockingStatsResponse) GetValidators() []ValidatorLockingStats {
+	if m != nil {
+		return m.Validators
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LockedDelegationTotalRewards(ctx, req.(*QueryLockedDelegationTotalRewardsRequest))
+	return nil
+}
+
+// QueryRewardPoolRequest is the request type for the Query/RewardPool RPC
+// method
+type QueryRewardPoolRequest struct {
+}
+
+func (m *QueryRewardPoolRequest) Reset()         { *m = QueryRewardPoolRequest{} }
+func (m *QueryRewardPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolRequest) ProtoMessage()    {}
+func (*QueryRewardPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{16}
+}
+func (m *QueryRewardPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRewardPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolRequest.Merge(m, src)
+}
+func (m *QueryRewardPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "aether.locking.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "LockedDelegations",
-			Handler:    _Query_LockedDelegations_Handler,
-		},
-		{
-			MethodName: "DelegatorLockedDelegations",
-			Handler:    _Query_DelegatorLockedDelegations_Handler,
-		},
-		{
-			MethodName: "LockedDelegationRewards",
-			Handler:    _Query_LockedDelegationRewards_Handler,
-		},
-		{
-			MethodName: "LockedDelegationTotalRewards",
-			Handler:    _Query_LockedDelegationTotalRewards_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "aether/locking/v1beta1/query.proto",
+var xxx_messageInfo_QueryRewardPoolRequest proto.InternalMessageInfo
+
+// QueryRewardPoolResponse is the response type for the Query/RewardPool RPC
+// method
+type QueryRewardPoolResponse struct {
+	// balance is the reward pool balance
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// total_debt is the total locking rewards owed to the delegators
+	TotalDebt github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_debt,json=totalDebt,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_debt"`
+	// mint_epoch is the current hybrid funding mint epoch
+	MintEpoch MintEpoch `protobuf:"bytes,3,opt,name=mint_epoch,json=mintEpoch,proto3" json:"mint_epoch"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardPoolResponse) Reset()         { *m = QueryRewardPoolResponse{} }
+func (m *QueryRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolResponse) ProtoMessage()    {}
+func (*QueryRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{17}
+}
+func (m *QueryRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolResponse.Merge(m, src)
+}
+func (m *QueryRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRewardPoolResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPoolResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRewardPoolResponse) GetTotalDebt() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalDebt
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardPoolResponse) GetMintEpoch() MintEpoch {
+	if m != nil {
+		return m.MintEpoch
 	}
-	return dAtA[:n], nil
+	return MintEpoch{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelegatorRewardDebtsRequest is the request type for the
+// Query/DelegatorRewardDebts RPC method
+type QueryDelegatorRewardDebtsRequest struct {
+	// delegator_address defines the delegator address to query for
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDelegatorRewardDebtsRequest) Reset()         { *m = QueryDelegatorRewardDebtsRequest{} }
+func (m *QueryDelegatorRewardDebtsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorRewardDebtsRequest) ProtoMessage()    {}
+func (*QueryDelegatorRewardDebtsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{18}
+}
+func (m *QueryDelegatorRewardDebtsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorRewardDebtsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorRewardDebtsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegatorRewardDebtsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorRewardDebtsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorRewardDebtsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorRewardDebtsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorRewardDebtsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLockedDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegatorRewardDebtsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorRewardDebtsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryLockedDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelegatorRewardDebtsResponse is the response type for the
+// Query/DelegatorRewardDebts RPC method
+type QueryDelegatorRewardDebtsResponse struct {
+	// debts are the locking rewards owed to the delegator per validator
+	Debts []RewardDebt `protobuf:"bytes,1,rep,name=debts,proto3" json:"debts"`
+	// total is the sum of the debts
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
 }
 
-func (m *QueryLockedDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDelegatorRewardDebtsResponse) Reset()         { *m = QueryDelegatorRewardDebtsResponse{} }
+func (m *QueryDelegatorRewardDebtsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorRewardDebtsResponse) ProtoMessage()    {}
+func (*QueryDelegatorRewardDebtsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{19}
+}
+func (m *QueryDelegatorRewardDebtsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorRewardDebtsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorRewardDebtsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryDelegatorRewardDebtsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorRewardDebtsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorRewardDebtsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorRewardDebtsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorRewardDebtsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorRewardDebtsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorRewardDebtsResponse) GetDebts() []RewardDebt {
+	if m != nil {
+		return m.Debts
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryLockedDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorRewardDebtsResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLockedDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBonusBeneficiaryRequest is the request type for the
+// Query/BonusBeneficiary RPC method
+type QueryBonusBeneficiaryRequest struct {
+	// delegator_address defines the delegator address to query for
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryLockedDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Shares.Size()
-		i -= size
-		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.LockedDelegations) > 0 {
-		for iNdEx := len(m.LockedDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LockedDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryBonusBeneficiaryRequest) Reset()         { *m = QueryBonusBeneficiaryRequest{} }
+func (m *QueryBonusBeneficiaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBonusBeneficiaryRequest) ProtoMessage()    {}
+func (*QueryBonusBeneficiaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{20}
+}
+func (m *QueryBonusBeneficiaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBonusBeneficiaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBonusBeneficiaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBonusBeneficiaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBonusBeneficiaryRequest.Merge(m, src)
+}
+func (m *QueryBonusBeneficiaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBonusBeneficiaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBonusBeneficiaryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDelegatorLockedDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBonusBeneficiaryRequest proto.InternalMessageInfo
+
+func (m *QueryBonusBeneficiaryRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDelegatorLockedDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBonusBeneficiaryResponse is the response type for the
+// Query/BonusBeneficiary RPC method
+type QueryBonusBeneficiaryResponse struct {
+	// beneficiary_address is the bonus beneficiary set by the delegator, empty
+	// if none is set
+	BeneficiaryAddress string `protobuf:"bytes,1,opt,name=beneficiary_address,json=beneficiaryAddress,proto3" json:"beneficiary_address,omitempty"`
+	// recipient_address is the address the locking rewards are paid to, the
+	// bonus beneficiary or the distribution withdraw address
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
 }
 
-func (m *QueryDelegatorLockedDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryBonusBeneficiaryResponse) Reset()         { *m = QueryBonusBeneficiaryResponse{} }
+func (m *QueryBonusBeneficiaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBonusBeneficiaryResponse) ProtoMessage()    {}
+func (*QueryBonusBeneficiaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{21}
+}
+func (m *QueryBonusBeneficiaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBonusBeneficiaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBonusBeneficiaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryBonusBeneficiaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBonusBeneficiaryResponse.Merge(m, src)
+}
+func (m *QueryBonusBeneficiaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBonusBeneficiaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBonusBeneficiaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBonusBeneficiaryResponse proto.InternalMessageInfo
+
+func (m *QueryBonusBeneficiaryResponse) GetBeneficiaryAddress() string {
+	if m != nil {
+		return m.BeneficiaryAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDelegatorLockedDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBonusBeneficiaryResponse) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDelegatorLockedDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLockingBudgetRequest is the request type for the Query/LockingBudget
+// RPC method
+type QueryLockingBudgetRequest struct {
 }
 
-func (m *QueryDelegatorLockedDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryLockingBudgetRequest) Reset()         { *m = QueryLockingBudgetRequest{} }
+func (m *QueryLockingBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockingBudgetRequest) ProtoMessage()    {}
+func (*QueryLockingBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{22}
+}
+func (m *QueryLockingBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockingBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockingBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.LockedDelegations) > 0 {
-		for iNdEx := len(m.LockedDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LockedDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+func (m *QueryLockingBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockingBudgetRequest.Merge(m, src)
+}
+func (m *QueryLockingBudgetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockingBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockingBudgetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockingBudgetRequest proto.InternalMessageInfo
+
+// QueryLockingBudgetResponse is the response type for the Query/LockingBudget
+// RPC method
+type QueryLockingBudgetResponse struct {
+	// window is the current budget window
+	Window BudgetWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window"`
+	// cap is the max amount minted per window
+	Cap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=cap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"cap"`
+	// remaining is the amount that can still be minted on the window
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+}
+
+func (m *QueryLockingBudgetResponse) Reset()         { *m = QueryLockingBudgetResponse{} }
+func (m *QueryLockingBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockingBudgetResponse) ProtoMessage()    {}
+func (*QueryLockingBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{23}
+}
+func (m *QueryLockingBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockingBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockingBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLockingBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockingBudgetResponse.Merge(m, src)
+}
+func (m *QueryLockingBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockingBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockingBudgetResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLockedDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLockingBudgetResponse proto.InternalMessageInfo
+
+func (m *QueryLockingBudgetResponse) GetWindow() BudgetWindow {
+	if m != nil {
+		return m.Window
 	}
-	return dAtA[:n], nil
+	return BudgetWindow{}
 }
 
-func (m *QueryLockedDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLockingBudgetResponse) GetCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Cap
+	}
+	return nil
 }
 
-func (m *QueryLockedDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryLockingBudgetResponse) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// QueryQuarantinedPairsRequest is the request type for the
+// Query/QuarantinedPairs RPC method
+type QueryQuarantinedPairsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQuarantinedPairsRequest) Reset()         { *m = QueryQuarantinedPairsRequest{} }
+func (m *QueryQuarantinedPairsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuarantinedPairsRequest) ProtoMessage()    {}
+func (*QueryQuarantinedPairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{24}
+}
+func (m *QueryQuarantinedPairsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuarantinedPairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuarantinedPairsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryQuarantinedPairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuarantinedPairsRequest.Merge(m, src)
+}
+func (m *QueryQuarantinedPairsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuarantinedPairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuarantinedPairsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLockedDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQuarantinedPairsRequest proto.InternalMessageInfo
+
+func (m *QueryQuarantinedPairsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLockedDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryQuarantinedPairsResponse is the response type for the
+// Query/QuarantinedPairs RPC method
+type QueryQuarantinedPairsResponse struct {
+	// quarantined_pairs are the quarantined locked delegation pairs
+	QuarantinedPairs []QuarantinedPair `protobuf:"bytes,1,rep,name=quarantined_pairs,json=quarantinedPairs,proto3" json:"quarantined_pairs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLockedDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Total) > 0 {
-		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.LockingReward) > 0 {
-		for iNdEx := len(m.LockingReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LockingReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.DistributionReward) > 0 {
-		for iNdEx := len(m.DistributionReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DistributionReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryQuarantinedPairsResponse) Reset()         { *m = QueryQuarantinedPairsResponse{} }
+func (m *QueryQuarantinedPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuarantinedPairsResponse) ProtoMessage()    {}
+func (*QueryQuarantinedPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{25}
+}
+func (m *QueryQuarantinedPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuarantinedPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuarantinedPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryQuarantinedPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuarantinedPairsResponse.Merge(m, src)
+}
+func (m *QueryQuarantinedPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuarantinedPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuarantinedPairsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLockedDelegationTotalRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQuarantinedPairsResponse proto.InternalMessageInfo
+
+func (m *QueryQuarantinedPairsResponse) GetQuarantinedPairs() []QuarantinedPair {
+	if m != nil {
+		return m.QuarantinedPairs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLockedDelegationTotalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryQuarantinedPairsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryLockedDelegationTotalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryUnlocksRequest is the request type for the Query/Unlocks RPC method
+type QueryUnlocksRequest struct {
+	// start_time is the inclusive start of the unlock time range
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the inclusive end of the unlock time range
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// delegator_addr optionally restricts the unlocks to a delegator
+	DelegatorAddr string `protobuf:"bytes,3,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	// validator_addr optionally restricts the unlocks to a validator
+	ValidatorAddr string `protobuf:"bytes,4,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLockedDelegationTotalRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnlocksRequest) Reset()         { *m = QueryUnlocksRequest{} }
+func (m *QueryUnlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlocksRequest) ProtoMessage()    {}
+func (*QueryUnlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{26}
+}
+func (m *QueryUnlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryUnlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlocksRequest.Merge(m, src)
+}
+func (m *QueryUnlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlocksRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLockedDelegationTotalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryUnlocksRequest proto.InternalMessageInfo
+
+// QueryUnlocksResponse is the response type for the Query/Unlocks RPC method
+type QueryUnlocksResponse struct {
+	// unlocks are the queued entries, ordered by unlock time and ID
+	Unlocks []LockedDelegationUnlock `protobuf:"bytes,1,rep,name=unlocks,proto3" json:"unlocks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLockedDelegationTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Total) > 0 {
-		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryUnlocksResponse) Reset()         { *m = QueryUnlocksResponse{} }
+func (m *QueryUnlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlocksResponse) ProtoMessage()    {}
+func (*QueryUnlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{27}
+}
+func (m *QueryUnlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryUnlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlocksResponse.Merge(m, src)
+}
+func (m *QueryUnlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlocksResponse proto.InternalMessageInfo
+
+func (m *QueryUnlocksResponse) GetUnlocks() []LockedDelegationUnlock {
+	if m != nil {
+		return m.Unlocks
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryUnlocksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryLockedDelegationEntryRequest is the request type for the
+// Query/LockedDelegationEntry RPC method
+type QueryLockedDelegationEntryRequest struct {
+	// id is the locked delegation entry id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryLockedDelegationEntryRequest) Reset()         { *m = QueryLockedDelegationEntryRequest{} }
+func (m *QueryLockedDelegationEntryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedDelegationEntryRequest) ProtoMessage()    {}
+func (*QueryLockedDelegationEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{28}
+}
+func (m *QueryLockedDelegationEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedDelegationEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedDelegationEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryLockedDelegationEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedDelegationEntryRequest.Merge(m, src)
+}
+func (m *QueryLockedDelegationEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedDelegationEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedDelegationEntryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryLockedDelegationEntryRequest proto.InternalMessageInfo
+
+func (m *QueryLockedDelegationEntryRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryLockedDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryLockedDelegationEntryResponse is the response type for the
+// Query/LockedDelegationEntry RPC method
+type QueryLockedDelegationEntryResponse struct {
+	// delegator_address is the delegator address of the entry
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address of the entry
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// entry is the locked delegation entry
+	Entry LockedDelegationEntry `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry"`
+	// tokens is the current entry token value
+	Tokens github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tokens"`
+	// time_remaining is the time left until the entry unlocks, zero if expired
+	TimeRemaining time.Duration `protobuf:"bytes,5,opt,name=time_remaining,json=timeRemaining,proto3,stdduration" json:"time_remaining"`
+	// bonus_estimate is the entry share of the pending locking rewards of its
+	// pair
+	BonusEstimate github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=bonus_estimate,json=bonusEstimate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"bonus_estimate"`
+}
+
+func (m *QueryLockedDelegationEntryResponse) Reset()         { *m = QueryLockedDelegationEntryResponse{} }
+func (m *QueryLockedDelegationEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedDelegationEntryResponse) ProtoMessage()    {}
+func (*QueryLockedDelegationEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{29}
+}
+func (m *QueryLockedDelegationEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedDelegationEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedDelegationEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryLockedDelegationEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedDelegationEntryResponse.Merge(m, src)
+}
+func (m *QueryLockedDelegationEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedDelegationEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedDelegationEntryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockedDelegationEntryResponse proto.InternalMessageInfo
+
+func (m *QueryLockedDelegationEntryResponse) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryLockedDelegationEntryResponse) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryLockedDelegationEntryResponse) GetEntry() LockedDelegationEntry {
+	if m != nil {
+		return m.Entry
 	}
-	return n
+	return LockedDelegationEntry{}
 }
 
-func (m *QueryLockedDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLockedDelegationEntryResponse) GetTimeRemaining() time.Duration {
+	if m != nil {
+		return m.TimeRemaining
 	}
-	var l int
-	_ = l
-	if len(m.LockedDelegations) > 0 {
-		for _, e := range m.LockedDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryLockedDelegationEntryResponse) GetBonusEstimate() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.BonusEstimate
+	}
+	return nil
+}
+
+// QueryDelegatorLockingSummaryRequest is the request type for the
+// Query/DelegatorLockingSummary RPC method
+type QueryDelegatorLockingSummaryRequest struct {
+	// delegator_address defines the delegator address to query for
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorLockingSummaryRequest) Reset()         { *m = QueryDelegatorLockingSummaryRequest{} }
+func (m *QueryDelegatorLockingSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorLockingSummaryRequest) ProtoMessage()    {}
+func (*QueryDelegatorLockingSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{30}
+}
+func (m *QueryDelegatorLockingSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorLockingSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorLockingSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = m.Shares.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDelegatorLockingSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorLockingSummaryRequest.Merge(m, src)
+}
+func (m *QueryDelegatorLockingSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorLockingSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorLockingSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorLockingSummaryRequest proto.InternalMessageInfo
+
+// QueryDelegatorLockingSummaryResponse is the response type for the
+// Query/DelegatorLockingSummary RPC method
+type QueryDelegatorLockingSummaryResponse struct {
+	// total is the summary over all the delegator validators
+	Total LockingSummary `protobuf:"bytes,1,opt,name=total,proto3" json:"total"`
+	// validators are the summaries per validator delegated to
+	Validators []ValidatorLockingSummary `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *QueryDelegatorLockingSummaryResponse) Reset()         { *m = QueryDelegatorLockingSummaryResponse{} }
+func (m *QueryDelegatorLockingSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorLockingSummaryResponse) ProtoMessage()    {}
+func (*QueryDelegatorLockingSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{31}
+}
+func (m *QueryDelegatorLockingSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorLockingSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorLockingSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryDelegatorLockingSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorLockingSummaryResponse.Merge(m, src)
+}
+func (m *QueryDelegatorLockingSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorLockingSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorLockingSummaryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDelegatorLockedDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryDelegatorLockingSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorLockingSummaryResponse) GetTotal() LockingSummary {
+	if m != nil {
+		return m.Total
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return LockingSummary{}
+}
+
+func (m *QueryDelegatorLockingSummaryResponse) GetValidators() []ValidatorLockingSummary {
+	if m != nil {
+		return m.Validators
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryEntryEscrowRequest is the request type for the Query/EntryEscrow RPC
+// method
+type QueryEntryEscrowRequest struct {
+	// id is the locked delegation entry id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryEntryEscrowRequest) Reset()         { *m = QueryEntryEscrowRequest{} }
+func (m *QueryEntryEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryEscrowRequest) ProtoMessage()    {}
+func (*QueryEntryEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{32}
+}
+func (m *QueryEntryEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntryEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryEntryEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryEscrowRequest.Merge(m, src)
+}
+func (m *QueryEntryEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntryEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryEscrowRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDelegatorLockedDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryEntryEscrowRequest proto.InternalMessageInfo
+
+func (m *QueryEntryEscrowRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	if len(m.LockedDelegations) > 0 {
-		for _, e := range m.LockedDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// QueryEntryEscrowResponse is the response type for the Query/EntryEscrow RPC
+// method
+type QueryEntryEscrowResponse struct {
+	// amount is the escrowed locking rewards of the entry, empty if there are
+	// none
+	Amount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+}
+
+func (m *QueryEntryEscrowResponse) Reset()         { *m = QueryEntryEscrowResponse{} }
+func (m *QueryEntryEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryEscrowResponse) ProtoMessage()    {}
+func (*QueryEntryEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054ebd2246d2b6c0, []int{33}
+}
+func (m *QueryEntryEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntryEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryEntryEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryEscrowResponse.Merge(m, src)
+}
+func (m *QueryEntryEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntryEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEntryEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryEntryEscrowResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Amount
 	}
-	return n
+	return nil
 }
 
-func (m *QueryLockedDelegationRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "aether.locking.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "aether.locking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryLockedDelegationRequest)(nil), "aether.locking.v1beta1.QueryLockedDelegationRequest")
+	proto.RegisterType((*QueryLockedDelegationResponse)(nil), "aether.locking.v1beta1.QueryLockedDelegationResponse")
+	proto.RegisterType((*QueryDelegatorLockedDelegationsRequest)(nil), "aether.locking.v1beta1.QueryDelegatorLockedDelegationsRequest")
+	proto.RegisterType((*QueryDelegatorLockedDelegationsResponse)(nil), "aether.locking.v1beta1.QueryDelegatorLockedDelegationsResponse")
+	proto.RegisterType((*QueryValidatorLockedDelegationsRequest)(nil), "aether.locking.v1beta1.QueryValidatorLockedDelegationsRequest")
+	proto.RegisterType((*QueryValidatorLockedDelegationsResponse)(nil), "aether.locking.v1beta1.QueryValidatorLockedDelegationsResponse")
+	proto.RegisterType((*QueryLockedDelegationRewardsRequest)(nil), "aether.locking.v1beta1.QueryLockedDelegationRewardsRequest")
+	proto.RegisterType((*QueryLockedDelegationRewardsResponse)(nil), "aether.locking.v1beta1.QueryLockedDelegationRewardsResponse")
+	proto.RegisterType((*QueryLockedDelegationTotalRewardsRequest)(nil), "aether.locking.v1beta1.QueryLockedDelegationTotalRewardsRequest")
+	proto.RegisterType((*QueryLockedDelegationTotalRewardsResponse)(nil), "aether.locking.v1beta1.QueryLockedDelegationTotalRewardsResponse")
+	proto.RegisterType((*QueryLockedDelegationEntrySlashesRequest)(nil), "aether.locking.v1beta1.QueryLockedDelegationEntrySlashesRequest")
+	proto.RegisterType((*QueryLockedDelegationEntrySlashesResponse)(nil), "aether.locking.v1beta1.QueryLockedDelegationEntrySlashesResponse")
+	proto.RegisterType((*QueryLockingStatsRequest)(nil), "aether.locking.v1beta1.QueryLockingStatsRequest")
+	proto.RegisterType((*QueryLockingStatsResponse)(nil), "aether.locking.v1beta1.QueryLockingStatsResponse")
+	proto.RegisterType((*QueryRewardPoolRequest)(nil), "aether.locking.v1beta1.QueryRewardPoolRequest")
+	proto.RegisterType((*QueryRewardPoolResponse)(nil), "aether.locking.v1beta1.QueryRewardPoolResponse")
+	proto.RegisterType((*QueryDelegatorRewardDebtsRequest)(nil), "aether.locking.v1beta1.QueryDelegatorRewardDebtsRequest")
+	proto.RegisterType((*QueryDelegatorRewardDebtsResponse)(nil), "aether.locking.v1beta1.QueryDelegatorRewardDebtsResponse")
+	proto.RegisterType((*QueryBonusBeneficiaryRequest)(nil), "aether.locking.v1beta1.QueryBonusBeneficiaryRequest")
+	proto.RegisterType((*QueryBonusBeneficiaryResponse)(nil), "aether.locking.v1beta1.QueryBonusBeneficiaryResponse")
+	proto.RegisterType((*QueryLockingBudgetRequest)(nil), "aether.locking.v1beta1.QueryLockingBudgetRequest")
+	proto.RegisterType((*QueryLockingBudgetResponse)(nil), "aether.locking.v1beta1.QueryLockingBudgetResponse")
+	proto.RegisterType((*QueryQuarantinedPairsRequest)(nil), "aether.locking.v1beta1.QueryQuarantinedPairsRequest")
+	proto.RegisterType((*QueryQuarantinedPairsResponse)(nil), "aether.locking.v1beta1.QueryQuarantinedPairsResponse")
+	proto.RegisterType((*QueryUnlocksRequest)(nil), "aether.locking.v1beta1.QueryUnlocksRequest")
+	proto.RegisterType((*QueryUnlocksResponse)(nil), "aether.locking.v1beta1.QueryUnlocksResponse")
+	proto.RegisterType((*QueryLockedDelegationEntryRequest)(nil), "aether.locking.v1beta1.QueryLockedDelegationEntryRequest")
+	proto.RegisterType((*QueryLockedDelegationEntryResponse)(nil), "aether.locking.v1beta1.QueryLockedDelegationEntryResponse")
+	proto.RegisterType((*QueryDelegatorLockingSummaryRequest)(nil), "aether.locking.v1beta1.QueryDelegatorLockingSummaryRequest")
+	proto.RegisterType((*QueryDelegatorLockingSummaryResponse)(nil), "aether.locking.v1beta1.QueryDelegatorLockingSummaryResponse")
+	proto.RegisterType((*QueryEntryEscrowRequest)(nil), "aether.locking.v1beta1.QueryEntryEscrowRequest")
+	proto.RegisterType((*QueryEntryEscrowResponse)(nil), "aether.locking.v1beta1.QueryEntryEscrowResponse")
+}
+
+func init() {
+	proto.RegisterFile("aether/locking/v1beta1/query.proto", fileDescriptor_054ebd2246d2b6c0)
+}
+
+var fileDescriptor_054ebd2246d2b6c0 = []byte{
+	// 2170 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4d, 0x6c, 0x1c, 0x59,
+	0x11, 0xf6, 0x1b, 0xff, 0xc5, 0x15, 0x12, 0xd9, 0x2f, 0x26, 0x99, 0xf4, 0x26, 0x33, 0x49, 0xc7,
+	0x38, 0x71, 0x1c, 0x4f, 0x27, 0x5e, 0x02, 0x49, 0xd6, 0x6c, 0x36, 0x13, 0x3b, 0x7f, 0x0b, 0x28,
+	0x3b, 0xce, 0x12, 0x08, 0x48, 0xa3, 0x9e, 0xe9, 0x97, 0x71, 0x93, 0x99, 0xee, 0x71, 0xbf, 0x9e,
+	0x44, 0x91, 0xe5, 0x0b, 0x17, 0x16, 0x4e, 0x11, 0x5c, 0xb8, 0xed, 0x4a, 0x7b, 0x41, 0x7b, 0xda,
+	0x95, 0x22, 0x21, 0x84, 0x38, 0xac, 0xb8, 0x2c, 0xe2, 0x12, 0x2d, 0x12, 0x42, 0x48, 0x64, 0x21,
+	0x59, 0x58, 0x24, 0x0e, 0xc0, 0x5e, 0xb8, 0xa2, 0x7e, 0xaf, 0x7a, 0xba, 0x7b, 0x66, 0x7a, 0xfe,
+	0x3c, 0x03, 0x68, 0x2f, 0x89, 0xdd, 0xfd, 0xaa, 0xea, 0xab, 0xaf, 0xaa, 0xde, 0xab, 0x57, 0x6d,
+	0x50, 0x75, 0xe6, 0x6e, 0x30, 0x47, 0x2b, 0xdb, 0xc5, 0x7b, 0xa6, 0x55, 0xd2, 0xee, 0x9f, 0x29,
+	0x30, 0x57, 0x3f, 0xa3, 0x6d, 0xd6, 0x98, 0xf3, 0x30, 0x53, 0x75, 0x6c, 0xd7, 0xa6, 0xfb, 0xe5,
+	0x9a, 0x0c, 0xae, 0xc9, 0xe0, 0x1a, 0xe5, 0x50, 0xc9, 0xb6, 0x4b, 0x65, 0xa6, 0xe9, 0x55, 0x53,
+	0xd3, 0x2d, 0xcb, 0x76, 0x75, 0xd7, 0xb4, 0x2d, 0x2e, 0xa5, 0x94, 0xd9, 0x92, 0x5d, 0xb2, 0xc5,
+	0x8f, 0x9a, 0xf7, 0x13, 0x3e, 0x9d, 0xd1, 0x2b, 0xa6, 0x65, 0x6b, 0xe2, 0x5f, 0x7c, 0x74, 0xb2,
+	0x68, 0xf3, 0x8a, 0xcd, 0xb5, 0x82, 0xce, 0x99, 0xb4, 0x5b, 0x47, 0x51, 0xd5, 0x4b, 0xa6, 0x25,
+	0xb4, 0xe2, 0xda, 0x83, 0x72, 0x6d, 0x5e, 0xea, 0x95, 0xbf, 0xe0, 0xab, 0x17, 0x50, 0x8d, 0xaf,
+	0x21, 0xec, 0x82, 0x92, 0x0a, 0xdb, 0xf0, 0xb5, 0x17, 0x6d, 0xd3, 0xd7, 0x9b, 0x46, 0x57, 0xc4,
+	0x6f, 0x85, 0xda, 0x5d, 0xcd, 0x35, 0x2b, 0x8c, 0xbb, 0x7a, 0xa5, 0xea, 0x2b, 0x68, 0x5c, 0x60,
+	0xd4, 0x9c, 0x30, 0xb0, 0x63, 0x31, 0x3c, 0x56, 0x75, 0x47, 0xaf, 0xf8, 0x10, 0xe7, 0x62, 0x16,
+	0xf9, 0xc4, 0x8a, 0x55, 0xea, 0x2c, 0xd0, 0xd7, 0x3c, 0xe8, 0x37, 0x85, 0x68, 0x8e, 0x6d, 0xd6,
+	0x18, 0x77, 0xd5, 0x75, 0xd8, 0x17, 0x79, 0xca, 0xab, 0xb6, 0xc5, 0x19, 0x5d, 0x81, 0x09, 0x69,
+	0x22, 0x49, 0x8e, 0x90, 0x13, 0xbb, 0x97, 0x53, 0x99, 0xd6, 0xc1, 0xca, 0x48, 0xb9, 0xec, 0xd8,
+	0x07, 0x4f, 0xd3, 0x23, 0x39, 0x94, 0x51, 0x3f, 0x25, 0x70, 0x48, 0x68, 0xfd, 0xaa, 0x5d, 0xbc,
+	0xc7, 0x8c, 0x55, 0x56, 0x66, 0x25, 0xe1, 0x15, 0x5a, 0xa5, 0x17, 0x61, 0xaf, 0x21, 0x1f, 0xda,
+	0x4e, 0x5e, 0x37, 0x0c, 0x47, 0x98, 0x99, 0xca, 0x26, 0x3f, 0x7c, 0xbc, 0x34, 0x8b, 0xf4, 0x5f,
+	0x32, 0x0c, 0x87, 0x71, 0xbe, 0xee, 0x3a, 0xa6, 0x55, 0xca, 0xed, 0xa9, 0xaf, 0xf7, 0x9e, 0x7b,
+	0x0a, 0xee, 0xeb, 0x65, 0xd3, 0x08, 0x14, 0x24, 0x3a, 0x29, 0xa8, 0xaf, 0x17, 0x0a, 0xae, 0x00,
+	0x04, 0x59, 0x90, 0x1c, 0x15, 0x4e, 0xce, 0x67, 0x50, 0xd2, 0x0b, 0x67, 0x46, 0xc6, 0x39, 0xf0,
+	0xb3, 0xc4, 0x10, 0x7d, 0x2e, 0x24, 0x79, 0x61, 0xd7, 0x1b, 0x6f, 0xa5, 0x47, 0xfe, 0xf6, 0x56,
+	0x7a, 0x44, 0x7d, 0x2f, 0x01, 0x87, 0x63, 0x9c, 0x46, 0x52, 0x37, 0x81, 0x96, 0xc5, 0xbb, 0xbc,
+	0x51, 0x7f, 0xe9, 0x11, 0x3c, 0x7a, 0x62, 0xf7, 0xf2, 0x97, 0xe3, 0x08, 0x6e, 0xd4, 0x76, 0xdb,
+	0x74, 0x37, 0x6e, 0xd9, 0xae, 0x5e, 0x5e, 0xdf, 0xd0, 0x1d, 0xc6, 0xb3, 0x53, 0x1e, 0xf3, 0x3f,
+	0xfd, 0xe4, 0xdd, 0x93, 0x24, 0x37, 0x53, 0x6e, 0x58, 0xcb, 0xe9, 0x2d, 0x98, 0xe0, 0x62, 0x1d,
+	0xf2, 0xb3, 0xe2, 0xad, 0xfe, 0xc3, 0xd3, 0xf4, 0x7c, 0xc9, 0x74, 0x37, 0x6a, 0x85, 0x4c, 0xd1,
+	0xae, 0x60, 0xba, 0xe3, 0x7f, 0x4b, 0xdc, 0xb8, 0xa7, 0xb9, 0x0f, 0xab, 0x8c, 0x67, 0xae, 0x5b,
+	0xee, 0x87, 0x8f, 0x97, 0x00, 0x39, 0xb9, 0x6e, 0xb9, 0x39, 0xd4, 0x45, 0xaf, 0xb6, 0x20, 0xef,
+	0x78, 0x47, 0xf2, 0x24, 0x0b, 0x61, 0xf6, 0xd4, 0x5f, 0x10, 0x98, 0x17, 0x9c, 0xad, 0xfa, 0xd1,
+	0x6d, 0x74, 0x97, 0x0f, 0x2c, 0x65, 0xa2, 0x11, 0x4f, 0x0c, 0x20, 0xe2, 0x7f, 0x21, 0x70, 0xbc,
+	0x23, 0xfa, 0xff, 0x5d, 0xec, 0xaf, 0xb6, 0x70, 0x78, 0x67, 0x51, 0xfa, 0x86, 0x5f, 0x42, 0xed,
+	0xa2, 0xd4, 0x50, 0x97, 0x64, 0x27, 0x75, 0x39, 0xd0, 0x28, 0xb5, 0x43, 0xff, 0x19, 0x88, 0xd2,
+	0x2f, 0x09, 0x1c, 0x8b, 0xd9, 0x7f, 0x1e, 0xe8, 0x8e, 0x51, 0x0f, 0xd1, 0x1a, 0xcc, 0x44, 0x0b,
+	0x89, 0x71, 0xde, 0x31, 0x4a, 0xd3, 0x91, 0x5a, 0x62, 0x9c, 0x7b, 0x6a, 0xa2, 0x91, 0xf6, 0xd4,
+	0x74, 0xda, 0x84, 0xa7, 0x23, 0xc1, 0x66, 0x9c, 0x87, 0xe2, 0xf4, 0xee, 0x18, 0xcc, 0xb5, 0xc7,
+	0x8f, 0x41, 0xfa, 0x3e, 0x81, 0x7d, 0x86, 0xc9, 0x5d, 0xc7, 0x2c, 0xd4, 0xbc, 0xf7, 0x79, 0x47,
+	0x2c, 0xc0, 0x30, 0x1d, 0x8a, 0x70, 0xe7, 0xb3, 0xb6, 0xca, 0x8a, 0x97, 0x6d, 0xd3, 0xca, 0x9e,
+	0xf3, 0x62, 0xf1, 0xce, 0x47, 0xe9, 0xc5, 0x2e, 0xf6, 0x3f, 0x94, 0xe1, 0x32, 0x74, 0x34, 0x6c,
+	0x52, 0x42, 0xa2, 0xdb, 0xb0, 0x17, 0x93, 0xc1, 0xc7, 0x90, 0x18, 0x2a, 0x86, 0x3d, 0x68, 0x0d,
+	0xcd, 0x97, 0x61, 0xdc, 0xf5, 0xf2, 0x2c, 0x39, 0x3a, 0x54, 0xab, 0xd2, 0x08, 0x7d, 0x44, 0x20,
+	0x19, 0xf5, 0x36, 0xef, 0xb0, 0x8a, 0x6e, 0x5a, 0x06, 0x73, 0x92, 0x63, 0x43, 0x45, 0xb0, 0x3f,
+	0xe2, 0x77, 0xce, 0xb7, 0xaa, 0x6e, 0xc1, 0x89, 0x96, 0x19, 0x23, 0xaa, 0x6f, 0x28, 0x69, 0x1f,
+	0xca, 0xd7, 0x7f, 0x13, 0x58, 0xe8, 0xc2, 0x3a, 0x26, 0xed, 0x77, 0x60, 0x52, 0x92, 0xd6, 0xf3,
+	0x76, 0x52, 0x3f, 0x5c, 0xa4, 0xca, 0xf0, 0x76, 0xe2, 0xab, 0x0c, 0x32, 0x21, 0xf1, 0x5f, 0xc8,
+	0x04, 0xf5, 0x42, 0x0c, 0xed, 0x6b, 0x96, 0xeb, 0x3c, 0x5c, 0x2f, 0xeb, 0x7c, 0x83, 0xd5, 0x69,
+	0xdf, 0x0b, 0x09, 0xd3, 0x10, 0x3c, 0x8f, 0xe5, 0x12, 0xa6, 0xa1, 0xfe, 0x2b, 0x01, 0x0b, 0x5d,
+	0x08, 0x23, 0x6b, 0x2d, 0x37, 0x19, 0xd2, 0xeb, 0x26, 0x43, 0xbf, 0x0e, 0xe3, 0xcc, 0x53, 0x8f,
+	0xdb, 0xeb, 0x52, 0xb7, 0xd4, 0x0b, 0x4c, 0x61, 0xc2, 0xa5, 0x1a, 0xaf, 0xab, 0x72, 0xed, 0x7b,
+	0xcc, 0xe2, 0xc9, 0xd1, 0x9e, 0xbb, 0xaa, 0x55, 0x56, 0x0c, 0x75, 0x55, 0xab, 0xac, 0x98, 0x43,
+	0x5d, 0xf4, 0x36, 0x4c, 0x72, 0xe9, 0x3f, 0x96, 0xd3, 0x72, 0x4f, 0x38, 0x05, 0x77, 0x91, 0xec,
+	0x40, 0x6d, 0xea, 0xb7, 0x21, 0x59, 0xa7, 0xdc, 0xb4, 0x4a, 0xeb, 0xae, 0xee, 0x0e, 0xec, 0xc0,
+	0x56, 0xdf, 0x27, 0x70, 0xb0, 0x85, 0x76, 0x0c, 0xe0, 0x0d, 0x3f, 0x31, 0xe5, 0x35, 0x62, 0x21,
+	0xce, 0x23, 0x51, 0x33, 0x61, 0x0d, 0x11, 0xd6, 0xe5, 0x06, 0xf4, 0x4d, 0x80, 0xba, 0x69, 0x8e,
+	0x99, 0x1e, 0x1b, 0xca, 0xc8, 0x61, 0xdf, 0x4a, 0x69, 0x48, 0x97, 0x9a, 0x84, 0xfd, 0xc2, 0x05,
+	0x59, 0x61, 0x37, 0x6d, 0xbb, 0xec, 0x5f, 0x8f, 0x7e, 0x95, 0x80, 0x03, 0x4d, 0xaf, 0xd0, 0xb7,
+	0xef, 0xc2, 0x64, 0x41, 0x2f, 0xeb, 0x56, 0x91, 0x61, 0x49, 0x1f, 0x6c, 0x59, 0x76, 0xa2, 0xe6,
+	0xce, 0x62, 0xcd, 0x9d, 0xe8, 0x22, 0x43, 0x42, 0x05, 0xe7, 0x1b, 0xa0, 0x36, 0x80, 0x20, 0x21,
+	0x6f, 0xb0, 0x82, 0x9b, 0x4c, 0x0c, 0xc9, 0xdc, 0x94, 0xb0, 0xb1, 0xca, 0x0a, 0x2e, 0x7d, 0x15,
+	0xa0, 0x62, 0x5a, 0x6e, 0x9e, 0x55, 0xed, 0xe2, 0x06, 0xb6, 0xf8, 0x47, 0xe3, 0xc8, 0xfe, 0x9a,
+	0x69, 0xb9, 0x6b, 0xde, 0xc2, 0x30, 0xc1, 0x53, 0x15, 0xff, 0xa9, 0x6a, 0xc2, 0x91, 0x68, 0x9f,
+	0x2c, 0xd9, 0xf4, 0x0c, 0x0d, 0x78, 0x7f, 0x56, 0x9f, 0x10, 0x38, 0xda, 0xc6, 0x16, 0x86, 0xee,
+	0x32, 0x8c, 0x7b, 0x44, 0xfa, 0x7b, 0xb1, 0x1a, 0xe7, 0x58, 0x20, 0x1b, 0xc9, 0x47, 0x21, 0x4b,
+	0xef, 0x46, 0x37, 0xdd, 0xc1, 0x87, 0x03, 0xb7, 0x5b, 0x86, 0x97, 0xe9, 0xac, 0x6d, 0xd5, 0x78,
+	0x96, 0x59, 0xec, 0xae, 0x59, 0x34, 0x75, 0xe7, 0xe1, 0x80, 0x99, 0x7b, 0x8f, 0xc0, 0xe1, 0x18,
+	0x3b, 0xc8, 0xda, 0x75, 0xd8, 0x57, 0x08, 0x1e, 0x77, 0x6d, 0x8a, 0x86, 0x84, 0x42, 0xdd, 0xa3,
+	0xc3, 0x8a, 0x66, 0xd5, 0x64, 0x96, 0xdb, 0x7d, 0xf7, 0x58, 0x17, 0xf1, 0x31, 0xbf, 0x10, 0xdd,
+	0x7b, 0xb2, 0x35, 0xa3, 0xc4, 0x5c, 0xbf, 0x76, 0x7f, 0x9e, 0x00, 0xa5, 0xd5, 0x5b, 0xf4, 0xe6,
+	0x2a, 0x4c, 0x3c, 0x30, 0x2d, 0xc3, 0x7e, 0x80, 0x7b, 0xd3, 0x5c, 0x5c, 0x12, 0x48, 0xb9, 0xdb,
+	0x62, 0x6d, 0x38, 0x0d, 0x50, 0x9c, 0x16, 0x60, 0xb4, 0xa8, 0x57, 0x87, 0x96, 0x05, 0x9e, 0x72,
+	0x6a, 0xc1, 0x94, 0x6c, 0xb6, 0x4c, 0xab, 0x94, 0x1c, 0x1d, 0x92, 0xa5, 0xc0, 0x84, 0x7a, 0x17,
+	0x73, 0xee, 0xb5, 0x9a, 0xee, 0xe8, 0x96, 0x6b, 0x5a, 0xcc, 0xb8, 0xa9, 0x9b, 0x4e, 0xbd, 0x5a,
+	0xa3, 0xd7, 0x34, 0xd2, 0xef, 0x35, 0x4d, 0xfd, 0xb5, 0x9f, 0x74, 0xcd, 0x86, 0x30, 0x4c, 0x79,
+	0x98, 0xd9, 0x0c, 0xde, 0xe5, 0xab, 0xde, 0x4b, 0x2c, 0xdb, 0xe3, 0x71, 0x11, 0x6b, 0x50, 0x16,
+	0x0e, 0xda, 0xf4, 0x66, 0x83, 0xa1, 0xc1, 0x5d, 0xc0, 0xfe, 0x91, 0xc0, 0x59, 0xda, 0xeb, 0x96,
+	0x07, 0xa8, 0xce, 0xd5, 0x65, 0x00, 0xee, 0xea, 0x8e, 0x9b, 0x77, 0xcd, 0x0a, 0x43, 0xae, 0x94,
+	0x8c, 0x1c, 0xfc, 0x65, 0xfc, 0xc1, 0x5f, 0xe6, 0x96, 0x3f, 0x19, 0xcc, 0xee, 0xf2, 0xd0, 0x3e,
+	0xfa, 0x28, 0x4d, 0x72, 0x53, 0x42, 0xce, 0x7b, 0x43, 0x2f, 0xc2, 0x2e, 0x66, 0x19, 0x52, 0x45,
+	0xa2, 0x07, 0x15, 0x93, 0xcc, 0x32, 0x50, 0x41, 0xe3, 0xfc, 0x64, 0x74, 0xa7, 0x23, 0xb7, 0xb1,
+	0x9d, 0x5c, 0xed, 0xc7, 0x07, 0x70, 0xb5, 0x7f, 0x4c, 0x60, 0x36, 0xca, 0x38, 0x26, 0xcd, 0x3a,
+	0x4c, 0xd6, 0xe4, 0x23, 0x4c, 0x95, 0x4c, 0xb7, 0xad, 0x94, 0xd4, 0x14, 0x69, 0xa3, 0x50, 0xd3,
+	0xe0, 0x12, 0xe5, 0x45, 0x3c, 0xa2, 0x5a, 0xb6, 0x71, 0x71, 0x8d, 0xf3, 0xdb, 0x63, 0xa0, 0xb6,
+	0x93, 0x0a, 0x3a, 0xe6, 0xff, 0x9f, 0xdb, 0x7d, 0xd0, 0x78, 0x8f, 0x0e, 0xba, 0xf1, 0x1e, 0x1b,
+	0x60, 0xe3, 0x7d, 0x03, 0xf6, 0x7a, 0x75, 0x95, 0x0f, 0x76, 0x58, 0x99, 0x9c, 0x07, 0x9b, 0x2a,
+	0x6c, 0x15, 0xa7, 0xf3, 0xb2, 0xc0, 0x7e, 0xe2, 0x15, 0xd8, 0x1e, 0x4f, 0x34, 0xe7, 0x4b, 0x7a,
+	0x23, 0x81, 0x82, 0x77, 0x7e, 0xe6, 0x19, 0x77, 0xcd, 0x8a, 0xee, 0xb2, 0xe4, 0xc4, 0x70, 0x47,
+	0x02, 0xc2, 0xda, 0x1a, 0x1a, 0x53, 0xef, 0xe3, 0x0c, 0x28, 0x32, 0x91, 0xf4, 0xda, 0xdf, 0x5a,
+	0xa5, 0x32, 0xf0, 0x96, 0x21, 0x54, 0x89, 0xbf, 0x21, 0x30, 0xd7, 0xde, 0x70, 0xfd, 0xd4, 0x8d,
+	0x5c, 0x08, 0xe6, 0xdb, 0x65, 0x44, 0x20, 0xde, 0xe2, 0x36, 0x70, 0xa7, 0xc5, 0x6d, 0x40, 0xeb,
+	0xfa, 0x36, 0xd0, 0xac, 0x36, 0x7c, 0x1f, 0x58, 0xc0, 0xa6, 0x5f, 0xa4, 0xe1, 0x1a, 0x2f, 0x3a,
+	0xf6, 0x83, 0xb8, 0xb2, 0xfc, 0x21, 0x81, 0x64, 0xf3, 0x5a, 0x74, 0xd6, 0x82, 0x09, 0xbd, 0x62,
+	0xd7, 0x2c, 0x77, 0xc8, 0xb3, 0x29, 0xb4, 0xb2, 0xfc, 0xb1, 0x02, 0xe3, 0x02, 0x0c, 0xfd, 0x01,
+	0x81, 0x09, 0xf9, 0x69, 0x86, 0x9e, 0x8c, 0x3f, 0x25, 0x1b, 0xbf, 0x06, 0x29, 0x8b, 0x5d, 0xad,
+	0x95, 0xde, 0xa9, 0xf3, 0xdf, 0xfb, 0xed, 0xc7, 0x3f, 0x4e, 0x1c, 0xa1, 0x29, 0xad, 0xed, 0x47,
+	0x2a, 0xfa, 0x57, 0x02, 0x33, 0x4d, 0x23, 0x57, 0xfa, 0xc5, 0xb6, 0xa6, 0x62, 0x3e, 0x1c, 0x29,
+	0x67, 0x7b, 0x94, 0x42, 0xa8, 0xc6, 0x1b, 0x1e, 0x4f, 0x02, 0xef, 0xb7, 0xe8, 0xed, 0x38, 0xbc,
+	0x41, 0x06, 0x68, 0x5b, 0xd1, 0xdd, 0x6f, 0x5b, 0x6b, 0x1e, 0x0b, 0x6b, 0x5b, 0xd1, 0x12, 0xda,
+	0xa6, 0x9f, 0x10, 0x50, 0xe2, 0x3f, 0x05, 0xd0, 0x97, 0xdb, 0x62, 0xef, 0xf8, 0x05, 0x44, 0xb9,
+	0xd8, 0xb7, 0x3c, 0xb2, 0x70, 0x2d, 0x60, 0xe1, 0x2b, 0xf4, 0x25, 0xad, 0xcd, 0x57, 0xc3, 0x4e,
+	0x9e, 0x7e, 0x4a, 0x40, 0x89, 0x1f, 0xa7, 0x77, 0xf0, 0xb4, 0xe3, 0x57, 0x04, 0xe5, 0x62, 0xdf,
+	0xf2, 0xe8, 0xe9, 0x7a, 0xe0, 0xe9, 0x35, 0x7a, 0x65, 0x30, 0xf1, 0xa6, 0xff, 0x24, 0x70, 0x20,
+	0x66, 0x36, 0x4d, 0x5f, 0xea, 0x31, 0x2f, 0xc3, 0xa3, 0x49, 0x65, 0xa5, 0x3f, 0x61, 0xf4, 0xf5,
+	0x8e, 0x70, 0xf3, 0x16, 0xcd, 0xc5, 0xb9, 0x59, 0x0f, 0x5e, 0x53, 0x20, 0x19, 0xe7, 0xdb, 0x1a,
+	0xce, 0x10, 0x1b, 0x29, 0xf0, 0xde, 0xd1, 0xbf, 0x13, 0x38, 0xd4, 0x6e, 0xbc, 0x49, 0x5f, 0xe9,
+	0x09, 0x7a, 0x8b, 0xb9, 0xac, 0x72, 0x69, 0x07, 0x1a, 0x90, 0x81, 0x2b, 0x82, 0x81, 0x57, 0xe8,
+	0xcb, 0x3b, 0x63, 0x80, 0x3e, 0x6d, 0xe1, 0x6d, 0x78, 0x2c, 0xd9, 0xa3, 0xb7, 0x2d, 0xc6, 0xa1,
+	0xca, 0xa5, 0x1d, 0x68, 0x40, 0x6f, 0xcf, 0x07, 0xb9, 0x9d, 0xa1, 0xa7, 0xe2, 0x5c, 0x66, 0x96,
+	0xeb, 0x98, 0x8c, 0x6b, 0x5b, 0xa6, 0xb1, 0xad, 0xe1, 0x20, 0x90, 0xbe, 0x49, 0xe0, 0x73, 0xe1,
+	0x79, 0x18, 0x3d, 0xdd, 0x11, 0x4e, 0xc3, 0xbc, 0x50, 0x39, 0xd3, 0x83, 0x04, 0x02, 0x3e, 0x19,
+	0x00, 0x4e, 0xd3, 0xc3, 0x71, 0x80, 0xb9, 0x00, 0xf4, 0x26, 0x01, 0x08, 0x46, 0x6d, 0x34, 0xd3,
+	0xd6, 0x5a, 0xd3, 0xb8, 0x4e, 0xd1, 0xba, 0x5e, 0x8f, 0xd8, 0x4e, 0x07, 0xd8, 0xbe, 0x40, 0x8f,
+	0xc5, 0x61, 0xc3, 0xcf, 0x1d, 0x55, 0x0f, 0xd2, 0x1f, 0x09, 0xcc, 0xb6, 0x9a, 0x2d, 0xd1, 0x73,
+	0xdd, 0x6d, 0xcf, 0xcd, 0xa3, 0x2f, 0xe5, 0x7c, 0x1f, 0x92, 0x88, 0xff, 0x66, 0x80, 0x7f, 0x8d,
+	0x5e, 0xde, 0x51, 0xfe, 0xe7, 0xe5, 0x54, 0xeb, 0x77, 0x04, 0xa6, 0x1b, 0x27, 0x40, 0x1d, 0x0e,
+	0xeb, 0x98, 0xc1, 0x94, 0x72, 0xb6, 0x47, 0x29, 0xf4, 0xe9, 0xf5, 0xc0, 0xa7, 0x1b, 0xf4, 0x5a,
+	0x9f, 0x3e, 0xc9, 0x26, 0x3c, 0x34, 0x79, 0xa2, 0x6f, 0x13, 0xd8, 0x13, 0x99, 0x04, 0xd1, 0xae,
+	0x72, 0x39, 0x32, 0x53, 0x52, 0x96, 0x7b, 0x11, 0x41, 0x7f, 0x16, 0x03, 0x7f, 0xda, 0x34, 0x4b,
+	0x05, 0x89, 0xe9, 0x67, 0x04, 0xa6, 0x1b, 0x67, 0x21, 0x1d, 0xe8, 0x8f, 0x99, 0xd1, 0x28, 0x67,
+	0x7b, 0x94, 0x42, 0xb8, 0x5f, 0x0a, 0xe0, 0x2e, 0xd2, 0x05, 0x2d, 0xf6, 0x0f, 0xb9, 0x1a, 0x66,
+	0x32, 0xf4, 0x47, 0x04, 0x26, 0xf1, 0x1e, 0x4e, 0xdb, 0xf7, 0x91, 0xd1, 0xf9, 0x88, 0x72, 0xaa,
+	0xbb, 0xc5, 0x08, 0xef, 0x54, 0x00, 0xef, 0x28, 0x4d, 0xc7, 0xc1, 0xf3, 0xef, 0xec, 0xef, 0x13,
+	0xf8, 0x7c, 0xcb, 0x5d, 0x95, 0x9e, 0xef, 0x7d, 0x27, 0xf6, 0x01, 0x5f, 0xe8, 0x47, 0x14, 0xe1,
+	0x9f, 0x09, 0xe0, 0xcf, 0xd3, 0xb9, 0x6e, 0x76, 0x6f, 0xfa, 0x67, 0x02, 0x07, 0x62, 0xae, 0x55,
+	0x1d, 0xfa, 0x8e, 0xf6, 0xb7, 0x40, 0x65, 0xa5, 0x3f, 0x61, 0xf4, 0xe4, 0xd5, 0xc0, 0x93, 0xfe,
+	0x8f, 0x5e, 0x8e, 0x7e, 0xbc, 0x43, 0x60, 0x77, 0xe8, 0x06, 0x45, 0xdb, 0x6f, 0xe4, 0xcd, 0xf7,
+	0x32, 0xe5, 0x74, 0xf7, 0x02, 0x88, 0xff, 0x5c, 0x80, 0x7f, 0x89, 0x2e, 0x76, 0x75, 0x8e, 0x32,
+	0xa1, 0x21, 0xbb, 0xf2, 0xc1, 0xb3, 0x14, 0x79, 0xf2, 0x2c, 0x45, 0xfe, 0xf4, 0x2c, 0x45, 0x1e,
+	0x3d, 0x4f, 0x8d, 0x3c, 0x79, 0x9e, 0x1a, 0xf9, 0xfd, 0xf3, 0xd4, 0xc8, 0x1d, 0x35, 0x74, 0x75,
+	0x93, 0x0a, 0xd9, 0xfd, 0x4a, 0x5d, 0xa7, 0xb8, 0xba, 0x15, 0x26, 0xc4, 0x34, 0xe1, 0xc5, 0xff,
+	0x0c, 0x00, 0x7d, 0x08, 0x81, 0x72, 0x23, 0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the params of the locking module
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// LockedDelegation queries locked delegatation info for given validator
+	// delegator pair
+	LockedDelegations(ctx context.Context, in *QueryLockedDelegationRequest, opts ...grpc.CallOption) (*QueryLockedDelegationResponse, error)
+	// DelegatorLockedDelegations queries all locked delegations of a given
+	// delegator address
+	DelegatorLockedDelegations(ctx context.Context, in *QueryDelegatorLockedDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorLockedDelegationsResponse, error)
+	// ValidatorLockedDelegations queries all locked delegations on a given
+	// validator address
+	ValidatorLockedDelegations(ctx context.Context, in *QueryValidatorLockedDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorLockedDelegationsResponse, error)
+	// LockedDelegationRewards queries the total rewards accrued by locked
+	// delegations
+	LockedDelegationRewards(ctx context.Context, in *QueryLockedDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryLockedDelegationRewardsResponse, error)
+	// LockedDelegationTotalRewards queries the total locked delegation rewards
+	// accrued by a each validator
+	LockedDelegationTotalRewards(ctx context.Context, in *QueryLockedDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryLockedDelegationTotalRewardsResponse, error)
+	// LockedDelegationEntrySlashes queries the token value of a locked delegation
+	// entry and its value before and after each slash of its validator
+	LockedDelegationEntrySlashes(ctx context.Context, in *QueryLockedDelegationEntrySlashesRequest, opts ...grpc.CallOption) (*QueryLockedDelegationEntrySlashesResponse, error)
+	// LockingStats queries the module wide and per validator locked totals
+	LockingStats(ctx context.Context, in *QueryLockingStatsRequest, opts ...grpc.CallOption) (*QueryLockingStatsResponse, error)
+	// RewardPool queries the reward pool balance and the outstanding debt
+	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
+	// DelegatorRewardDebts queries the locking rewards owed to a delegator
+	DelegatorRewardDebts(ctx context.Context, in *QueryDelegatorRewardDebtsRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardDebtsResponse, error)
+	// BonusBeneficiary queries the address receiving the locking rewards of a
+	// delegator
+	BonusBeneficiary(ctx context.Context, in *QueryBonusBeneficiaryRequest, opts ...grpc.CallOption) (*QueryBonusBeneficiaryResponse, error)
+	// LockingBudget queries the locking rewards budget consumed on the current
+	// window
+	LockingBudget(ctx context.Context, in *QueryLockingBudgetRequest, opts ...grpc.CallOption) (*QueryLockingBudgetResponse, error)
+	// QuarantinedPairs queries the locked delegation pairs whose expired entries
+	// couldn't be completed
+	QuarantinedPairs(ctx context.Context, in *QueryQuarantinedPairsRequest, opts ...grpc.CallOption) (*QueryQuarantinedPairsResponse, error)
+	// Unlocks queries the locked delegation entries unlocking between two
+	// timestamps, optionally filtered by delegator or validator
+	Unlocks(ctx context.Context, in *QueryUnlocksRequest, opts ...grpc.CallOption) (*QueryUnlocksResponse, error)
+	// LockedDelegationEntry queries a locked delegation entry by its id with its
+	// pair, token value, time remaining and accrued bonus estimate
+	LockedDelegationEntry(ctx context.Context, in *QueryLockedDelegationEntryRequest, opts ...grpc.CallOption) (*QueryLockedDelegationEntryResponse, error)
+	// DelegatorLockingSummary queries the delegated, locked and free amounts,
+	// reward ratios, pending rewards and next unlock of a delegator, per
+	// validator and in total
+	DelegatorLockingSummary(ctx context.Context, in *QueryDelegatorLockingSummaryRequest, opts ...grpc.CallOption) (*QueryDelegatorLockingSummaryResponse, error)
+	// EntryEscrow queries the locking rewards held in escrow for a locked
+	// delegation entry with the at maturity payout mode
+	EntryEscrow(ctx context.Context, in *QueryEntryEscrowRequest, opts ...grpc.CallOption) (*QueryEntryEscrowResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) LockedDelegations(ctx context.Context, in *QueryLockedDelegationRequest, opts ...grpc.CallOption) (*QueryLockedDelegationResponse, error) {
+	out := new(QueryLockedDelegationResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/LockedDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) DelegatorLockedDelegations(ctx context.Context, in *QueryDelegatorLockedDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorLockedDelegationsResponse, error) {
+	out := new(QueryDelegatorLockedDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/DelegatorLockedDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryLockedDelegationRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ValidatorLockedDelegations(ctx context.Context, in *QueryValidatorLockedDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorLockedDelegationsResponse, error) {
+	out := new(QueryValidatorLockedDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/ValidatorLockedDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.DistributionReward) > 0 {
-		for _, e := range m.DistributionReward {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) LockedDelegationRewards(ctx context.Context, in *QueryLockedDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryLockedDelegationRewardsResponse, error) {
+	out := new(QueryLockedDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/LockedDelegationRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockedDelegationTotalRewards(ctx context.Context, in *QueryLockedDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryLockedDelegationTotalRewardsResponse, error) {
+	out := new(QueryLockedDelegationTotalRewardsResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/LockedDelegationTotalRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockedDelegationEntrySlashes(ctx context.Context, in *QueryLockedDelegationEntrySlashesRequest, opts ...grpc.CallOption) (*QueryLockedDelegationEntrySlashesResponse, error) {
+	out := new(QueryLockedDelegationEntrySlashesResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/LockedDelegationEntrySlashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockingStats(ctx context.Context, in *QueryLockingStatsRequest, opts ...grpc.CallOption) (*QueryLockingStatsResponse, error) {
+	out := new(QueryLockingStatsResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/LockingStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error) {
+	out := new(QueryRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/RewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorRewardDebts(ctx context.Context, in *QueryDelegatorRewardDebtsRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardDebtsResponse, error) {
+	out := new(QueryDelegatorRewardDebtsResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/DelegatorRewardDebts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BonusBeneficiary(ctx context.Context, in *QueryBonusBeneficiaryRequest, opts ...grpc.CallOption) (*QueryBonusBeneficiaryResponse, error) {
+	out := new(QueryBonusBeneficiaryResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/BonusBeneficiary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockingBudget(ctx context.Context, in *QueryLockingBudgetRequest, opts ...grpc.CallOption) (*QueryLockingBudgetResponse, error) {
+	out := new(QueryLockingBudgetResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/LockingBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuarantinedPairs(ctx context.Context, in *QueryQuarantinedPairsRequest, opts ...grpc.CallOption) (*QueryQuarantinedPairsResponse, error) {
+	out := new(QueryQuarantinedPairsResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/QuarantinedPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Unlocks(ctx context.Context, in *QueryUnlocksRequest, opts ...grpc.CallOption) (*QueryUnlocksResponse, error) {
+	out := new(QueryUnlocksResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/Unlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockedDelegationEntry(ctx context.Context, in *QueryLockedDelegationEntryRequest, opts ...grpc.CallOption) (*QueryLockedDelegationEntryResponse, error) {
+	out := new(QueryLockedDelegationEntryResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/LockedDelegationEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorLockingSummary(ctx context.Context, in *QueryDelegatorLockingSummaryRequest, opts ...grpc.CallOption) (*QueryDelegatorLockingSummaryResponse, error) {
+	out := new(QueryDelegatorLockingSummaryResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/DelegatorLockingSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntryEscrow(ctx context.Context, in *QueryEntryEscrowRequest, opts ...grpc.CallOption) (*QueryEntryEscrowResponse, error) {
+	out := new(QueryEntryEscrowResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Query/EntryEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the params of the locking module
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// LockedDelegation queries locked delegatation info for given validator
+	// delegator pair
+	LockedDelegations(context.Context, *QueryLockedDelegationRequest) (*QueryLockedDelegationResponse, error)
+	// DelegatorLockedDelegations queries all locked delegations of a given
+	// delegator address
+	DelegatorLockedDelegations(context.Context, *QueryDelegatorLockedDelegationsRequest) (*QueryDelegatorLockedDelegationsResponse, error)
+	// ValidatorLockedDelegations queries all locked delegations on a given
+	// validator address
+	ValidatorLockedDelegations(context.Context, *QueryValidatorLockedDelegationsRequest) (*QueryValidatorLockedDelegationsResponse, error)
+	// LockedDelegationRewards queries the total rewards accrued by locked
+	// delegations
+	LockedDelegationRewards(context.Context, *QueryLockedDelegationRewardsRequest) (*QueryLockedDelegationRewardsResponse, error)
+	// LockedDelegationTotalRewards queries the total locked delegation rewards
+	// accrued by a each validator
+	LockedDelegationTotalRewards(context.Context, *QueryLockedDelegationTotalRewardsRequest) (*QueryLockedDelegationTotalRewardsResponse, error)
+	// LockedDelegationEntrySlashes queries the token value of a locked delegation
+	// entry and its value before and after each slash of its validator
+	LockedDelegationEntrySlashes(context.Context, *QueryLockedDelegationEntrySlashesRequest) (*QueryLockedDelegationEntrySlashesResponse, error)
+	// LockingStats queries the module wide and per validator locked totals
+	LockingStats(context.Context, *QueryLockingStatsRequest) (*QueryLockingStatsResponse, error)
+	// RewardPool queries the reward pool balance and the outstanding debt
+	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
+	// DelegatorRewardDebts queries the locking rewards owed to a delegator
+	DelegatorRewardDebts(context.Context, *QueryDelegatorRewardDebtsRequest) (*QueryDelegatorRewardDebtsResponse, error)
+	// BonusBeneficiary queries the address receiving the locking rewards of a
+	// delegator
+	BonusBeneficiary(context.Context, *QueryBonusBeneficiaryRequest) (*QueryBonusBeneficiaryResponse, error)
+	// LockingBudget queries the locking rewards budget consumed on the current
+	// window
+	LockingBudget(context.Context, *QueryLockingBudgetRequest) (*QueryLockingBudgetResponse, error)
+	// QuarantinedPairs queries the locked delegation pairs whose expired entries
+	// couldn't be completed
+	QuarantinedPairs(context.Context, *QueryQuarantinedPairsRequest) (*QueryQuarantinedPairsResponse, error)
+	// Unlocks queries the locked delegation entries unlocking between two
+	// timestamps, optionally filtered by delegator or validator
+	Unlocks(context.Context, *QueryUnlocksRequest) (*QueryUnlocksResponse, error)
+	// LockedDelegationEntry queries a locked delegation entry by its id with its
+	// pair, token value, time remaining and accrued bonus estimate
+	LockedDelegationEntry(context.Context, *QueryLockedDelegationEntryRequest) (*QueryLockedDelegationEntryResponse, error)
+	// DelegatorLockingSummary queries the delegated, locked and free amounts,
+	// reward ratios, pending rewards and next unlock of a delegator, per
+	// validator and in total
+	DelegatorLockingSummary(context.Context, *QueryDelegatorLockingSummaryRequest) (*QueryDelegatorLockingSummaryResponse, error)
+	// EntryEscrow queries the locking rewards held in escrow for a locked
+	// delegation entry with the at maturity payout mode
+	EntryEscrow(context.Context, *QueryEntryEscrowRequest) (*QueryEntryEscrowResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) LockedDelegations(ctx context.Context, req *QueryLockedDelegationRequest) (*QueryLockedDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedDelegations not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorLockedDelegations(ctx context.Context, req *QueryDelegatorLockedDelegationsRequest) (*QueryDelegatorLockedDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorLockedDelegations not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorLockedDelegations(ctx context.Context, req *QueryValidatorLockedDelegationsRequest) (*QueryValidatorLockedDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorLockedDelegations not implemented")
+}
+func (*UnimplementedQueryServer) LockedDelegationRewards(ctx context.Context, req *QueryLockedDelegationRewardsRequest) (*QueryLockedDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedDelegationRewards not implemented")
+}
+func (*UnimplementedQueryServer) LockedDelegationTotalRewards(ctx context.Context, req *QueryLockedDelegationTotalRewardsRequest) (*QueryLockedDelegationTotalRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedDelegationTotalRewards not implemented")
+}
+func (*UnimplementedQueryServer) LockedDelegationEntrySlashes(ctx context.Context, req *QueryLockedDelegationEntrySlashesRequest) (*QueryLockedDelegationEntrySlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedDelegationEntrySlashes not implemented")
+}
+func (*UnimplementedQueryServer) LockingStats(ctx context.Context, req *QueryLockingStatsRequest) (*QueryLockingStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockingStats not implemented")
+}
+func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorRewardDebts(ctx context.Context, req *QueryDelegatorRewardDebtsRequest) (*QueryDelegatorRewardDebtsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorRewardDebts not implemented")
+}
+func (*UnimplementedQueryServer) BonusBeneficiary(ctx context.Context, req *QueryBonusBeneficiaryRequest) (*QueryBonusBeneficiaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BonusBeneficiary not implemented")
+}
+func (*UnimplementedQueryServer) LockingBudget(ctx context.Context, req *QueryLockingBudgetRequest) (*QueryLockingBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockingBudget not implemented")
+}
+func (*UnimplementedQueryServer) QuarantinedPairs(ctx context.Context, req *QueryQuarantinedPairsRequest) (*QueryQuarantinedPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuarantinedPairs not implemented")
+}
+func (*UnimplementedQueryServer) Unlocks(ctx context.Context, req *QueryUnlocksRequest) (*QueryUnlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlocks not implemented")
+}
+func (*UnimplementedQueryServer) LockedDelegationEntry(ctx context.Context, req *QueryLockedDelegationEntryRequest) (*QueryLockedDelegationEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedDelegationEntry not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorLockingSummary(ctx context.Context, req *QueryDelegatorLockingSummaryRequest) (*QueryDelegatorLockingSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorLockingSummary not implemented")
+}
+func (*UnimplementedQueryServer) EntryEscrow(ctx context.Context, req *QueryEntryEscrowRequest) (*QueryEntryEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntryEscrow not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockedDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/LockedDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedDelegations(ctx, req.(*QueryLockedDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorLockedDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorLockedDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorLockedDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/DelegatorLockedDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorLockedDelegations(ctx, req.(*QueryDelegatorLockedDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorLockedDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorLockedDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorLockedDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/ValidatorLockedDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorLockedDelegations(ctx, req.(*QueryValidatorLockedDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockedDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedDelegationRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedDelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/LockedDelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedDelegationRewards(ctx, req.(*QueryLockedDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockedDelegationTotalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedDelegationTotalRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedDelegationTotalRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/LockedDelegationTotalRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedDelegationTotalRewards(ctx, req.(*QueryLockedDelegationTotalRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockedDelegationEntrySlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedDelegationEntrySlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedDelegationEntrySlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/LockedDelegationEntrySlashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedDelegationEntrySlashes(ctx, req.(*QueryLockedDelegationEntrySlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockingStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockingStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/LockingStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockingStats(ctx, req.(*QueryLockingStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/RewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPool(ctx, req.(*QueryRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorRewardDebts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorRewardDebtsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorRewardDebts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/DelegatorRewardDebts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorRewardDebts(ctx, req.(*QueryDelegatorRewardDebtsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BonusBeneficiary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBonusBeneficiaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BonusBeneficiary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/BonusBeneficiary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BonusBeneficiary(ctx, req.(*QueryBonusBeneficiaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockingBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockingBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockingBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/LockingBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockingBudget(ctx, req.(*QueryLockingBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuarantinedPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuarantinedPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuarantinedPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/QuarantinedPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuarantinedPairs(ctx, req.(*QueryQuarantinedPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Unlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Unlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/Unlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Unlocks(ctx, req.(*QueryUnlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockedDelegationEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedDelegationEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedDelegationEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/LockedDelegationEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedDelegationEntry(ctx, req.(*QueryLockedDelegationEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorLockingSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorLockingSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorLockingSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/DelegatorLockingSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorLockingSummary(ctx, req.(*QueryDelegatorLockingSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntryEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntryEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntryEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Query/EntryEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntryEscrow(ctx, req.(*QueryEntryEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "aether.locking.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "LockedDelegations",
+			Handler:    _Query_LockedDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatorLockedDelegations",
+			Handler:    _Query_DelegatorLockedDelegations_Handler,
+		},
+		{
+			MethodName: "ValidatorLockedDelegations",
+			Handler:    _Query_ValidatorLockedDelegations_Handler,
+		},
+		{
+			MethodName: "LockedDelegationRewards",
+			Handler:    _Query_LockedDelegationRewards_Handler,
+		},
+		{
+			MethodName: "LockedDelegationTotalRewards",
+			Handler:    _Query_LockedDelegationTotalRewards_Handler,
+		},
+		{
+			MethodName: "LockedDelegationEntrySlashes",
+			Handler:    _Query_LockedDelegationEntrySlashes_Handler,
+		},
+		{
+			MethodName: "LockingStats",
+			Handler:    _Query_LockingStats_Handler,
+		},
+		{
+			MethodName: "RewardPool",
+			Handler:    _Query_RewardPool_Handler,
+		},
+		{
+			MethodName: "DelegatorRewardDebts",
+			Handler:    _Query_DelegatorRewardDebts_Handler,
+		},
+		{
+			MethodName: "BonusBeneficiary",
+			Handler:    _Query_BonusBeneficiary_Handler,
+		},
+		{
+			MethodName: "LockingBudget",
+			Handler:    _Query_LockingBudget_Handler,
+		},
+		{
+			MethodName: "QuarantinedPairs",
+			Handler:    _Query_QuarantinedPairs_Handler,
+		},
+		{
+			MethodName: "Unlocks",
+			Handler:    _Query_Unlocks_Handler,
+		},
+		{
+			MethodName: "LockedDelegationEntry",
+			Handler:    _Query_LockedDelegationEntry_Handler,
+		},
+		{
+			MethodName: "DelegatorLockingSummary",
+			Handler:    _Query_DelegatorLockingSummary_Handler,
+		},
+		{
+			MethodName: "EntryEscrow",
+			Handler:    _Query_EntryEscrow_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aether/locking/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.LockedDelegations) > 0 {
+		for iNdEx := len(m.LockedDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorLockedDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorLockedDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorLockedDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorLockedDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorLockedDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorLockedDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LockedDelegations) > 0 {
+		for iNdEx := len(m.LockedDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorLockedDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorLockedDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorLockedDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorLockedDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorLockedDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorLockedDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LockedDelegations) > 0 {
+		for iNdEx := len(m.LockedDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockingRewardRemainder) > 0 {
+		for iNdEx := len(m.LockingRewardRemainder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockingRewardRemainder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LockingReward) > 0 {
+		for iNdEx := len(m.LockingReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockingReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DistributionReward) > 0 {
+		for iNdEx := len(m.DistributionReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedDelegationTotalRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedDelegationTotalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedDelegationTotalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedDelegationTotalRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedDelegationTotalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedDelegationTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedDelegationEntrySlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedDelegationEntrySlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedDelegationEntrySlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedDelegationEntrySlashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedDelegationEntrySlashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedDelegationEntrySlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockingStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockingStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockingStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockingStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockingStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockingStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TotalDebt) > 0 {
+		for iNdEx := len(m.TotalDebt) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDebt[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorRewardDebtsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRewardDebtsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRewardDebtsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorRewardDebtsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRewardDebtsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRewardDebtsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Debts) > 0 {
+		for iNdEx := len(m.Debts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Debts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBonusBeneficiaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBonusBeneficiaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBonusBeneficiaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBonusBeneficiaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBonusBeneficiaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBonusBeneficiaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BeneficiaryAddress) > 0 {
+		i -= len(m.BeneficiaryAddress)
+		copy(dAtA[i:], m.BeneficiaryAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BeneficiaryAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockingBudgetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockingBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockingBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockingBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockingBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockingBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Cap) > 0 {
+		for iNdEx := len(m.Cap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuarantinedPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuarantinedPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuarantinedPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuarantinedPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuarantinedPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuarantinedPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QuarantinedPairs) > 0 {
+		for iNdEx := len(m.QuarantinedPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuarantinedPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintQuery(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x12
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintQuery(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Unlocks) > 0 {
+		for iNdEx := len(m.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedDelegationEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedDelegationEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedDelegationEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedDelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedDelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedDelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BonusEstimate) > 0 {
+		for iNdEx := len(m.BonusEstimate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BonusEstimate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeRemaining, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeRemaining):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintQuery(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorLockingSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorLockingSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorLockingSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorLockingSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorLockingSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorLockingSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLockedDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockedDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockedDelegations) > 0 {
+		for _, e := range m.LockedDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorLockedDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorLockedDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockedDelegations) > 0 {
+		for _, e := range m.LockedDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorLockedDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorLockedDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockedDelegations) > 0 {
+		for _, e := range m.LockedDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockedDelegationRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockedDelegationRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DistributionReward) > 0 {
+		for _, e := range m.DistributionReward {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.LockingReward) > 0 {
+		for _, e := range m.LockingReward {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.LockingRewardRemainder) > 0 {
+		for _, e := range m.LockingRewardRemainder {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLockedDelegationTotalRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockedDelegationTotalRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLockedDelegationEntrySlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryLockedDelegationEntrySlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Entry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Tokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLockingStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockingStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalDebt) > 0 {
+		for _, e := range m.TotalDebt {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.MintEpoch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorRewardDebtsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorRewardDebtsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Debts) > 0 {
+		for _, e := range m.Debts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBonusBeneficiaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBonusBeneficiaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BeneficiaryAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockingBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLockingBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Window.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Cap) > 0 {
+		for _, e := range m.Cap {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryQuarantinedPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuarantinedPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QuarantinedPairs) > 0 {
+		for _, e := range m.QuarantinedPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unlocks) > 0 {
+		for _, e := range m.Unlocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockedDelegationEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryLockedDelegationEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Entry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Tokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeRemaining)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.BonusEstimate) > 0 {
+		for _, e := range m.BonusEstimate {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorLockingSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorLockingSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEntryEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryEntryEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedDelegations = append(m.LockedDelegations, LockedDelegationWithTotalShares{})
+			if err := m.LockedDelegations[len(m.LockedDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorLockedDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorLockedDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorLockedDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorLockedDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorLockedDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorLockedDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedDelegations = append(m.LockedDelegations, LockedDelegationWithTotalShares{})
+			if err := m.LockedDelegations[len(m.LockedDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorLockedDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorLockedDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorLockedDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorLockedDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorLockedDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorLockedDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedDelegations = append(m.LockedDelegations, LockedDelegationWithTotalShares{})
+			if err := m.LockedDelegations[len(m.LockedDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDelegationRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDelegationRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionReward = append(m.DistributionReward, types.DecCoin{})
+			if err := m.DistributionReward[len(m.DistributionReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockingReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockingReward = append(m.LockingReward, types.DecCoin{})
+			if err := m.LockingReward[len(m.LockingReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.DecCoin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockingRewardRemainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockingRewardRemainder = append(m.LockingRewardRemainder, types.DecCoin{})
+			if err := m.LockingRewardRemainder[len(m.LockingRewardRemainder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDelegationTotalRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDelegationTotalRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, LockedDelegationDelegatorReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.DecCoin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedDelegationEntrySlashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDelegationEntrySlashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDelegationEntrySlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedDelegationEntrySlashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDelegationEntrySlashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDelegationEntrySlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, LockedDelegationEntrySlash{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockingStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockingStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockingStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockingStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockingStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockingStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorLockingStats{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDebt = append(m.TotalDebt, types.Coin{})
+			if err := m.TotalDebt[len(m.TotalDebt)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorRewardDebtsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorRewardDebtsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorRewardDebtsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorRewardDebtsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorRewardDebtsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorRewardDebtsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Debts = append(m.Debts, RewardDebt{})
+			if err := m.Debts[len(m.Debts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBonusBeneficiaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBonusBeneficiaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBonusBeneficiaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBonusBeneficiaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBonusBeneficiaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBonusBeneficiaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeneficiaryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockingBudgetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.LockingReward) > 0 {
-		for _, e := range m.LockingReward {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockingBudgetRequest: wiretype end group for non-group")
 		}
-	}
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockingBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryLockedDelegationTotalRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryLockedDelegationTotalRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLockingBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockingBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockingBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cap = append(m.Cap, types.Coin{})
+			if err := m.Cap[len(m.Cap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuarantinedPairsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1495,16 +7471,52 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuarantinedPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuarantinedPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1526,7 +7538,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQuarantinedPairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1549,15 +7561,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuarantinedPairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuarantinedPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedPairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1584,7 +7596,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QuarantinedPairs = append(m.QuarantinedPairs, QuarantinedPair{})
+			if err := m.QuarantinedPairs[len(m.QuarantinedPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1609,7 +7658,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockedDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnlocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1632,13 +7681,79 @@ func (m *QueryLockedDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockedDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnlocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockedDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
 			}
@@ -1670,7 +7785,7 @@ func (m *QueryLockedDelegationRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
@@ -1702,7 +7817,7 @@ func (m *QueryLockedDelegationRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1759,7 +7874,7 @@ func (m *QueryLockedDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockedDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnlocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1782,15 +7897,15 @@ func (m *QueryLockedDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockedDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnlocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockedDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1817,16 +7932,16 @@ func (m *QueryLockedDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockedDelegations = append(m.LockedDelegations, LockedDelegationWithTotalShares{})
-			if err := m.LockedDelegations[len(m.LockedDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unlocks = append(m.Unlocks, LockedDelegationUnlock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1836,31 +7951,83 @@ func (m *QueryLockedDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedDelegationEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDelegationEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDelegationEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1870,28 +8037,11 @@ func (m *QueryLockedDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1913,7 +8063,7 @@ func (m *QueryLockedDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorLockedDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLockedDelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1923,28 +8073,60 @@ func (m *QueryDelegatorLockedDelegationsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDelegationEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorLockedDelegationsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorLockedDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1972,11 +8154,11 @@ func (m *QueryDelegatorLockedDelegationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2003,66 +8185,47 @@ func (m *QueryDelegatorLockedDelegationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDelegatorLockedDelegationsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorLockedDelegationsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorLockedDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeRemaining", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2089,14 +8252,13 @@ func (m *QueryDelegatorLockedDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockedDelegations = append(m.LockedDelegations, LockedDelegationWithTotalShares{})
-			if err := m.LockedDelegations[len(m.LockedDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeRemaining, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusEstimate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2123,10 +8285,8 @@ func (m *QueryDelegatorLockedDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BonusEstimate = append(m.BonusEstimate, types.DecCoin{})
+			if err := m.BonusEstimate[len(m.BonusEstimate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2151,7 +8311,7 @@ func (m *QueryDelegatorLockedDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockedDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorLockingSummaryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2174,10 +8334,10 @@ func (m *QueryLockedDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockedDelegationRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorLockingSummaryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockedDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorLockingSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2212,38 +8372,6 @@ func (m *QueryLockedDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2265,7 +8393,7 @@ func (m *QueryLockedDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockedDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorLockingSummaryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2288,15 +8416,15 @@ func (m *QueryLockedDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockedDelegationRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorLockingSummaryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockedDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorLockingSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2323,48 +8451,13 @@ func (m *QueryLockedDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionReward = append(m.DistributionReward, types.DecCoin{})
-			if err := m.DistributionReward[len(m.DistributionReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockingReward", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LockingReward = append(m.LockingReward, types.DecCoin{})
-			if err := m.LockingReward[len(m.LockingReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2391,8 +8484,8 @@ func (m *QueryLockedDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.DecCoin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, ValidatorLockingSummary{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2417,7 +8510,7 @@ func (m *QueryLockedDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockedDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEntryEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2440,17 +8533,17 @@ func (m *QueryLockedDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockedDelegationTotalRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEntryEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockedDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEntryEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2460,24 +8553,11 @@ func (m *QueryLockedDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2499,7 +8579,7 @@ func (m *QueryLockedDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryLockedDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEntryEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2522,49 +8602,15 @@ func (m *QueryLockedDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockedDelegationTotalRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEntryEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockedDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEntryEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Rewards = append(m.Rewards, LockedDelegationDelegatorReward{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2591,8 +8637,8 @@ func (m *QueryLockedDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.DecCoin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
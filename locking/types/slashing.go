@@ -0,0 +1,47 @@
+package types
+
+import (
+	fmt "fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ErrSlashEventFractionInvalid = "%s slash event fraction is invalid: %s"
+	ErrSlashEventHeightInvalid   = "%s slash event height is invalid: %d"
+)
+
+// NewValidatorSlashEvent returns a new ValidatorSlashEvent
+func NewValidatorSlashEvent(
+	valAddr sdk.ValAddress,
+	height int64,
+	time time.Time,
+	fraction math.LegacyDec,
+	tokensPerShareBefore math.LegacyDec,
+	tokensPerShareAfter math.LegacyDec,
+) ValidatorSlashEvent {
+	return ValidatorSlashEvent{
+		ValidatorAddress:     valAddr.String(),
+		Height:               height,
+		Time:                 time,
+		Fraction:             fraction,
+		TokensPerShareBefore: tokensPerShareBefore,
+		TokensPerShareAfter:  tokensPerShareAfter,
+	}
+}
+
+// Validate validates a ValidatorSlashEvent
+func (e ValidatorSlashEvent) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(e.ValidatorAddress); err != nil {
+		return fmt.Errorf(ErrValidatorAddressInvalid, ModuleName, err)
+	}
+	if e.Height < 0 {
+		return fmt.Errorf(ErrSlashEventHeightInvalid, ModuleName, e.Height)
+	}
+	if e.Fraction.IsNil() || e.Fraction.IsNegative() || e.Fraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf(ErrSlashEventFractionInvalid, ModuleName, e.Fraction)
+	}
+	return nil
+}
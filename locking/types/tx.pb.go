@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -37,7 +38,8 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// MsgCreateLockedDelegation defines a SDK message for creating a locked delegation
+// MsgCreateLockedDelegation defines a SDK message for creating a locked
+// delegation
 type MsgCreateLockedDelegation struct {
 	// delegator_address is the target delegator address
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
@@ -47,8 +49,15 @@ type MsgCreateLockedDelegation struct {
 	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 	// lock_duration is for how long the locking will last
 	LockDuration time.Duration `protobuf:"bytes,4,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration"`
-	// auto_renew defines if the delegator wants to auto renew the locking after expiration
+	// auto_renew defines if the delegator wants to auto renew the locking after
+	// expiration
 	AutoRenew bool `protobuf:"varint,5,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// expiry_action defines what happens to the shares when the entry expires
+	// without auto renew
+	ExpiryAction ExpiryAction `protobuf:"varint,6,opt,name=expiry_action,json=expiryAction,proto3,enum=aether.locking.v1beta1.ExpiryAction" json:"expiry_action,omitempty"`
+	// redelegate_to is the validator the shares are redelegated to with the
+	// EXPIRY_ACTION_REDELEGATE expiry action
+	RedelegateTo string `protobuf:"bytes,7,opt,name=redelegate_to,json=redelegateTo,proto3" json:"redelegate_to,omitempty"`
 }
 
 func (m *MsgCreateLockedDelegation) Reset()         { *m = MsgCreateLockedDelegation{} }
@@ -84,7 +93,8 @@ func (m *MsgCreateLockedDelegation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateLockedDelegation proto.InternalMessageInfo
 
-// MsgCreateLockedDelegationResponse defines the Msg/CreateLockedDelegation response type.
+// MsgCreateLockedDelegationResponse defines the Msg/CreateLockedDelegation
+// response type.
 type MsgCreateLockedDelegationResponse struct {
 }
 
@@ -121,8 +131,8 @@ func (m *MsgCreateLockedDelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateLockedDelegationResponse proto.InternalMessageInfo
 
-// MsgRedelegateLockedDelegation defines a SDK message for performing a redelegation
-// of locked delegations
+// MsgRedelegateLockedDelegation defines a SDK message for performing a
+// redelegation of locked delegations
 type MsgRedelegateLockedDelegations struct {
 	// delegator_address is the delegator address, the signer
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
@@ -130,7 +140,8 @@ type MsgRedelegateLockedDelegations struct {
 	ValidatorSrcAddress string `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
 	// validator_dst_address is the target validator address
 	ValidatorDstAddress string `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
-	// ids are all the locked delegation ids that will move between the source and destination validators
+	// ids are all the locked delegation ids that will move between the source and
+	// destination validators
 	Ids []uint64 `protobuf:"varint,4,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
 
@@ -167,7 +178,8 @@ func (m *MsgRedelegateLockedDelegations) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRedelegateLockedDelegations proto.InternalMessageInfo
 
-// MsgRedelegateLockedDelegationsResponse defines the Msg/MsgRedelegateLockedDelegation response type.
+// MsgRedelegateLockedDelegationsResponse defines the
+// Msg/MsgRedelegateLockedDelegation response type.
 type MsgRedelegateLockedDelegationsResponse struct {
 	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
 }
@@ -214,8 +226,8 @@ func (m *MsgRedelegateLockedDelegationsResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
-// MsgToggleAutoRenew defines a SDK message for performing a auto renew flag flip on a
-// locked delegation entry
+// MsgToggleAutoRenew defines a SDK message for performing a auto renew flag
+// flip on a locked delegation entry
 type MsgToggleAutoRenew struct {
 	// delegator_address is the delegator address, the signer
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
@@ -295,27 +307,34 @@ func (m *MsgToggleAutoRenewResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgToggleAutoRenewResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-type MsgUpdateParams struct {
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/locking parameters to update.
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgSetExpiryAction defines a SDK message for changing the expiry action of a
+// locked delegation entry
+type MsgSetExpiryAction struct {
+	// delegator_address is the delegator address, the signer
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// id is the id of the entry that will have the expiry action changed
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// expiry_action is the new entry expiry action
+	ExpiryAction ExpiryAction `protobuf:"varint,4,opt,name=expiry_action,json=expiryAction,proto3,enum=aether.locking.v1beta1.ExpiryAction" json:"expiry_action,omitempty"`
+	// redelegate_to is the validator the shares are redelegated to with the
+	// EXPIRY_ACTION_REDELEGATE expiry action
+	RedelegateTo string `protobuf:"bytes,5,opt,name=redelegate_to,json=redelegateTo,proto3" json:"redelegate_to,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgSetExpiryAction) Reset()         { *m = MsgSetExpiryAction{} }
+func (m *MsgSetExpiryAction) String() string { return proto.CompactTextString(m) }
+func (*MsgSetExpiryAction) ProtoMessage()    {}
+func (*MsgSetExpiryAction) Descriptor() ([]byte, []int) {
 	return fileDescriptor_282d618ca7eabb4c, []int{6}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetExpiryAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetExpiryAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetExpiryAction.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -325,48 +344,78 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgSetExpiryAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetExpiryAction.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgSetExpiryAction) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgSetExpiryAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetExpiryAction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetExpiryAction proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
+// MsgSetExpiryActionResponse defines the Msg/SetExpiryAction response type.
+type MsgSetExpiryActionResponse struct {
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
-	if m != nil {
-		return m.Params
+func (m *MsgSetExpiryActionResponse) Reset()         { *m = MsgSetExpiryActionResponse{} }
+func (m *MsgSetExpiryActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetExpiryActionResponse) ProtoMessage()    {}
+func (*MsgSetExpiryActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{7}
+}
+func (m *MsgSetExpiryActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetExpiryActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetExpiryActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return Params{}
+}
+func (m *MsgSetExpiryActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetExpiryActionResponse.Merge(m, src)
+}
+func (m *MsgSetExpiryActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetExpiryActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetExpiryActionResponse.DiscardUnknown(m)
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+var xxx_messageInfo_MsgSetExpiryActionResponse proto.InternalMessageInfo
+
+// MsgEarlyUnlock defines a SDK message for unlocking locked delegation entries
+// before their unlock time
+type MsgEarlyUnlock struct {
+	// delegator_address is the delegator address, the signer
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// ids are the locked delegation entry ids that will be unlocked
+	Ids []uint64 `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_282d618ca7eabb4c, []int{7}
+func (m *MsgEarlyUnlock) Reset()         { *m = MsgEarlyUnlock{} }
+func (m *MsgEarlyUnlock) String() string { return proto.CompactTextString(m) }
+func (*MsgEarlyUnlock) ProtoMessage()    {}
+func (*MsgEarlyUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{8}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgEarlyUnlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgEarlyUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgEarlyUnlock.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -376,735 +425,6363 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgEarlyUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEarlyUnlock.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgEarlyUnlock) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgEarlyUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEarlyUnlock.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgEarlyUnlock proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateLockedDelegation)(nil), "aether.locking.v1beta1.MsgCreateLockedDelegation")
-	proto.RegisterType((*MsgCreateLockedDelegationResponse)(nil), "aether.locking.v1beta1.MsgCreateLockedDelegationResponse")
-	proto.RegisterType((*MsgRedelegateLockedDelegations)(nil), "aether.locking.v1beta1.MsgRedelegateLockedDelegations")
-	proto.RegisterType((*MsgRedelegateLockedDelegationsResponse)(nil), "aether.locking.v1beta1.MsgRedelegateLockedDelegationsResponse")
-	proto.RegisterType((*MsgToggleAutoRenew)(nil), "aether.locking.v1beta1.MsgToggleAutoRenew")
-	proto.RegisterType((*MsgToggleAutoRenewResponse)(nil), "aether.locking.v1beta1.MsgToggleAutoRenewResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "aether.locking.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "aether.locking.v1beta1.MsgUpdateParamsResponse")
+// MsgEarlyUnlockResponse defines the Msg/MsgEarlyUnlock response type.
+type MsgEarlyUnlockResponse struct {
+	// completion_time is when the undelegation of the unlocked shares completes
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// penalty is the amount charged for unlocking early
+	Penalty types.Coin `protobuf:"bytes,2,opt,name=penalty,proto3" json:"penalty"`
 }
 
-func init() { proto.RegisterFile("aether/locking/v1beta1/tx.proto", fileDescriptor_282d618ca7eabb4c) }
-
-var fileDescriptor_282d618ca7eabb4c = []byte{
-	// 792 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0x8e, 0x93, 0xb4, 0x6a, 0xa7, 0xff, 0xbe, 0xbd, 0x6d, 0xe2, 0x7b, 0xeb, 0x04, 0x57, 0x82,
-	0x28, 0x52, 0x6d, 0x35, 0x48, 0x95, 0xa8, 0x2a, 0x44, 0xd3, 0x22, 0x21, 0xd1, 0x48, 0xc8, 0x2d,
-	0x1b, 0x36, 0x91, 0x63, 0x0f, 0x13, 0xab, 0xb1, 0x27, 0x78, 0x26, 0x85, 0x4a, 0x2c, 0x10, 0x0b,
-	0x84, 0xba, 0xaa, 0x90, 0x90, 0x58, 0xb0, 0xe8, 0x92, 0x65, 0x17, 0x3c, 0x44, 0x97, 0x15, 0x2b,
-	0x56, 0x14, 0xa5, 0x8b, 0xf2, 0x00, 0x3c, 0x00, 0x1a, 0x7b, 0xec, 0x90, 0xa4, 0x49, 0x03, 0x62,
-	0xc1, 0x26, 0xf1, 0x9c, 0xf9, 0xce, 0x77, 0x7e, 0xbe, 0xe3, 0x63, 0x90, 0x31, 0x20, 0xad, 0x42,
-	0x4f, 0xab, 0x61, 0x73, 0xd7, 0x76, 0x91, 0xb6, 0xb7, 0x5c, 0x81, 0xd4, 0x58, 0xd6, 0xe8, 0x33,
-	0xb5, 0xee, 0x61, 0x8a, 0xc5, 0xb9, 0x00, 0xa0, 0x72, 0x80, 0xca, 0x01, 0xd2, 0x2c, 0xc2, 0x08,
-	0xfb, 0x10, 0x8d, 0x3d, 0x05, 0x68, 0x49, 0x46, 0x18, 0xa3, 0x1a, 0xd4, 0xfc, 0x53, 0xa5, 0xf1,
-	0x58, 0xb3, 0x1a, 0x9e, 0x41, 0x6d, 0xec, 0xf2, 0xfb, 0x4c, 0xe7, 0x3d, 0xb5, 0x1d, 0x48, 0xa8,
-	0xe1, 0xd4, 0x39, 0x20, 0x6d, 0x62, 0xe2, 0x60, 0x52, 0x0e, 0x98, 0x83, 0x43, 0xc8, 0x1d, 0x9c,
-	0xb4, 0x8a, 0x41, 0x60, 0x94, 0xa7, 0x89, 0xed, 0x90, 0x7b, 0x9e, 0xdf, 0x3b, 0x84, 0x95, 0xc1,
-	0xfe, 0xf8, 0xc5, 0x8c, 0xe1, 0xd8, 0x2e, 0xd6, 0xfc, 0x5f, 0x6e, 0x5a, 0xec, 0x51, 0x76, 0xdd,
-	0xf0, 0x0c, 0x87, 0x07, 0x54, 0xde, 0x24, 0x40, 0xba, 0x44, 0xd0, 0x86, 0x07, 0x0d, 0x0a, 0xb7,
-	0xb0, 0xb9, 0x0b, 0xad, 0x4d, 0x58, 0x83, 0xc8, 0x2f, 0x48, 0xbc, 0x0b, 0x66, 0xac, 0xe0, 0x84,
-	0xbd, 0xb2, 0x61, 0x59, 0x1e, 0x24, 0x24, 0x25, 0x64, 0x85, 0xdc, 0x68, 0x31, 0xf5, 0xe9, 0xe3,
-	0xd2, 0x2c, 0xcf, 0x7d, 0x3d, 0xb8, 0xd9, 0xa6, 0x9e, 0xed, 0x22, 0x7d, 0x3a, 0x72, 0xe1, 0x76,
-	0x46, 0xb3, 0x67, 0xd4, 0x6c, 0xab, 0x8d, 0x26, 0x7e, 0x15, 0x4d, 0xe4, 0x12, 0xd2, 0xac, 0x81,
-	0x61, 0xc3, 0xc1, 0x0d, 0x97, 0xa6, 0x12, 0x59, 0x21, 0x37, 0x56, 0x48, 0xab, 0xdc, 0x91, 0x75,
-	0x2b, 0x14, 0x4d, 0xdd, 0xc0, 0xb6, 0x5b, 0x1c, 0x3d, 0xf9, 0x92, 0x89, 0x7d, 0xb8, 0x38, 0xce,
-	0x0b, 0x3a, 0xf7, 0x11, 0xef, 0x81, 0x09, 0xd6, 0x89, 0x72, 0xa8, 0x56, 0x2a, 0xc9, 0x49, 0x02,
-	0xb9, 0xd4, 0x50, 0x2e, 0x75, 0x93, 0x03, 0x8a, 0x23, 0x8c, 0xe4, 0xdd, 0x59, 0x46, 0xd0, 0xc7,
-	0x99, 0x67, 0x68, 0x17, 0x17, 0x00, 0x30, 0x1a, 0x14, 0x97, 0x3d, 0xe8, 0xc2, 0xa7, 0xa9, 0xa1,
-	0xac, 0x90, 0x1b, 0xd1, 0x47, 0x99, 0x45, 0x67, 0x86, 0xd5, 0x3b, 0xaf, 0x8f, 0x32, 0xb1, 0x6f,
-	0x47, 0x99, 0xd8, 0xcb, 0x8b, 0xe3, 0x7c, 0x77, 0xff, 0x0e, 0x2e, 0x8e, 0xf3, 0x0b, 0x5c, 0x9a,
-	0xcb, 0xdb, 0xae, 0x2c, 0x82, 0x6b, 0x3d, 0x35, 0xd1, 0x21, 0xa9, 0x63, 0x97, 0x40, 0xa5, 0x19,
-	0x07, 0x72, 0x89, 0x20, 0x1d, 0xf2, 0x08, 0x5d, 0x48, 0xf2, 0xa7, 0xe4, 0xdb, 0x02, 0xff, 0xb6,
-	0xe4, 0x23, 0x9e, 0x39, 0xb0, 0x84, 0xff, 0x44, 0x6e, 0xdb, 0x9e, 0x79, 0x29, 0x9b, 0x45, 0x68,
-	0xc4, 0x96, 0x18, 0x98, 0x6d, 0x93, 0xd0, 0x90, 0x6d, 0x1a, 0x24, 0x6c, 0x8b, 0xa4, 0x92, 0xd9,
-	0x44, 0x2e, 0xa9, 0xb3, 0xc7, 0xd5, 0xfb, 0x57, 0xb7, 0x3f, 0x17, 0xf0, 0x2f, 0x11, 0x6b, 0x57,
-	0xeb, 0xdb, 0x42, 0xe5, 0x39, 0xb8, 0xde, 0xbf, 0xc7, 0xa1, 0x1c, 0xa2, 0x0e, 0xa6, 0x4c, 0xec,
-	0xd4, 0x6b, 0x90, 0x99, 0xcb, 0xec, 0x95, 0xf7, 0x3b, 0x3d, 0x56, 0x90, 0xba, 0x06, 0x6c, 0x27,
-	0xdc, 0x07, 0xc5, 0x09, 0x36, 0x61, 0x87, 0x67, 0x19, 0x21, 0x18, 0xd5, 0xc9, 0x16, 0x03, 0xc3,
-	0x28, 0xdf, 0x05, 0x20, 0x96, 0x08, 0xda, 0xc1, 0x08, 0xd5, 0xe0, 0x7a, 0x38, 0x60, 0x7f, 0xd9,
-	0x5b, 0x39, 0x09, 0xe2, 0xb6, 0xe5, 0x8b, 0x97, 0xd4, 0xe3, 0xb6, 0x35, 0xd0, 0xf8, 0xb7, 0xf7,
-	0xbf, 0xa3, 0x3e, 0xe5, 0x7f, 0x20, 0x75, 0x5b, 0xa3, 0xb9, 0x7f, 0x2f, 0x80, 0xa9, 0x12, 0x41,
-	0x0f, 0xeb, 0x96, 0x41, 0xe1, 0x03, 0x7f, 0x97, 0x89, 0x2b, 0x80, 0xbd, 0x7f, 0x55, 0xec, 0xd9,
-	0x74, 0xff, 0xca, 0x4e, 0xb4, 0xa0, 0xe2, 0x3a, 0x18, 0x0e, 0xb6, 0xa1, 0x5f, 0xf7, 0x58, 0x41,
-	0x56, 0x2f, 0xff, 0x12, 0xa8, 0x41, 0x9c, 0xb6, 0xb5, 0x12, 0x38, 0xae, 0x4e, 0xb2, 0x32, 0x5b,
-	0x94, 0x4a, 0x1a, 0xcc, 0x77, 0x64, 0x17, 0x66, 0x5e, 0x38, 0x48, 0x82, 0x44, 0x89, 0x20, 0xf1,
-	0x95, 0x00, 0xe6, 0x7a, 0x2c, 0xdc, 0xe5, 0x5e, 0x09, 0xf4, 0xdc, 0x07, 0xd2, 0xad, 0x5f, 0x76,
-	0x89, 0x66, 0xf6, 0xad, 0x00, 0xfe, 0xeb, 0xb7, 0x3f, 0x56, 0xfa, 0x50, 0xf7, 0xf1, 0x93, 0x6e,
-	0xff, 0x9e, 0x5f, 0x94, 0xd7, 0x13, 0x30, 0xd5, 0x39, 0xf3, 0xf9, 0x3e, 0x94, 0x1d, 0x58, 0xa9,
-	0x30, 0x38, 0x36, 0x0a, 0x59, 0x05, 0xe3, 0x6d, 0x13, 0x75, 0xa3, 0x0f, 0xc7, 0xcf, 0x40, 0x49,
-	0x1b, 0x10, 0x18, 0x46, 0x92, 0x86, 0x5e, 0xb0, 0xf9, 0x29, 0xae, 0x9d, 0x34, 0x65, 0xe1, 0xb4,
-	0x29, 0x0b, 0x5f, 0x9b, 0xb2, 0x70, 0x78, 0x2e, 0xc7, 0x4e, 0xcf, 0xe5, 0xd8, 0xe7, 0x73, 0x39,
-	0xf6, 0x48, 0x41, 0x36, 0xad, 0x36, 0x2a, 0xaa, 0x89, 0x1d, 0x2d, 0xe0, 0x86, 0x7b, 0x4e, 0xf4,
-	0x15, 0xa7, 0xfb, 0x75, 0x48, 0x2a, 0xc3, 0xfe, 0x32, 0xb9, 0xf9, 0x23, 0x00, 0x00, 0xff, 0xff,
-	0x95, 0x5e, 0x09, 0x13, 0xdb, 0x08, 0x00, 0x00,
+func (m *MsgEarlyUnlockResponse) Reset()         { *m = MsgEarlyUnlockResponse{} }
+func (m *MsgEarlyUnlockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEarlyUnlockResponse) ProtoMessage()    {}
+func (*MsgEarlyUnlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{9}
+}
+func (m *MsgEarlyUnlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEarlyUnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEarlyUnlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEarlyUnlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEarlyUnlockResponse.Merge(m, src)
+}
+func (m *MsgEarlyUnlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEarlyUnlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEarlyUnlockResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgEarlyUnlockResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateLockedDelegation defines a method for creating a new locked delegation.
-	CreateLockedDelegation(ctx context.Context, in *MsgCreateLockedDelegation, opts ...grpc.CallOption) (*MsgCreateLockedDelegationResponse, error)
-	// RedelegateLockedDelegation defines a method for performing a redelegation
-	// of locked delegations
-	RedelegateLockedDelegations(ctx context.Context, in *MsgRedelegateLockedDelegations, opts ...grpc.CallOption) (*MsgRedelegateLockedDelegationsResponse, error)
-	// ToggleAutoRenew toogles the auto renew flag in a locked delegation entry
-	ToggleAutoRenew(ctx context.Context, in *MsgToggleAutoRenew, opts ...grpc.CallOption) (*MsgToggleAutoRenewResponse, error)
-	// UpdateParams defines an operation for updating the x/locking module parameters
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgEarlyUnlockResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgEarlyUnlockResponse) GetPenalty() types.Coin {
+	if m != nil {
+		return m.Penalty
+	}
+	return types.Coin{}
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgSplitLockedDelegationEntry defines a SDK message for splitting a locked
+// delegation entry in two new entries
+type MsgSplitLockedDelegationEntry struct {
+	// delegator_address is the delegator address, the signer
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// id is the id of the entry that will be split
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// shares are the shares moved to the first new entry, the remaining shares
+	// are kept by the second new entry
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
 }
 
-func (c *msgClient) CreateLockedDelegation(ctx context.Context, in *MsgCreateLockedDelegation, opts ...grpc.CallOption) (*MsgCreateLockedDelegationResponse, error) {
-	out := new(MsgCreateLockedDelegationResponse)
-	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/CreateLockedDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSplitLockedDelegationEntry) Reset()         { *m = MsgSplitLockedDelegationEntry{} }
+func (m *MsgSplitLockedDelegationEntry) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitLockedDelegationEntry) ProtoMessage()    {}
+func (*MsgSplitLockedDelegationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{10}
+}
+func (m *MsgSplitLockedDelegationEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitLockedDelegationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitLockedDelegationEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSplitLockedDelegationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitLockedDelegationEntry.Merge(m, src)
+}
+func (m *MsgSplitLockedDelegationEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitLockedDelegationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitLockedDelegationEntry.DiscardUnknown(m)
 }
 
-func (c *msgClient) RedelegateLockedDelegations(ctx context.Context, in *MsgRedelegateLockedDelegations, opts ...grpc.CallOption) (*MsgRedelegateLockedDelegationsResponse, error) {
-	out := new(MsgRedelegateLockedDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/RedelegateLockedDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSplitLockedDelegationEntry proto.InternalMessageInfo
+
+// MsgSplitLockedDelegationEntryResponse defines the
+// Msg/MsgSplitLockedDelegationEntry response type.
+type MsgSplitLockedDelegationEntryResponse struct {
+	// split_id is the id of the new entry holding the requested shares
+	SplitId uint64 `protobuf:"varint,1,opt,name=split_id,json=splitId,proto3" json:"split_id,omitempty"`
+	// remainder_id is the id of the new entry holding the remaining shares
+	RemainderId uint64 `protobuf:"varint,2,opt,name=remainder_id,json=remainderId,proto3" json:"remainder_id,omitempty"`
 }
 
-func (c *msgClient) ToggleAutoRenew(ctx context.Context, in *MsgToggleAutoRenew, opts ...grpc.CallOption) (*MsgToggleAutoRenewResponse, error) {
-	out := new(MsgToggleAutoRenewResponse)
-	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/ToggleAutoRenew", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSplitLockedDelegationEntryResponse) Reset()         { *m = MsgSplitLockedDelegationEntryResponse{} }
+func (m *MsgSplitLockedDelegationEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitLockedDelegationEntryResponse) ProtoMessage()    {}
+func (*MsgSplitLockedDelegationEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{11}
+}
+func (m *MsgSplitLockedDelegationEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitLockedDelegationEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitLockedDelegationEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSplitLockedDelegationEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitLockedDelegationEntryResponse.Merge(m, src)
+}
+func (m *MsgSplitLockedDelegationEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitLockedDelegationEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitLockedDelegationEntryResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSplitLockedDelegationEntryResponse proto.InternalMessageInfo
+
+func (m *MsgSplitLockedDelegationEntryResponse) GetSplitId() uint64 {
+	if m != nil {
+		return m.SplitId
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateLockedDelegation defines a method for creating a new locked delegation.
-	CreateLockedDelegation(context.Context, *MsgCreateLockedDelegation) (*MsgCreateLockedDelegationResponse, error)
-	// RedelegateLockedDelegation defines a method for performing a redelegation
-	// of locked delegations
-	RedelegateLockedDelegations(context.Context, *MsgRedelegateLockedDelegations) (*MsgRedelegateLockedDelegationsResponse, error)
-	// ToggleAutoRenew toogles the auto renew flag in a locked delegation entry
-	ToggleAutoRenew(context.Context, *MsgToggleAutoRenew) (*MsgToggleAutoRenewResponse, error)
-	// UpdateParams defines an operation for updating the x/locking module parameters
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgSplitLockedDelegationEntryResponse) GetRemainderId() uint64 {
+	if m != nil {
+		return m.RemainderId
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgExtendLock defines a SDK message for moving a locked delegation entry to
+// a longer duration rate
+type MsgExtendLock struct {
+	// delegator_address is the delegator address, the signer
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// id is the id of the entry that will be extended
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// lock_duration is the duration of the new rate, it must be longer than the
+	// current entry rate duration
+	LockDuration time.Duration `protobuf:"bytes,4,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration"`
 }
 
-func (*UnimplementedMsgServer) CreateLockedDelegation(ctx context.Context, req *MsgCreateLockedDelegation) (*MsgCreateLockedDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateLockedDelegation not implemented")
+func (m *MsgExtendLock) Reset()         { *m = MsgExtendLock{} }
+func (m *MsgExtendLock) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendLock) ProtoMessage()    {}
+func (*MsgExtendLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{12}
 }
-func (*UnimplementedMsgServer) RedelegateLockedDelegations(ctx context.Context, req *MsgRedelegateLockedDelegations) (*MsgRedelegateLockedDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RedelegateLockedDelegations not implemented")
+func (m *MsgExtendLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ToggleAutoRenew(ctx context.Context, req *MsgToggleAutoRenew) (*MsgToggleAutoRenewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ToggleAutoRenew not implemented")
+func (m *MsgExtendLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgExtendLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendLock.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgExtendLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendLock.DiscardUnknown(m)
 }
 
-func _Msg_CreateLockedDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateLockedDelegation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateLockedDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aether.locking.v1beta1.Msg/CreateLockedDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateLockedDelegation(ctx, req.(*MsgCreateLockedDelegation))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgExtendLock proto.InternalMessageInfo
+
+// MsgExtendLockResponse defines the Msg/MsgExtendLock response type.
+type MsgExtendLockResponse struct {
+	// unlock_on is the new unlock time of the entry
+	UnlockOn time.Time `protobuf:"bytes,1,opt,name=unlock_on,json=unlockOn,proto3,stdtime" json:"unlock_on"`
 }
 
-func _Msg_RedelegateLockedDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRedelegateLockedDelegations)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RedelegateLockedDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aether.locking.v1beta1.Msg/RedelegateLockedDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RedelegateLockedDelegations(ctx, req.(*MsgRedelegateLockedDelegations))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgExtendLockResponse) Reset()         { *m = MsgExtendLockResponse{} }
+func (m *MsgExtendLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendLockResponse) ProtoMessage()    {}
+func (*MsgExtendLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{13}
 }
-
-func _Msg_ToggleAutoRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgToggleAutoRenew)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ToggleAutoRenew(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aether.locking.v1beta1.Msg/ToggleAutoRenew",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ToggleAutoRenew(ctx, req.(*MsgToggleAutoRenew))
+func (m *MsgExtendLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgExtendLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendLockResponse.Merge(m, src)
+}
+func (m *MsgExtendLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendLockResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aether.locking.v1beta1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgExtendLockResponse proto.InternalMessageInfo
+
+func (m *MsgExtendLockResponse) GetUnlockOn() time.Time {
+	if m != nil {
+		return m.UnlockOn
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "aether.locking.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateLockedDelegation",
-			Handler:    _Msg_CreateLockedDelegation_Handler,
-		},
-		{
-			MethodName: "RedelegateLockedDelegations",
-			Handler:    _Msg_RedelegateLockedDelegations_Handler,
-		},
-		{
-			MethodName: "ToggleAutoRenew",
-			Handler:    _Msg_ToggleAutoRenew_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "aether/locking/v1beta1/tx.proto",
+// MsgLockExistingDelegation defines a SDK message for locking shares of an
+// existing delegation
+type MsgLockExistingDelegation struct {
+	// delegator_address is the delegator address, the signer
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address of the existing delegation
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the amount of the existing delegation that will be locked
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// lock_duration is for how long the locking will last
+	LockDuration time.Duration `protobuf:"bytes,4,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration"`
+	// auto_renew defines if the delegator wants to auto renew the locking after
+	// expiration
+	AutoRenew bool `protobuf:"varint,5,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// expiry_action defines what happens to the shares when the entry expires
+	// without auto renew
+	ExpiryAction ExpiryAction `protobuf:"varint,6,opt,name=expiry_action,json=expiryAction,proto3,enum=aether.locking.v1beta1.ExpiryAction" json:"expiry_action,omitempty"`
+	// redelegate_to is the validator the shares are redelegated to with the
+	// EXPIRY_ACTION_REDELEGATE expiry action
+	RedelegateTo string `protobuf:"bytes,7,opt,name=redelegate_to,json=redelegateTo,proto3" json:"redelegate_to,omitempty"`
 }
 
-func (m *MsgCreateLockedDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgLockExistingDelegation) Reset()         { *m = MsgLockExistingDelegation{} }
+func (m *MsgLockExistingDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgLockExistingDelegation) ProtoMessage()    {}
+func (*MsgLockExistingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{14}
+}
+func (m *MsgLockExistingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockExistingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockExistingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgLockExistingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockExistingDelegation.Merge(m, src)
+}
+func (m *MsgLockExistingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockExistingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockExistingDelegation.DiscardUnknown(m)
 }
 
-func (m *MsgCreateLockedDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgLockExistingDelegation proto.InternalMessageInfo
+
+// MsgLockExistingDelegationResponse defines the Msg/LockExistingDelegation
+// response type.
+type MsgLockExistingDelegationResponse struct {
 }
 
-func (m *MsgCreateLockedDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AutoRenew {
-		i--
-		if m.AutoRenew {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgLockExistingDelegationResponse) Reset()         { *m = MsgLockExistingDelegationResponse{} }
+func (m *MsgLockExistingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockExistingDelegationResponse) ProtoMessage()    {}
+func (*MsgLockExistingDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{15}
+}
+func (m *MsgLockExistingDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockExistingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockExistingDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
-	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration):])
-	if err1 != nil {
-		return 0, err1
+		return b[:n], nil
 	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgLockExistingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockExistingDelegationResponse.Merge(m, src)
+}
+func (m *MsgLockExistingDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockExistingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockExistingDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockExistingDelegationResponse proto.InternalMessageInfo
+
+// MsgFundRewardPool defines a SDK message for funding the locking reward pool
+type MsgFundRewardPool struct {
+	// depositor is the address funding the pool, the signer
+	Depositor string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount is the amount sent to the pool
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundRewardPool) Reset()         { *m = MsgFundRewardPool{} }
+func (m *MsgFundRewardPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardPool) ProtoMessage()    {}
+func (*MsgFundRewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{16}
+}
+func (m *MsgFundRewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgFundRewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardPool.Merge(m, src)
+}
+func (m *MsgFundRewardPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardPool.DiscardUnknown(m)
 }
 
-func (m *MsgCreateLockedDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgFundRewardPool proto.InternalMessageInfo
+
+// MsgFundRewardPoolResponse defines the Msg/FundRewardPool response type.
+type MsgFundRewardPoolResponse struct {
 }
 
-func (m *MsgCreateLockedDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFundRewardPoolResponse) Reset()         { *m = MsgFundRewardPoolResponse{} }
+func (m *MsgFundRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardPoolResponse) ProtoMessage()    {}
+func (*MsgFundRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{17}
+}
+func (m *MsgFundRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardPoolResponse.Merge(m, src)
+}
+func (m *MsgFundRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardPoolResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateLockedDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgFundRewardPoolResponse proto.InternalMessageInfo
+
+// MsgClaimRewardDebt defines a SDK message for claiming the locking rewards
+// owed to a delegator on a validator
+type MsgClaimRewardDebt struct {
+	// delegator_address is the delegator address, the signer
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *MsgRedelegateLockedDelegations) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimRewardDebt) Reset()         { *m = MsgClaimRewardDebt{} }
+func (m *MsgClaimRewardDebt) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardDebt) ProtoMessage()    {}
+func (*MsgClaimRewardDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{18}
+}
+func (m *MsgClaimRewardDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgClaimRewardDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardDebt.Merge(m, src)
+}
+func (m *MsgClaimRewardDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardDebt.DiscardUnknown(m)
 }
 
-func (m *MsgRedelegateLockedDelegations) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClaimRewardDebt proto.InternalMessageInfo
+
+// MsgClaimRewardDebtResponse defines the Msg/ClaimRewardDebt response type.
+type MsgClaimRewardDebtResponse struct {
+	// amount is the amount paid
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// remaining_debt is the amount still owed after the claim
+	RemainingDebt github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=remaining_debt,json=remainingDebt,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_debt"`
 }
 
-func (m *MsgRedelegateLockedDelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		dAtA4 := make([]byte, len(m.Ids)*10)
-		var j3 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
+func (m *MsgClaimRewardDebtResponse) Reset()         { *m = MsgClaimRewardDebtResponse{} }
+func (m *MsgClaimRewardDebtResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardDebtResponse) ProtoMessage()    {}
+func (*MsgClaimRewardDebtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{19}
+}
+func (m *MsgClaimRewardDebtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardDebtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardDebtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ValidatorDstAddress) > 0 {
-		i -= len(m.ValidatorDstAddress)
-		copy(dAtA[i:], m.ValidatorDstAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorDstAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorSrcAddress) > 0 {
-		i -= len(m.ValidatorSrcAddress)
-		copy(dAtA[i:], m.ValidatorSrcAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRedelegateLockedDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgClaimRewardDebtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardDebtResponse.Merge(m, src)
 }
-
-func (m *MsgRedelegateLockedDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimRewardDebtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardDebtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardDebtResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRedelegateLockedDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err5 != nil {
-		return 0, err5
+var xxx_messageInfo_MsgClaimRewardDebtResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRewardDebtResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	i -= n5
-	i = encodeVarintTx(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgToggleAutoRenew) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimRewardDebtResponse) GetRemainingDebt() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingDebt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgToggleAutoRenew) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetBonusBeneficiary defines a SDK message for setting the address
+// receiving the locking rewards of a delegator, an empty beneficiary address
+// sends them to the distribution withdraw address again
+type MsgSetBonusBeneficiary struct {
+	// delegator_address is the delegator address, the signer
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// beneficiary_address is the address receiving the locking rewards
+	BeneficiaryAddress string `protobuf:"bytes,2,opt,name=beneficiary_address,json=beneficiaryAddress,proto3" json:"beneficiary_address,omitempty"`
 }
 
-func (m *MsgToggleAutoRenew) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetBonusBeneficiary) Reset()         { *m = MsgSetBonusBeneficiary{} }
+func (m *MsgSetBonusBeneficiary) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBonusBeneficiary) ProtoMessage()    {}
+func (*MsgSetBonusBeneficiary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{20}
 }
-
-func (m *MsgToggleAutoRenewResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetBonusBeneficiary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBonusBeneficiary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBonusBeneficiary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgToggleAutoRenewResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetBonusBeneficiary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBonusBeneficiary.Merge(m, src)
+}
+func (m *MsgSetBonusBeneficiary) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBonusBeneficiary) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBonusBeneficiary.DiscardUnknown(m)
 }
 
-func (m *MsgToggleAutoRenewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetBonusBeneficiary proto.InternalMessageInfo
+
+// MsgSetBonusBeneficiaryResponse defines the Msg/SetBonusBeneficiary response
+// type.
+type MsgSetBonusBeneficiaryResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetBonusBeneficiaryResponse) Reset()         { *m = MsgSetBonusBeneficiaryResponse{} }
+func (m *MsgSetBonusBeneficiaryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBonusBeneficiaryResponse) ProtoMessage()    {}
+func (*MsgSetBonusBeneficiaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{21}
+}
+func (m *MsgSetBonusBeneficiaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBonusBeneficiaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBonusBeneficiaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetBonusBeneficiaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBonusBeneficiaryResponse.Merge(m, src)
+}
+func (m *MsgSetBonusBeneficiaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBonusBeneficiaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBonusBeneficiaryResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetBonusBeneficiaryResponse proto.InternalMessageInfo
+
+// MsgSetAutoCompound defines a SDK message for setting if the locking rewards
+// of a locked delegation are delegated and added to its entry with the latest
+// unlock time
+type MsgSetAutoCompound struct {
+	// delegator_address is the delegator address, the signer
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// auto_compound is the new compounding preference
+	AutoCompound bool `protobuf:"varint,3,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{22}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+// MsgSetAutoCompoundResponse defines the Msg/SetAutoCompound response type.
+type MsgSetAutoCompoundResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{23}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgCreateLockedDelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration)
-	n += 1 + l + sovTx(uint64(l))
-	if m.AutoRenew {
-		n += 2
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
+// MsgWithdrawLockingRewards defines a SDK message for withdrawing the locking
+// rewards of a delegator on a validator on the standalone reward mode
+type MsgWithdrawLockingRewards struct {
+	// delegator_address is the delegator address, the signer
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *MsgWithdrawLockingRewards) Reset()         { *m = MsgWithdrawLockingRewards{} }
+func (m *MsgWithdrawLockingRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawLockingRewards) ProtoMessage()    {}
+func (*MsgWithdrawLockingRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{24}
+}
+func (m *MsgWithdrawLockingRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawLockingRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawLockingRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgWithdrawLockingRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawLockingRewards.Merge(m, src)
+}
+func (m *MsgWithdrawLockingRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawLockingRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawLockingRewards.DiscardUnknown(m)
 }
 
-func (m *MsgCreateLockedDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgWithdrawLockingRewards proto.InternalMessageInfo
+
+// MsgWithdrawLockingRewardsResponse defines the Msg/WithdrawLockingRewards
+// response type.
+type MsgWithdrawLockingRewardsResponse struct {
+	// amount is the amount paid
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawLockingRewardsResponse) Reset()         { *m = MsgWithdrawLockingRewardsResponse{} }
+func (m *MsgWithdrawLockingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawLockingRewardsResponse) ProtoMessage()    {}
+func (*MsgWithdrawLockingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{25}
+}
+func (m *MsgWithdrawLockingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawLockingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawLockingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgWithdrawLockingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawLockingRewardsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawLockingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawLockingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawLockingRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRedelegateLockedDelegations) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgWithdrawLockingRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawLockingRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/locking parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{26}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovTx(uint64(e))
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{27}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRedelegateLockedDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgRetryQuarantinedPairs is the Msg/RetryQuarantinedPairs request type.
+type MsgRetryQuarantinedPairs struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// pairs are the quarantined locked delegation pairs to retry
+	Pairs []LockedDelegationPair `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs"`
 }
 
-func (m *MsgToggleAutoRenew) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRetryQuarantinedPairs) Reset()         { *m = MsgRetryQuarantinedPairs{} }
+func (m *MsgRetryQuarantinedPairs) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryQuarantinedPairs) ProtoMessage()    {}
+func (*MsgRetryQuarantinedPairs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{28}
+}
+func (m *MsgRetryQuarantinedPairs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryQuarantinedPairs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryQuarantinedPairs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRetryQuarantinedPairs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryQuarantinedPairs.Merge(m, src)
+}
+func (m *MsgRetryQuarantinedPairs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryQuarantinedPairs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryQuarantinedPairs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryQuarantinedPairs proto.InternalMessageInfo
+
+// MsgRetryQuarantinedPairsResponse defines the response structure for
+// executing a MsgRetryQuarantinedPairs message.
+type MsgRetryQuarantinedPairsResponse struct {
+}
+
+func (m *MsgRetryQuarantinedPairsResponse) Reset()         { *m = MsgRetryQuarantinedPairsResponse{} }
+func (m *MsgRetryQuarantinedPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryQuarantinedPairsResponse) ProtoMessage()    {}
+func (*MsgRetryQuarantinedPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{29}
+}
+func (m *MsgRetryQuarantinedPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryQuarantinedPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryQuarantinedPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRetryQuarantinedPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryQuarantinedPairsResponse.Merge(m, src)
+}
+func (m *MsgRetryQuarantinedPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryQuarantinedPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryQuarantinedPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryQuarantinedPairsResponse proto.InternalMessageInfo
+
+// MsgFundFromCommunityPool defines a SDK message for funding the locking
+// reward pool from the community pool
+type MsgFundFromCommunityPool struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// amount is the amount moved from the community pool to the reward pool
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundFromCommunityPool) Reset()         { *m = MsgFundFromCommunityPool{} }
+func (m *MsgFundFromCommunityPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundFromCommunityPool) ProtoMessage()    {}
+func (*MsgFundFromCommunityPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{30}
+}
+func (m *MsgFundFromCommunityPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundFromCommunityPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundFromCommunityPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+}
+func (m *MsgFundFromCommunityPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundFromCommunityPool.Merge(m, src)
+}
+func (m *MsgFundFromCommunityPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundFromCommunityPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundFromCommunityPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundFromCommunityPool proto.InternalMessageInfo
+
+// MsgFundFromCommunityPoolResponse defines the response structure for
+// executing a MsgFundFromCommunityPool message.
+type MsgFundFromCommunityPoolResponse struct {
+}
+
+func (m *MsgFundFromCommunityPoolResponse) Reset()         { *m = MsgFundFromCommunityPoolResponse{} }
+func (m *MsgFundFromCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundFromCommunityPoolResponse) ProtoMessage()    {}
+func (*MsgFundFromCommunityPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_282d618ca7eabb4c, []int{31}
+}
+func (m *MsgFundFromCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundFromCommunityPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundFromCommunityPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundFromCommunityPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundFromCommunityPoolResponse.Merge(m, src)
+}
+func (m *MsgFundFromCommunityPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundFromCommunityPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundFromCommunityPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundFromCommunityPoolResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateLockedDelegation)(nil), "aether.locking.v1beta1.MsgCreateLockedDelegation")
+	proto.RegisterType((*MsgCreateLockedDelegationResponse)(nil), "aether.locking.v1beta1.MsgCreateLockedDelegationResponse")
+	proto.RegisterType((*MsgRedelegateLockedDelegations)(nil), "aether.locking.v1beta1.MsgRedelegateLockedDelegations")
+	proto.RegisterType((*MsgRedelegateLockedDelegationsResponse)(nil), "aether.locking.v1beta1.MsgRedelegateLockedDelegationsResponse")
+	proto.RegisterType((*MsgToggleAutoRenew)(nil), "aether.locking.v1beta1.MsgToggleAutoRenew")
+	proto.RegisterType((*MsgToggleAutoRenewResponse)(nil), "aether.locking.v1beta1.MsgToggleAutoRenewResponse")
+	proto.RegisterType((*MsgSetExpiryAction)(nil), "aether.locking.v1beta1.MsgSetExpiryAction")
+	proto.RegisterType((*MsgSetExpiryActionResponse)(nil), "aether.locking.v1beta1.MsgSetExpiryActionResponse")
+	proto.RegisterType((*MsgEarlyUnlock)(nil), "aether.locking.v1beta1.MsgEarlyUnlock")
+	proto.RegisterType((*MsgEarlyUnlockResponse)(nil), "aether.locking.v1beta1.MsgEarlyUnlockResponse")
+	proto.RegisterType((*MsgSplitLockedDelegationEntry)(nil), "aether.locking.v1beta1.MsgSplitLockedDelegationEntry")
+	proto.RegisterType((*MsgSplitLockedDelegationEntryResponse)(nil), "aether.locking.v1beta1.MsgSplitLockedDelegationEntryResponse")
+	proto.RegisterType((*MsgExtendLock)(nil), "aether.locking.v1beta1.MsgExtendLock")
+	proto.RegisterType((*MsgExtendLockResponse)(nil), "aether.locking.v1beta1.MsgExtendLockResponse")
+	proto.RegisterType((*MsgLockExistingDelegation)(nil), "aether.locking.v1beta1.MsgLockExistingDelegation")
+	proto.RegisterType((*MsgLockExistingDelegationResponse)(nil), "aether.locking.v1beta1.MsgLockExistingDelegationResponse")
+	proto.RegisterType((*MsgFundRewardPool)(nil), "aether.locking.v1beta1.MsgFundRewardPool")
+	proto.RegisterType((*MsgFundRewardPoolResponse)(nil), "aether.locking.v1beta1.MsgFundRewardPoolResponse")
+	proto.RegisterType((*MsgClaimRewardDebt)(nil), "aether.locking.v1beta1.MsgClaimRewardDebt")
+	proto.RegisterType((*MsgClaimRewardDebtResponse)(nil), "aether.locking.v1beta1.MsgClaimRewardDebtResponse")
+	proto.RegisterType((*MsgSetBonusBeneficiary)(nil), "aether.locking.v1beta1.MsgSetBonusBeneficiary")
+	proto.RegisterType((*MsgSetBonusBeneficiaryResponse)(nil), "aether.locking.v1beta1.MsgSetBonusBeneficiaryResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "aether.locking.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "aether.locking.v1beta1.MsgSetAutoCompoundResponse")
+	proto.RegisterType((*MsgWithdrawLockingRewards)(nil), "aether.locking.v1beta1.MsgWithdrawLockingRewards")
+	proto.RegisterType((*MsgWithdrawLockingRewardsResponse)(nil), "aether.locking.v1beta1.MsgWithdrawLockingRewardsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "aether.locking.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "aether.locking.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRetryQuarantinedPairs)(nil), "aether.locking.v1beta1.MsgRetryQuarantinedPairs")
+	proto.RegisterType((*MsgRetryQuarantinedPairsResponse)(nil), "aether.locking.v1beta1.MsgRetryQuarantinedPairsResponse")
+	proto.RegisterType((*MsgFundFromCommunityPool)(nil), "aether.locking.v1beta1.MsgFundFromCommunityPool")
+	proto.RegisterType((*MsgFundFromCommunityPoolResponse)(nil), "aether.locking.v1beta1.MsgFundFromCommunityPoolResponse")
+}
+
+func init() { proto.RegisterFile("aether/locking/v1beta1/tx.proto", fileDescriptor_282d618ca7eabb4c) }
+
+var fileDescriptor_282d618ca7eabb4c = []byte{
+	// 1716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6b, 0x1b, 0x47,
+	0x1b, 0xf7, 0xca, 0x1f, 0xb1, 0xc7, 0x5f, 0xc9, 0x26, 0x4e, 0xe4, 0xcd, 0x1b, 0x49, 0xd9, 0x7c,
+	0xbc, 0x7e, 0xcd, 0x6b, 0x29, 0x56, 0x48, 0x9a, 0x08, 0x27, 0xd4, 0xb2, 0x9d, 0xd6, 0xd4, 0xa2,
+	0xe9, 0xda, 0xa1, 0xd0, 0x8b, 0x58, 0x69, 0x27, 0xeb, 0x25, 0xda, 0x1d, 0x75, 0x67, 0x14, 0x5b,
+	0xd0, 0x42, 0x69, 0x69, 0x29, 0x85, 0x42, 0x28, 0x14, 0x7a, 0x08, 0x34, 0xc7, 0x52, 0x7a, 0xf0,
+	0x21, 0x7f, 0x44, 0x8e, 0x21, 0xa7, 0x52, 0xda, 0x24, 0x38, 0x87, 0x94, 0x52, 0x7a, 0xea, 0xa1,
+	0xf4, 0xd2, 0x32, 0xfb, 0x31, 0x5a, 0xad, 0x56, 0xab, 0x95, 0x71, 0x82, 0x28, 0xbd, 0x24, 0xda,
+	0x99, 0xdf, 0xf3, 0x3c, 0xf3, 0x7c, 0x3f, 0x33, 0x06, 0x49, 0x19, 0x92, 0x4d, 0x68, 0x66, 0x2a,
+	0xa8, 0x7c, 0x4b, 0x33, 0xd4, 0xcc, 0xed, 0xf9, 0x12, 0x24, 0xf2, 0x7c, 0x86, 0x6c, 0xa7, 0xab,
+	0x26, 0x22, 0x88, 0x3f, 0x6a, 0x03, 0xd2, 0x0e, 0x20, 0xed, 0x00, 0x84, 0x23, 0x2a, 0x52, 0x91,
+	0x05, 0xc9, 0xd0, 0x5f, 0x36, 0x5a, 0x48, 0xa8, 0x08, 0xa9, 0x15, 0x98, 0xb1, 0xbe, 0x4a, 0xb5,
+	0x9b, 0x19, 0xa5, 0x66, 0xca, 0x44, 0x43, 0x86, 0xb3, 0x9f, 0xf4, 0xef, 0x13, 0x4d, 0x87, 0x98,
+	0xc8, 0x7a, 0xd5, 0x01, 0x4c, 0x97, 0x11, 0xd6, 0x11, 0x2e, 0xda, 0x9c, 0xed, 0x0f, 0x97, 0xb7,
+	0xfd, 0x95, 0x29, 0xc9, 0x18, 0xb2, 0x73, 0x96, 0x91, 0xe6, 0xf2, 0x3e, 0xe6, 0xec, 0xeb, 0x98,
+	0xaa, 0x41, 0xff, 0x73, 0x36, 0x0e, 0xc9, 0xba, 0x66, 0xa0, 0x8c, 0xf5, 0xaf, 0xb3, 0x74, 0xaa,
+	0x8d, 0xda, 0x55, 0xd9, 0x94, 0x75, 0x57, 0xe0, 0xe9, 0x36, 0x20, 0xd7, 0x14, 0x16, 0x4a, 0xbc,
+	0x3b, 0x00, 0xa6, 0x0b, 0x58, 0x5d, 0x32, 0xa1, 0x4c, 0xe0, 0x1a, 0x2a, 0xdf, 0x82, 0xca, 0x32,
+	0xac, 0x40, 0xd5, 0x52, 0x9b, 0x5f, 0x01, 0x87, 0x14, 0xfb, 0x0b, 0x99, 0x45, 0x59, 0x51, 0x4c,
+	0x88, 0x71, 0x9c, 0x4b, 0x71, 0x33, 0x23, 0xf9, 0xf8, 0xa3, 0xfb, 0x73, 0x47, 0x1c, 0x0d, 0x17,
+	0xed, 0x9d, 0x75, 0x62, 0x6a, 0x86, 0x2a, 0x1d, 0x64, 0x24, 0xce, 0x3a, 0x65, 0x73, 0x5b, 0xae,
+	0x68, 0x4a, 0x13, 0x9b, 0x58, 0x27, 0x36, 0x8c, 0xc4, 0x65, 0xb3, 0x00, 0x86, 0x64, 0x1d, 0xd5,
+	0x0c, 0x12, 0xef, 0x4f, 0x71, 0x33, 0xa3, 0xd9, 0xe9, 0xb4, 0x43, 0x48, 0x6d, 0xea, 0xba, 0x36,
+	0xbd, 0x84, 0x34, 0x23, 0x3f, 0xf2, 0xe0, 0x71, 0xb2, 0xef, 0x9b, 0xe7, 0x3b, 0xb3, 0x9c, 0xe4,
+	0xd0, 0xf0, 0xaf, 0x83, 0x71, 0xaa, 0x7a, 0xd1, 0xf5, 0x69, 0x7c, 0xc0, 0x61, 0x62, 0x3b, 0x35,
+	0xed, 0x3a, 0x35, 0xbd, 0xec, 0x00, 0xf2, 0xc3, 0x94, 0xc9, 0x57, 0x4f, 0x92, 0x9c, 0x34, 0x46,
+	0x29, 0xdd, 0x75, 0xfe, 0x04, 0x00, 0x72, 0x8d, 0xa0, 0xa2, 0x09, 0x0d, 0xb8, 0x15, 0x1f, 0x4c,
+	0x71, 0x33, 0xc3, 0xd2, 0x08, 0x5d, 0x91, 0xe8, 0x02, 0xbf, 0x0a, 0xc6, 0xe1, 0x76, 0x55, 0x33,
+	0xeb, 0x45, 0xb9, 0x6c, 0x09, 0x1a, 0x4a, 0x71, 0x33, 0x13, 0xd9, 0xd3, 0xe9, 0xe0, 0x58, 0x4c,
+	0xaf, 0x58, 0xe0, 0x45, 0x0b, 0x2b, 0x8d, 0x41, 0xcf, 0x17, 0x7f, 0x05, 0x8c, 0x9b, 0xd0, 0x31,
+	0x27, 0x2c, 0x12, 0x14, 0x3f, 0xd0, 0xc1, 0x68, 0x63, 0x0d, 0xf8, 0x06, 0xca, 0xbd, 0xfa, 0xe9,
+	0xbd, 0x64, 0xdf, 0xcf, 0xf7, 0x92, 0x7d, 0x1f, 0x3e, 0xdf, 0x99, 0x6d, 0xf5, 0xe4, 0x67, 0xcf,
+	0x77, 0x66, 0x4f, 0x38, 0x51, 0x12, 0x1c, 0x00, 0xe2, 0x29, 0x70, 0xb2, 0x6d, 0x74, 0x48, 0x10,
+	0x57, 0x91, 0x81, 0xa1, 0xb8, 0x1b, 0x03, 0x89, 0x02, 0x56, 0x25, 0x26, 0xda, 0x8f, 0xc4, 0xfb,
+	0x15, 0x48, 0x6b, 0x60, 0xaa, 0x11, 0x48, 0xd8, 0x2c, 0x47, 0x0e, 0xa6, 0xc3, 0x8c, 0x6c, 0xdd,
+	0x2c, 0x07, 0x72, 0x53, 0x30, 0x61, 0xdc, 0xfa, 0x23, 0x73, 0x5b, 0xc6, 0xc4, 0xe5, 0x76, 0x10,
+	0xf4, 0x6b, 0x0a, 0x8e, 0x0f, 0xa4, 0xfa, 0x67, 0x06, 0x24, 0xfa, 0x33, 0xf7, 0x46, 0x67, 0xf3,
+	0xcf, 0xd8, 0xfc, 0xe7, 0xb0, 0x72, 0x2b, 0x13, 0x6a, 0x42, 0xf1, 0x3d, 0x70, 0x36, 0xdc, 0xc6,
+	0xae, 0x3b, 0x78, 0x09, 0x4c, 0x96, 0x91, 0x5e, 0xad, 0x40, 0xba, 0x5c, 0xa4, 0x25, 0xca, 0xb2,
+	0xf4, 0x68, 0x56, 0x68, 0x09, 0xf5, 0x0d, 0xb7, 0x7e, 0xe5, 0xc7, 0x69, 0xac, 0xdf, 0x79, 0x92,
+	0xe4, 0xec, 0xa4, 0x99, 0x68, 0x70, 0xa0, 0x18, 0xf1, 0x77, 0x0e, 0xf0, 0x05, 0xac, 0x6e, 0x20,
+	0x55, 0xad, 0xc0, 0x45, 0x16, 0xea, 0xbd, 0x55, 0x1f, 0x26, 0x40, 0x4c, 0x53, 0x2c, 0xe7, 0x0d,
+	0x48, 0x31, 0x4d, 0x89, 0x14, 0xfe, 0xcd, 0xf6, 0xf7, 0xe9, 0x27, 0xfe, 0x07, 0x08, 0xad, 0xab,
+	0x2c, 0xee, 0xff, 0x8c, 0x59, 0x46, 0x59, 0x87, 0xc4, 0x9b, 0xc2, 0xbd, 0x6d, 0x94, 0xd6, 0xea,
+	0x34, 0xb0, 0x7f, 0xd5, 0x69, 0xb0, 0xab, 0xea, 0xb4, 0xd0, 0xd9, 0x3d, 0xd3, 0x4e, 0x75, 0x6a,
+	0xb5, 0xb2, 0xe3, 0x1a, 0xdf, 0x2a, 0x73, 0xcd, 0x2f, 0x1c, 0x98, 0x28, 0x60, 0x75, 0x45, 0x36,
+	0x2b, 0xf5, 0x1b, 0x06, 0xd5, 0xa9, 0xc7, 0xdc, 0xe2, 0x54, 0x8b, 0xfe, 0x46, 0xb5, 0xb8, 0xd4,
+	0xd9, 0x1c, 0x53, 0x0d, 0x73, 0x78, 0x34, 0x13, 0xbf, 0xe3, 0xc0, 0xd1, 0xe6, 0xa5, 0x17, 0x59,
+	0x0b, 0xf8, 0xab, 0xe0, 0x40, 0x15, 0x1a, 0x72, 0x85, 0xd4, 0xe3, 0x31, 0xa7, 0x85, 0x46, 0xe9,
+	0xc3, 0x2e, 0x91, 0xf8, 0x63, 0x0c, 0x9c, 0xa0, 0xae, 0xab, 0x56, 0x34, 0xe2, 0xaf, 0x62, 0x2b,
+	0x06, 0x31, 0xeb, 0x3d, 0x9e, 0x41, 0x1b, 0x60, 0x08, 0x6f, 0xca, 0x26, 0xc4, 0x56, 0xea, 0x8c,
+	0xe4, 0x17, 0xa8, 0x8e, 0x3f, 0x3c, 0x4e, 0x9e, 0x55, 0x35, 0xb2, 0x59, 0x2b, 0xa5, 0xcb, 0x48,
+	0x77, 0x46, 0xbf, 0x8c, 0xa7, 0xba, 0x90, 0x7a, 0x15, 0xe2, 0xf4, 0x32, 0x2c, 0x3f, 0xba, 0x3f,
+	0x07, 0x1c, 0xc9, 0xcb, 0xb0, 0x2c, 0x39, 0xbc, 0x72, 0xaf, 0x75, 0x76, 0xff, 0x69, 0x4f, 0x36,
+	0xb4, 0x35, 0x9e, 0x08, 0xc1, 0x99, 0x50, 0x00, 0x8b, 0x8d, 0x69, 0x30, 0x8c, 0x29, 0xaa, 0xa8,
+	0x29, 0x96, 0x71, 0x07, 0xa4, 0x03, 0xd6, 0xf7, 0xaa, 0xc2, 0x9f, 0x04, 0x63, 0x26, 0xd4, 0x65,
+	0xcd, 0x50, 0xa0, 0x49, 0xb7, 0x63, 0xd6, 0xf6, 0x28, 0x5b, 0x5b, 0x55, 0xc4, 0x9d, 0x18, 0x18,
+	0xa7, 0x41, 0xb7, 0x4d, 0xa0, 0xa1, 0xac, 0xf5, 0x5e, 0x82, 0xf9, 0xbd, 0xb6, 0x6f, 0xe3, 0x5f,
+	0xee, 0x95, 0xce, 0x9e, 0x3a, 0xe2, 0x49, 0x54, 0x66, 0x20, 0xb1, 0x08, 0xa6, 0x9a, 0x16, 0x98,
+	0x27, 0xae, 0x81, 0x91, 0x9a, 0x95, 0xb7, 0x45, 0x64, 0x74, 0x9f, 0x9f, 0xc3, 0x36, 0xed, 0x9b,
+	0x86, 0xf8, 0xb5, 0x3d, 0xcc, 0x53, 0xde, 0x2b, 0xdb, 0x1a, 0x26, 0x9a, 0xa1, 0xfe, 0x3b, 0xcc,
+	0xff, 0x53, 0x86, 0xf9, 0xa5, 0xce, 0x61, 0x97, 0x6a, 0x84, 0x5d, 0x70, 0x0c, 0x38, 0xf3, 0x7c,
+	0xf0, 0xa6, 0xb7, 0x79, 0x1e, 0x2a, 0x60, 0xf5, 0x5a, 0xcd, 0x50, 0x24, 0xb8, 0x25, 0x9b, 0xca,
+	0x75, 0x84, 0x2a, 0xfc, 0x45, 0x30, 0xa2, 0xc0, 0x2a, 0xc2, 0x1a, 0x41, 0x66, 0xc7, 0xb0, 0x69,
+	0x40, 0xf9, 0x4d, 0xe6, 0xe8, 0x58, 0xaa, 0x3f, 0xdc, 0xd1, 0x17, 0xa8, 0x8f, 0xbe, 0x7d, 0x92,
+	0x9c, 0x89, 0x50, 0x49, 0x29, 0x01, 0x6e, 0x0a, 0x8a, 0xdc, 0x79, 0xaf, 0x85, 0x1a, 0x27, 0xa0,
+	0x96, 0x89, 0x37, 0x2c, 0xd3, 0xac, 0x96, 0x78, 0x1c, 0x4c, 0xb7, 0x2c, 0x32, 0x4b, 0x3c, 0xb5,
+	0xc7, 0xde, 0xa5, 0x8a, 0xac, 0xe9, 0xf6, 0xf6, 0x32, 0x2c, 0x91, 0xde, 0xca, 0xa4, 0x2e, 0xe7,
+	0x28, 0x9f, 0x2e, 0xe2, 0x5f, 0x1c, 0x10, 0x5a, 0x97, 0x59, 0x69, 0x6a, 0x78, 0x8f, 0x7b, 0xb1,
+	0xde, 0xe3, 0xb7, 0xc0, 0x84, 0xdd, 0x5f, 0x34, 0x43, 0x2d, 0x2a, 0xb0, 0xf4, 0xe2, 0xe2, 0x65,
+	0x9c, 0xc9, 0xb1, 0x2c, 0xf0, 0xab, 0x3d, 0x3e, 0xad, 0x43, 0x92, 0x47, 0x46, 0x0d, 0xe7, 0xa1,
+	0x01, 0x6f, 0x6a, 0x65, 0x4d, 0xde, 0xbf, 0x41, 0x64, 0x15, 0x1c, 0x2e, 0x35, 0xb8, 0x46, 0x76,
+	0x35, 0xef, 0x21, 0x72, 0x9d, 0xdd, 0xc5, 0x95, 0x3e, 0x58, 0x27, 0x31, 0x05, 0x12, 0xc1, 0x3b,
+	0x2c, 0xea, 0x3f, 0x66, 0xf7, 0x1a, 0x7a, 0xe7, 0x59, 0x42, 0x7a, 0x15, 0xd5, 0x0c, 0xa5, 0xc7,
+	0xfa, 0xc7, 0x29, 0x30, 0x6e, 0xd5, 0xed, 0xb2, 0x73, 0x3c, 0xab, 0x8d, 0x0c, 0x4b, 0x63, 0xb2,
+	0xe7, 0xc8, 0xdd, 0x5f, 0x31, 0xbc, 0x0a, 0x37, 0xae, 0x18, 0xde, 0x55, 0x66, 0xa5, 0xdf, 0x38,
+	0xab, 0x72, 0xbc, 0xad, 0x91, 0x4d, 0xc5, 0x94, 0xb7, 0xd6, 0xec, 0x46, 0x60, 0xa7, 0x10, 0xee,
+	0xb1, 0x12, 0xd1, 0x5d, 0xef, 0x08, 0x56, 0x49, 0xfc, 0x9c, 0x03, 0x27, 0xdb, 0xee, 0xbe, 0xfc,
+	0x82, 0x21, 0xde, 0xe5, 0xc0, 0x64, 0x01, 0xab, 0x37, 0xaa, 0x8a, 0x4c, 0xe0, 0x75, 0xeb, 0xe9,
+	0x93, 0x36, 0x29, 0xb9, 0x46, 0x36, 0x91, 0xa9, 0x91, 0x7a, 0xe7, 0x26, 0xc5, 0xa0, 0xfc, 0x22,
+	0x18, 0xb2, 0x1f, 0x4f, 0x9d, 0x2b, 0x4d, 0xa2, 0x5d, 0x7f, 0xb7, 0xe5, 0x34, 0x8d, 0x24, 0x36,
+	0x61, 0x6e, 0xc2, 0xea, 0x3a, 0x8c, 0xa5, 0x38, 0x0d, 0x8e, 0xf9, 0x4e, 0xc7, 0x42, 0xe7, 0x27,
+	0x0e, 0xc4, 0xad, 0xc7, 0x1c, 0x62, 0xd6, 0xdf, 0xaa, 0xc9, 0xa6, 0x6c, 0x10, 0xcd, 0x80, 0xca,
+	0x75, 0x59, 0x33, 0xf7, 0xae, 0x42, 0x01, 0x0c, 0x56, 0x29, 0x03, 0xa7, 0x6c, 0xfe, 0xbf, 0x9d,
+	0x06, 0xfe, 0x4b, 0x01, 0x95, 0xea, 0xd5, 0xc7, 0xe6, 0x92, 0xcb, 0x35, 0x35, 0x53, 0x26, 0x86,
+	0x86, 0x4a, 0xb2, 0x11, 0x2a, 0x81, 0x2a, 0x88, 0x22, 0x48, 0xb5, 0xdb, 0x63, 0x36, 0xf8, 0xc3,
+	0xb6, 0x01, 0x6d, 0xbc, 0xd7, 0x4c, 0xa4, 0x2f, 0x21, 0x5d, 0xaf, 0x19, 0x1a, 0xa9, 0xbb, 0xb3,
+	0xc6, 0x9e, 0x6c, 0xf0, 0xf2, 0x66, 0x8d, 0x68, 0xe6, 0x09, 0xd4, 0xce, 0x31, 0x4f, 0xe0, 0x9e,
+	0x6b, 0x9e, 0xec, 0xc3, 0x49, 0xd0, 0x5f, 0xc0, 0x2a, 0xff, 0x09, 0x07, 0x8e, 0xb6, 0x79, 0x9c,
+	0x9f, 0x6f, 0xe7, 0xe1, 0xb6, 0x2f, 0xb6, 0xc2, 0xe5, 0xae, 0x49, 0x58, 0x5e, 0x7f, 0xc9, 0x81,
+	0xe3, 0x61, 0x2f, 0xbc, 0x17, 0x43, 0x58, 0x87, 0xd0, 0x09, 0x57, 0xf7, 0x46, 0xc7, 0xce, 0xf5,
+	0x2e, 0x98, 0xf4, 0xbf, 0x4a, 0xce, 0x86, 0xb0, 0xf4, 0x61, 0x85, 0x6c, 0x74, 0xac, 0x57, 0xa4,
+	0xff, 0xcd, 0x2f, 0x4c, 0xa4, 0x0f, 0x2b, 0x64, 0xa3, 0x63, 0x99, 0x48, 0x08, 0x46, 0xbd, 0x6f,
+	0x59, 0x67, 0x43, 0x58, 0x78, 0x70, 0x42, 0x3a, 0x1a, 0x8e, 0x89, 0xf9, 0x82, 0x03, 0x42, 0xc8,
+	0xbb, 0xcc, 0x85, 0xb0, 0x93, 0xb7, 0x25, 0x13, 0xae, 0xec, 0x89, 0x8c, 0x1d, 0xaa, 0x04, 0x80,
+	0xe7, 0x95, 0xe1, 0x4c, 0x98, 0x4a, 0x0c, 0x26, 0xcc, 0x45, 0x82, 0x31, 0x19, 0x34, 0xcd, 0xda,
+	0x5c, 0x9b, 0xc3, 0xd2, 0x2c, 0x98, 0x44, 0xb8, 0xdc, 0x35, 0x09, 0x3b, 0x88, 0x01, 0x26, 0x7c,
+	0xf7, 0xae, 0xff, 0x85, 0x30, 0x6b, 0x86, 0x0a, 0xf3, 0x91, 0xa1, 0xde, 0x58, 0xf6, 0xdf, 0x6e,
+	0xc2, 0x62, 0xd9, 0x87, 0x15, 0xb2, 0xd1, 0xb1, 0x4c, 0xe4, 0xfb, 0xe0, 0x70, 0xd0, 0xac, 0x9d,
+	0x0e, 0x4f, 0x0b, 0x3f, 0x5e, 0xb8, 0xd8, 0x1d, 0xde, 0x97, 0xbd, 0x4d, 0x93, 0x6d, 0x87, 0xec,
+	0xf5, 0x62, 0x85, 0x6c, 0x74, 0x6c, 0x53, 0x74, 0xb5, 0x99, 0x13, 0xc3, 0x5c, 0x16, 0x4c, 0x22,
+	0x5c, 0xee, 0x9a, 0x84, 0x1d, 0xe4, 0x23, 0x0e, 0x4c, 0x05, 0x4f, 0x1d, 0xe7, 0x42, 0xcb, 0x70,
+	0x00, 0x85, 0x70, 0xa9, 0x5b, 0x8a, 0xa6, 0x53, 0x04, 0xf7, 0xfd, 0x73, 0x1d, 0x02, 0xb8, 0x85,
+	0x42, 0xb8, 0xd4, 0x2d, 0x85, 0x67, 0x50, 0x1d, 0x6b, 0x1a, 0x1d, 0xff, 0x1b, 0xc2, 0xc9, 0x0b,
+	0x14, 0x32, 0x11, 0x81, 0xae, 0x24, 0x61, 0xf0, 0x03, 0x3a, 0x3a, 0xe4, 0x17, 0x1e, 0xec, 0x26,
+	0xb8, 0x87, 0xbb, 0x09, 0xee, 0xe9, 0x6e, 0x82, 0xbb, 0xf3, 0x2c, 0xd1, 0xf7, 0xf0, 0x59, 0xa2,
+	0xef, 0xfb, 0x67, 0x89, 0xbe, 0x77, 0x44, 0xcf, 0x0c, 0x62, 0xf3, 0x86, 0xb7, 0x75, 0xf6, 0x87,
+	0x7b, 0x6b, 0x06, 0x29, 0x0d, 0x59, 0x4f, 0x5a, 0xe7, 0xff, 0x1e, 0x00, 0xcd, 0x0a, 0xe6, 0xae,
+	0xf3, 0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateLockedDelegation defines a method for creating a new locked
+	// delegation.
+	CreateLockedDelegation(ctx context.Context, in *MsgCreateLockedDelegation, opts ...grpc.CallOption) (*MsgCreateLockedDelegationResponse, error)
+	// RedelegateLockedDelegation defines a method for performing a redelegation
+	// of locked delegations
+	RedelegateLockedDelegations(ctx context.Context, in *MsgRedelegateLockedDelegations, opts ...grpc.CallOption) (*MsgRedelegateLockedDelegationsResponse, error)
+	// ToggleAutoRenew toogles the auto renew flag in a locked delegation entry
+	ToggleAutoRenew(ctx context.Context, in *MsgToggleAutoRenew, opts ...grpc.CallOption) (*MsgToggleAutoRenewResponse, error)
+	// SetExpiryAction changes what happens to the shares of a locked delegation
+	// entry when it expires without auto renew
+	SetExpiryAction(ctx context.Context, in *MsgSetExpiryAction, opts ...grpc.CallOption) (*MsgSetExpiryActionResponse, error)
+	// EarlyUnlock unlocks locked delegation entries before their unlock time,
+	// undelegating the shares and charging the rate early unlock penalty
+	EarlyUnlock(ctx context.Context, in *MsgEarlyUnlock, opts ...grpc.CallOption) (*MsgEarlyUnlockResponse, error)
+	// SplitLockedDelegationEntry splits a locked delegation entry in two new
+	// entries, keeping the rate, unlock time and auto renew flag
+	SplitLockedDelegationEntry(ctx context.Context, in *MsgSplitLockedDelegationEntry, opts ...grpc.CallOption) (*MsgSplitLockedDelegationEntryResponse, error)
+	// ExtendLock moves a locked delegation entry to a longer duration rate
+	ExtendLock(ctx context.Context, in *MsgExtendLock, opts ...grpc.CallOption) (*MsgExtendLockResponse, error)
+	// LockExistingDelegation defines a method for creating a new locked
+	// delegation entry on top of an existing delegation
+	LockExistingDelegation(ctx context.Context, in *MsgLockExistingDelegation, opts ...grpc.CallOption) (*MsgLockExistingDelegationResponse, error)
+	// FundRewardPool defines a method for funding the locking reward pool
+	FundRewardPool(ctx context.Context, in *MsgFundRewardPool, opts ...grpc.CallOption) (*MsgFundRewardPoolResponse, error)
+	// ClaimRewardDebt defines a method for claiming the locking rewards owed by
+	// the reward pool
+	ClaimRewardDebt(ctx context.Context, in *MsgClaimRewardDebt, opts ...grpc.CallOption) (*MsgClaimRewardDebtResponse, error)
+	// SetBonusBeneficiary defines a method for setting the address receiving the
+	// locking rewards of a delegator
+	SetBonusBeneficiary(ctx context.Context, in *MsgSetBonusBeneficiary, opts ...grpc.CallOption) (*MsgSetBonusBeneficiaryResponse, error)
+	// SetAutoCompound defines a method for setting if the locking rewards of a
+	// locked delegation are compounded
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// WithdrawLockingRewards defines a method for withdrawing the locking
+	// rewards on the standalone reward mode
+	WithdrawLockingRewards(ctx context.Context, in *MsgWithdrawLockingRewards, opts ...grpc.CallOption) (*MsgWithdrawLockingRewardsResponse, error)
+	// RetryQuarantinedPairs defines a governance operation for completing the
+	// expired entries of quarantined locked delegation pairs
+	RetryQuarantinedPairs(ctx context.Context, in *MsgRetryQuarantinedPairs, opts ...grpc.CallOption) (*MsgRetryQuarantinedPairsResponse, error)
+	// FundFromCommunityPool defines a governance operation for funding the
+	// locking reward pool from the community pool
+	FundFromCommunityPool(ctx context.Context, in *MsgFundFromCommunityPool, opts ...grpc.CallOption) (*MsgFundFromCommunityPoolResponse, error)
+	// UpdateParams defines an operation for updating the x/locking module
+	// parameters
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateLockedDelegation(ctx context.Context, in *MsgCreateLockedDelegation, opts ...grpc.CallOption) (*MsgCreateLockedDelegationResponse, error) {
+	out := new(MsgCreateLockedDelegationResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/CreateLockedDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedelegateLockedDelegations(ctx context.Context, in *MsgRedelegateLockedDelegations, opts ...grpc.CallOption) (*MsgRedelegateLockedDelegationsResponse, error) {
+	out := new(MsgRedelegateLockedDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/RedelegateLockedDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ToggleAutoRenew(ctx context.Context, in *MsgToggleAutoRenew, opts ...grpc.CallOption) (*MsgToggleAutoRenewResponse, error) {
+	out := new(MsgToggleAutoRenewResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/ToggleAutoRenew", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetExpiryAction(ctx context.Context, in *MsgSetExpiryAction, opts ...grpc.CallOption) (*MsgSetExpiryActionResponse, error) {
+	out := new(MsgSetExpiryActionResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/SetExpiryAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EarlyUnlock(ctx context.Context, in *MsgEarlyUnlock, opts ...grpc.CallOption) (*MsgEarlyUnlockResponse, error) {
+	out := new(MsgEarlyUnlockResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/EarlyUnlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SplitLockedDelegationEntry(ctx context.Context, in *MsgSplitLockedDelegationEntry, opts ...grpc.CallOption) (*MsgSplitLockedDelegationEntryResponse, error) {
+	out := new(MsgSplitLockedDelegationEntryResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/SplitLockedDelegationEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExtendLock(ctx context.Context, in *MsgExtendLock, opts ...grpc.CallOption) (*MsgExtendLockResponse, error) {
+	out := new(MsgExtendLockResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/ExtendLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockExistingDelegation(ctx context.Context, in *MsgLockExistingDelegation, opts ...grpc.CallOption) (*MsgLockExistingDelegationResponse, error) {
+	out := new(MsgLockExistingDelegationResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/LockExistingDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundRewardPool(ctx context.Context, in *MsgFundRewardPool, opts ...grpc.CallOption) (*MsgFundRewardPoolResponse, error) {
+	out := new(MsgFundRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/FundRewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewardDebt(ctx context.Context, in *MsgClaimRewardDebt, opts ...grpc.CallOption) (*MsgClaimRewardDebtResponse, error) {
+	out := new(MsgClaimRewardDebtResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/ClaimRewardDebt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBonusBeneficiary(ctx context.Context, in *MsgSetBonusBeneficiary, opts ...grpc.CallOption) (*MsgSetBonusBeneficiaryResponse, error) {
+	out := new(MsgSetBonusBeneficiaryResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/SetBonusBeneficiary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawLockingRewards(ctx context.Context, in *MsgWithdrawLockingRewards, opts ...grpc.CallOption) (*MsgWithdrawLockingRewardsResponse, error) {
+	out := new(MsgWithdrawLockingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/WithdrawLockingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetryQuarantinedPairs(ctx context.Context, in *MsgRetryQuarantinedPairs, opts ...grpc.CallOption) (*MsgRetryQuarantinedPairsResponse, error) {
+	out := new(MsgRetryQuarantinedPairsResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/RetryQuarantinedPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundFromCommunityPool(ctx context.Context, in *MsgFundFromCommunityPool, opts ...grpc.CallOption) (*MsgFundFromCommunityPoolResponse, error) {
+	out := new(MsgFundFromCommunityPoolResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/FundFromCommunityPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/aether.locking.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateLockedDelegation defines a method for creating a new locked
+	// delegation.
+	CreateLockedDelegation(context.Context, *MsgCreateLockedDelegation) (*MsgCreateLockedDelegationResponse, error)
+	// RedelegateLockedDelegation defines a method for performing a redelegation
+	// of locked delegations
+	RedelegateLockedDelegations(context.Context, *MsgRedelegateLockedDelegations) (*MsgRedelegateLockedDelegationsResponse, error)
+	// ToggleAutoRenew toogles the auto renew flag in a locked delegation entry
+	ToggleAutoRenew(context.Context, *MsgToggleAutoRenew) (*MsgToggleAutoRenewResponse, error)
+	// SetExpiryAction changes what happens to the shares of a locked delegation
+	// entry when it expires without auto renew
+	SetExpiryAction(context.Context, *MsgSetExpiryAction) (*MsgSetExpiryActionResponse, error)
+	// EarlyUnlock unlocks locked delegation entries before their unlock time,
+	// undelegating the shares and charging the rate early unlock penalty
+	EarlyUnlock(context.Context, *MsgEarlyUnlock) (*MsgEarlyUnlockResponse, error)
+	// SplitLockedDelegationEntry splits a locked delegation entry in two new
+	// entries, keeping the rate, unlock time and auto renew flag
+	SplitLockedDelegationEntry(context.Context, *MsgSplitLockedDelegationEntry) (*MsgSplitLockedDelegationEntryResponse, error)
+	// ExtendLock moves a locked delegation entry to a longer duration rate
+	ExtendLock(context.Context, *MsgExtendLock) (*MsgExtendLockResponse, error)
+	// LockExistingDelegation defines a method for creating a new locked
+	// delegation entry on top of an existing delegation
+	LockExistingDelegation(context.Context, *MsgLockExistingDelegation) (*MsgLockExistingDelegationResponse, error)
+	// FundRewardPool defines a method for funding the locking reward pool
+	FundRewardPool(context.Context, *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error)
+	// ClaimRewardDebt defines a method for claiming the locking rewards owed by
+	// the reward pool
+	ClaimRewardDebt(context.Context, *MsgClaimRewardDebt) (*MsgClaimRewardDebtResponse, error)
+	// SetBonusBeneficiary defines a method for setting the address receiving the
+	// locking rewards of a delegator
+	SetBonusBeneficiary(context.Context, *MsgSetBonusBeneficiary) (*MsgSetBonusBeneficiaryResponse, error)
+	// SetAutoCompound defines a method for setting if the locking rewards of a
+	// locked delegation are compounded
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// WithdrawLockingRewards defines a method for withdrawing the locking
+	// rewards on the standalone reward mode
+	WithdrawLockingRewards(context.Context, *MsgWithdrawLockingRewards) (*MsgWithdrawLockingRewardsResponse, error)
+	// RetryQuarantinedPairs defines a governance operation for completing the
+	// expired entries of quarantined locked delegation pairs
+	RetryQuarantinedPairs(context.Context, *MsgRetryQuarantinedPairs) (*MsgRetryQuarantinedPairsResponse, error)
+	// FundFromCommunityPool defines a governance operation for funding the
+	// locking reward pool from the community pool
+	FundFromCommunityPool(context.Context, *MsgFundFromCommunityPool) (*MsgFundFromCommunityPoolResponse, error)
+	// UpdateParams defines an operation for updating the x/locking module
+	// parameters
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateLockedDelegation(ctx context.Context, req *MsgCreateLockedDelegation) (*MsgCreateLockedDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLockedDelegation not implemented")
+}
+func (*UnimplementedMsgServer) RedelegateLockedDelegations(ctx context.Context, req *MsgRedelegateLockedDelegations) (*MsgRedelegateLockedDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateLockedDelegations not implemented")
+}
+func (*UnimplementedMsgServer) ToggleAutoRenew(ctx context.Context, req *MsgToggleAutoRenew) (*MsgToggleAutoRenewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleAutoRenew not implemented")
+}
+func (*UnimplementedMsgServer) SetExpiryAction(ctx context.Context, req *MsgSetExpiryAction) (*MsgSetExpiryActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExpiryAction not implemented")
+}
+func (*UnimplementedMsgServer) EarlyUnlock(ctx context.Context, req *MsgEarlyUnlock) (*MsgEarlyUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarlyUnlock not implemented")
+}
+func (*UnimplementedMsgServer) SplitLockedDelegationEntry(ctx context.Context, req *MsgSplitLockedDelegationEntry) (*MsgSplitLockedDelegationEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitLockedDelegationEntry not implemented")
+}
+func (*UnimplementedMsgServer) ExtendLock(ctx context.Context, req *MsgExtendLock) (*MsgExtendLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendLock not implemented")
+}
+func (*UnimplementedMsgServer) LockExistingDelegation(ctx context.Context, req *MsgLockExistingDelegation) (*MsgLockExistingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockExistingDelegation not implemented")
+}
+func (*UnimplementedMsgServer) FundRewardPool(ctx context.Context, req *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundRewardPool not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRewardDebt(ctx context.Context, req *MsgClaimRewardDebt) (*MsgClaimRewardDebtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewardDebt not implemented")
+}
+func (*UnimplementedMsgServer) SetBonusBeneficiary(ctx context.Context, req *MsgSetBonusBeneficiary) (*MsgSetBonusBeneficiaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBonusBeneficiary not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawLockingRewards(ctx context.Context, req *MsgWithdrawLockingRewards) (*MsgWithdrawLockingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawLockingRewards not implemented")
+}
+func (*UnimplementedMsgServer) RetryQuarantinedPairs(ctx context.Context, req *MsgRetryQuarantinedPairs) (*MsgRetryQuarantinedPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryQuarantinedPairs not implemented")
+}
+func (*UnimplementedMsgServer) FundFromCommunityPool(ctx context.Context, req *MsgFundFromCommunityPool) (*MsgFundFromCommunityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundFromCommunityPool not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateLockedDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLockedDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLockedDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/CreateLockedDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLockedDelegation(ctx, req.(*MsgCreateLockedDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedelegateLockedDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegateLockedDelegations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegateLockedDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/RedelegateLockedDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegateLockedDelegations(ctx, req.(*MsgRedelegateLockedDelegations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ToggleAutoRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgToggleAutoRenew)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ToggleAutoRenew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/ToggleAutoRenew",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ToggleAutoRenew(ctx, req.(*MsgToggleAutoRenew))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetExpiryAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetExpiryAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetExpiryAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/SetExpiryAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetExpiryAction(ctx, req.(*MsgSetExpiryAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EarlyUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEarlyUnlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EarlyUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/EarlyUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EarlyUnlock(ctx, req.(*MsgEarlyUnlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SplitLockedDelegationEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitLockedDelegationEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SplitLockedDelegationEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/SplitLockedDelegationEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitLockedDelegationEntry(ctx, req.(*MsgSplitLockedDelegationEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExtendLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/ExtendLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendLock(ctx, req.(*MsgExtendLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockExistingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockExistingDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockExistingDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/LockExistingDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockExistingDelegation(ctx, req.(*MsgLockExistingDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundRewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundRewardPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundRewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/FundRewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundRewardPool(ctx, req.(*MsgFundRewardPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewardDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewardDebt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewardDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/ClaimRewardDebt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewardDebt(ctx, req.(*MsgClaimRewardDebt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBonusBeneficiary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBonusBeneficiary)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBonusBeneficiary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/SetBonusBeneficiary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBonusBeneficiary(ctx, req.(*MsgSetBonusBeneficiary))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawLockingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawLockingRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawLockingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/WithdrawLockingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawLockingRewards(ctx, req.(*MsgWithdrawLockingRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetryQuarantinedPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryQuarantinedPairs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryQuarantinedPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/RetryQuarantinedPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryQuarantinedPairs(ctx, req.(*MsgRetryQuarantinedPairs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundFromCommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundFromCommunityPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundFromCommunityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/FundFromCommunityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundFromCommunityPool(ctx, req.(*MsgFundFromCommunityPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aether.locking.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "aether.locking.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLockedDelegation",
+			Handler:    _Msg_CreateLockedDelegation_Handler,
+		},
+		{
+			MethodName: "RedelegateLockedDelegations",
+			Handler:    _Msg_RedelegateLockedDelegations_Handler,
+		},
+		{
+			MethodName: "ToggleAutoRenew",
+			Handler:    _Msg_ToggleAutoRenew_Handler,
+		},
+		{
+			MethodName: "SetExpiryAction",
+			Handler:    _Msg_SetExpiryAction_Handler,
+		},
+		{
+			MethodName: "EarlyUnlock",
+			Handler:    _Msg_EarlyUnlock_Handler,
+		},
+		{
+			MethodName: "SplitLockedDelegationEntry",
+			Handler:    _Msg_SplitLockedDelegationEntry_Handler,
+		},
+		{
+			MethodName: "ExtendLock",
+			Handler:    _Msg_ExtendLock_Handler,
+		},
+		{
+			MethodName: "LockExistingDelegation",
+			Handler:    _Msg_LockExistingDelegation_Handler,
+		},
+		{
+			MethodName: "FundRewardPool",
+			Handler:    _Msg_FundRewardPool_Handler,
+		},
+		{
+			MethodName: "ClaimRewardDebt",
+			Handler:    _Msg_ClaimRewardDebt_Handler,
+		},
+		{
+			MethodName: "SetBonusBeneficiary",
+			Handler:    _Msg_SetBonusBeneficiary_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "WithdrawLockingRewards",
+			Handler:    _Msg_WithdrawLockingRewards_Handler,
+		},
+		{
+			MethodName: "RetryQuarantinedPairs",
+			Handler:    _Msg_RetryQuarantinedPairs_Handler,
+		},
+		{
+			MethodName: "FundFromCommunityPool",
+			Handler:    _Msg_FundFromCommunityPool_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aether/locking/v1beta1/tx.proto",
+}
+
+func (m *MsgCreateLockedDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLockedDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLockedDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedelegateTo) > 0 {
+		i -= len(m.RedelegateTo)
+		copy(dAtA[i:], m.RedelegateTo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RedelegateTo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryAction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryAction))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLockedDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLockedDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLockedDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedelegateLockedDelegations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedelegateLockedDelegations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedelegateLockedDelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA4 := make([]byte, len(m.Ids)*10)
+		var j3 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedelegateLockedDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedelegateLockedDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedelegateLockedDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleAutoRenew) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleAutoRenew) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleAutoRenew) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleAutoRenewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleAutoRenewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleAutoRenewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetExpiryAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetExpiryAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetExpiryAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedelegateTo) > 0 {
+		i -= len(m.RedelegateTo)
+		copy(dAtA[i:], m.RedelegateTo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RedelegateTo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiryAction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryAction))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetExpiryActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetExpiryActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetExpiryActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEarlyUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEarlyUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEarlyUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA7 := make([]byte, len(m.Ids)*10)
+		var j6 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTx(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEarlyUnlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEarlyUnlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEarlyUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Penalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitLockedDelegationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitLockedDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitLockedDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitLockedDelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitLockedDelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitLockedDelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RemainderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SplitId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SplitId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTx(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockOn, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockOn):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockExistingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockExistingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockExistingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedelegateTo) > 0 {
+		i -= len(m.RedelegateTo)
+		copy(dAtA[i:], m.RedelegateTo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RedelegateTo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryAction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryAction))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockExistingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockExistingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockExistingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardDebtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardDebtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingDebt) > 0 {
+		for iNdEx := len(m.RemainingDebt) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingDebt[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBonusBeneficiary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBonusBeneficiary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBonusBeneficiary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BeneficiaryAddress) > 0 {
+		i -= len(m.BeneficiaryAddress)
+		copy(dAtA[i:], m.BeneficiaryAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BeneficiaryAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBonusBeneficiaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBonusBeneficiaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBonusBeneficiaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawLockingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawLockingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawLockingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawLockingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawLockingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawLockingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryQuarantinedPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryQuarantinedPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryQuarantinedPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryQuarantinedPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryQuarantinedPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryQuarantinedPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundFromCommunityPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundFromCommunityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundFromCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundFromCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundFromCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundFromCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateLockedDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovTx(uint64(l))
+	if m.AutoRenew {
+		n += 2
+	}
+	if m.ExpiryAction != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryAction))
+	}
+	l = len(m.RedelegateTo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateLockedDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedelegateLockedDelegations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgRedelegateLockedDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgToggleAutoRenew) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgToggleAutoRenewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetExpiryAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.ExpiryAction != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryAction))
+	}
+	l = len(m.RedelegateTo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetExpiryActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEarlyUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgEarlyUnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Penalty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitLockedDelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitLockedDelegationEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SplitId != 0 {
+		n += 1 + sovTx(uint64(m.SplitId))
+	}
+	if m.RemainderId != 0 {
+		n += 1 + sovTx(uint64(m.RemainderId))
+	}
+	return n
+}
+
+func (m *MsgExtendLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExtendLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockOn)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLockExistingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovTx(uint64(l))
+	if m.AutoRenew {
+		n += 2
+	}
+	if m.ExpiryAction != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryAction))
+	}
+	l = len(m.RedelegateTo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLockExistingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundRewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimRewardDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRewardDebtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemainingDebt) > 0 {
+		for _, e := range m.RemainingDebt {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetBonusBeneficiary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BeneficiaryAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBonusBeneficiaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AutoCompound {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawLockingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawLockingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRetryQuarantinedPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRetryQuarantinedPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundFromCommunityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundFromCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateLockedDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLockedDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLockedDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryAction", wireType)
+			}
+			m.ExpiryAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryAction |= ExpiryAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegateTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegateTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateLockedDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLockedDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLockedDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedelegateLockedDelegations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedelegateLockedDelegations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedelegateLockedDelegations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedelegateLockedDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedelegateLockedDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedelegateLockedDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleAutoRenew) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleAutoRenew: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleAutoRenew: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleAutoRenewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleAutoRenewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleAutoRenewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetExpiryAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetExpiryAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetExpiryAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryAction", wireType)
+			}
+			m.ExpiryAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryAction |= ExpiryAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegateTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegateTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetExpiryActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetExpiryActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetExpiryActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEarlyUnlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEarlyUnlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEarlyUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEarlyUnlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEarlyUnlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEarlyUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitLockedDelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitLockedDelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitLockedDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitLockedDelegationEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitLockedDelegationEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitLockedDelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitId", wireType)
+			}
+			m.SplitId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplitId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainderId", wireType)
+			}
+			m.RemainderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockOn, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockExistingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockExistingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockExistingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryAction", wireType)
+			}
+			m.ExpiryAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryAction |= ExpiryAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegateTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegateTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockExistingDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockExistingDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockExistingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardDebtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardDebtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingDebt = append(m.RemainingDebt, types.Coin{})
+			if err := m.RemainingDebt[len(m.RemainingDebt)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBonusBeneficiary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBonusBeneficiary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBonusBeneficiary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeneficiaryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgToggleAutoRenewResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetBonusBeneficiaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBonusBeneficiaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBonusBeneficiaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateLockedDelegation) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1127,10 +6804,10 @@ func (m *MsgCreateLockedDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateLockedDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateLockedDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1198,10 +6875,10 @@ func (m *MsgCreateLockedDelegation) Unmarshal(dAtA []byte) error {
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1211,30 +6888,117 @@ func (m *MsgCreateLockedDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+			m.AutoCompound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawLockingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawLockingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawLockingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1244,30 +7008,29 @@ func (m *MsgCreateLockedDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1277,12 +7040,24 @@ func (m *MsgCreateLockedDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AutoRenew = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1304,7 +7079,7 @@ func (m *MsgCreateLockedDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateLockedDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawLockingRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1327,12 +7102,46 @@ func (m *MsgCreateLockedDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateLockedDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawLockingRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateLockedDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawLockingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1354,7 +7163,7 @@ func (m *MsgCreateLockedDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedelegateLockedDelegations) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1377,15 +7186,15 @@ func (m *MsgRedelegateLockedDelegations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedelegateLockedDelegations: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedelegateLockedDelegations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1413,13 +7222,13 @@ func (m *MsgRedelegateLockedDelegations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1429,132 +7238,25 @@ func (m *MsgRedelegateLockedDelegations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1576,7 +7278,7 @@ func (m *MsgRedelegateLockedDelegations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedelegateLockedDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1599,45 +7301,12 @@ func (m *MsgRedelegateLockedDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedelegateLockedDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedelegateLockedDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1659,7 +7328,7 @@ func (m *MsgRedelegateLockedDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleAutoRenew) Unmarshal(dAtA []byte) error {
+func (m *MsgRetryQuarantinedPairs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1682,15 +7351,15 @@ func (m *MsgToggleAutoRenew) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleAutoRenew: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetryQuarantinedPairs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleAutoRenew: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetryQuarantinedPairs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1718,13 +7387,13 @@ func (m *MsgToggleAutoRenew) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1734,43 +7403,26 @@ func (m *MsgToggleAutoRenew) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Pairs = append(m.Pairs, LockedDelegationPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1792,7 +7444,7 @@ func (m *MsgToggleAutoRenew) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleAutoRenewResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRetryQuarantinedPairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1815,10 +7467,10 @@ func (m *MsgToggleAutoRenewResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleAutoRenewResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetryQuarantinedPairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleAutoRenewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetryQuarantinedPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1842,7 +7494,7 @@ func (m *MsgToggleAutoRenewResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgFundFromCommunityPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1865,10 +7517,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundFromCommunityPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundFromCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1905,7 +7557,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1932,7 +7584,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1957,7 +7610,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundFromCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1980,10 +7633,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundFromCommunityPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundFromCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15,10 +15,12 @@ const (
 	ErrInvalidDenom      = "invalid denom: %s"
 	ErrZeroTime          = "time cannot be zero: %s"
 	ErrZeroDec           = "dec cannot be zero: %s"
+	ErrZeroOrNegativeDec = "dec cannot be zero or negative: %s"
 	ErrZeroOrNegativeInt = "int cannot be zero or negative: %s"
 	ErrZeroCoin          = "coin cannot be zero: %s"
 	ErrZeroDuration      = "duration cannot be zero: %s"
 	ErrZeroU32           = "u32 cannot be zero: %d"
+	ErrFractionRange     = "dec must be between zero and one: %s"
 )
 
 // ValidateDenom validates if the given parameter is a non-empty string.
@@ -73,6 +75,18 @@ func ValidateNonZeroDec(i interface{}) error {
 	return nil
 }
 
+// ValidatePositiveDec validates if the given parameter is a positive dec
+func ValidatePositiveDec(i interface{}) error {
+	d, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf(ErrInvalidType, i)
+	}
+	if d.IsNil() || !d.IsPositive() {
+		return fmt.Errorf(ErrZeroOrNegativeDec, d)
+	}
+	return nil
+}
+
 // ValidatePositiveInt validates if the given parameter is a non-zero
 func ValidatePositiveInt(i interface{}) error {
 	in, ok := i.(math.Int)
@@ -113,3 +127,15 @@ func ValidatePositiveU32(i interface{}) error {
 	}
 	return nil
 }
+
+// ValidateFraction validates if the given parameter is a dec between zero (inclusive) and one (exclusive)
+func ValidateFraction(i interface{}) error {
+	d, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf(ErrInvalidType, i)
+	}
+	if d.IsNil() || d.IsNegative() || d.GTE(sdk.OneDec()) {
+		return fmt.Errorf(ErrFractionRange, d)
+	}
+	return nil
+}
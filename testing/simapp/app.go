@@ -152,6 +152,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		nft.ModuleName:                 nil,
 		lockingtypes.ModuleName:        {authtypes.Minter, authtypes.Staking},
+		lockingtypes.RewardPoolName:    nil,
 	}
 )
 
@@ -330,7 +331,7 @@ func NewSimApp(
 	app.LockingKeeper = lockingkeeper.NewKeeper(
 		keys[lockingtypes.StoreKey], appCodec,
 		app.StakingKeeper, app.DistrKeeper,
-		app.BankKeeper,
+		app.BankKeeper, app.SlashingKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -342,11 +343,7 @@ func NewSimApp(
 
 	// register the distribution rewards hooks
 	// this must be done before we set the staking hooks
-	app.DistrKeeper.SetHooks(
-		distrtypes.NewMultiDistributionHooks(
-			app.LockingKeeper.DistributionHooks(),
-		),
-	)
+	app.setDistributionHooks()
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -751,6 +748,8 @@ func BlockedAddresses() map[string]bool {
 
 	// allow the following addresses to receive funds
 	delete(modAccAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the locking reward pool is funded from the community pool
+	delete(modAccAddrs, authtypes.NewModuleAddress(lockingtypes.RewardPoolName).String())
 
 	return modAccAddrs
 }
@@ -0,0 +1,17 @@
+//go:build !nodisthooks
+
+package simapp
+
+import (
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// setDistributionHooks registers the distribution rewards hooks
+// The hooks are only available on the aetherevm cosmos-sdk fork
+func (app *SimApp) setDistributionHooks() {
+	app.DistrKeeper.SetHooks(
+		distrtypes.NewMultiDistributionHooks(
+			app.LockingKeeper.DistributionHooks(),
+		),
+	)
+}
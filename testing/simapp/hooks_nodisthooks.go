@@ -0,0 +1,7 @@
+//go:build nodisthooks
+
+package simapp
+
+// setDistributionHooks leaves the distribution hooks unset
+// The stock cosmos-sdk has no distribution hooks, so the locking rewards use the standalone reward mode
+func (app *SimApp) setDistributionHooks() {}